@@ -3,8 +3,10 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"os"
 	"runtime/debug"
 	"strings"
+	"syscall"
 
 	"github.com/pkg/errors"
 
@@ -32,6 +34,8 @@ type runTxMode uint8
 const (
 	// Check a transaction
 	runTxModeCheck runTxMode = iota
+	// Recheck a (pending) transaction after a commit
+	runTxModeReCheck runTxMode = iota
 	// Simulate a transaction
 	runTxModeSimulate runTxMode = iota
 	// Deliver a transaction
@@ -68,8 +72,27 @@ type BaseApp struct {
 	deliverState *state          // for DeliverTx
 	voteInfos    []abci.VoteInfo // absent validators from begin block
 
-	// minimum fees for spam prevention
-	minimumFees sdk.Coins
+	// Hashes of txs that passed CheckTx since the last Commit (checkedTxs),
+	// and of those that passed before it and are expected to be re-checked by
+	// the mempool (recheckTxs). Tendermint does not tell the app whether a
+	// CheckTx is a re-check, so BaseApp keeps track of it itself.
+	checkedTxs map[string]struct{}
+	recheckTxs map[string]struct{}
+
+	// minimum gas prices for spam prevention, a tx must pay at least
+	// gasPrice * gasWanted in one of the accepted denominations
+	minGasPrices sdk.DecCoins
+
+	// block height at which to halt the chain and gracefully shutdown
+	haltHeight uint64
+
+	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
+	haltTime uint64
+
+	// indexer of the transactions of committed blocks, may be nil, and the
+	// transactions delivered in the current block
+	txIndexer    TxIndexer
+	deliveredTxs []DeliveredTx
 
 	// flag for sealing
 	sealed bool
@@ -96,6 +119,8 @@ func NewBaseApp(name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecod
 		queryRouter: NewQueryRouter(),
 		codespacer:  sdk.NewCodespacer(),
 		txDecoder:   txDecoder,
+		checkedTxs:  make(map[string]struct{}),
+		recheckTxs:  make(map[string]struct{}),
 	}
 
 	// Register the undefined & root codespaces, which should not be used by
@@ -191,13 +216,13 @@ func (app *BaseApp) initFromStore(mainKey sdk.StoreKey) error {
 	return nil
 }
 
-// SetMinimumFees sets the minimum fees.
-func (app *BaseApp) SetMinimumFees(fees sdk.Coins) { app.minimumFees = fees }
+// SetMinGasPrices sets the minimum gas prices.
+func (app *BaseApp) SetMinGasPrices(gasPrices sdk.DecCoins) { app.minGasPrices = gasPrices }
 
 // NewContext returns a new Context with the correct store, the given header, and nil txBytes.
 func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 	if isCheckTx {
-		return sdk.NewContext(app.checkState.ms, header, true, app.Logger).WithMinimumFees(app.minimumFees)
+		return sdk.NewContext(app.checkState.ms, header, true, app.Logger).WithMinGasPrices(app.minGasPrices)
 	}
 	return sdk.NewContext(app.deliverState.ms, header, false, app.Logger)
 }
@@ -215,7 +240,7 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.Logger).WithMinimumFees(app.minimumFees),
+		ctx: sdk.NewContext(ms, header, true, app.Logger).WithMinGasPrices(app.minGasPrices),
 	}
 }
 
@@ -332,6 +357,8 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				Code:  uint32(sdk.ABCICodeOK),
 				Value: []byte(version.GetVersion()),
 			}
+		case "txs":
+			return handleQueryTxs(app, req)
 		default:
 			result = sdk.ErrUnknownRequest(fmt.Sprintf("Unknown query: %s", path)).Result()
 		}
@@ -343,7 +370,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 			Value: value,
 		}
 	}
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be either simulate, version or txs, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -393,7 +420,7 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 	}
 
 	ctx := sdk.NewContext(app.cms.CacheMultiStore(), app.checkState.ctx.BlockHeader(), true, app.Logger).
-		WithMinimumFees(app.minimumFees)
+		WithMinGasPrices(app.minGasPrices)
 	// Passes the rest of the path as an argument to the querier.
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets []string{"proposal", "test"} as the path
 	resBytes, err := querier(ctx, path[2:], req)
@@ -444,6 +471,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 // first decoding, then the ante handler (which checks signatures/fees/ValidateBasic),
 // then finally the route match to see whether a handler exists. CheckTx does not run the actual
 // Msg handler function(s).
+//
+// A transaction that already passed CheckTx before the last Commit is being
+// re-checked by the mempool and runs in re-check mode, which allows the ante
+// handler to skip expensive checks such as signature verification.
 func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 	// Decode the Tx.
 	var result sdk.Result
@@ -451,7 +482,18 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 	if err != nil {
 		result = err.Result()
 	} else {
-		result = app.runTx(runTxModeCheck, txBytes, tx)
+		txHash := string(tmhash.Sum(txBytes))
+
+		mode := runTxModeCheck
+		if _, ok := app.recheckTxs[txHash]; ok {
+			mode = runTxModeReCheck
+			delete(app.recheckTxs, txHash)
+		}
+
+		result = app.runTx(mode, txBytes, tx)
+		if result.IsOK() {
+			app.checkedTxs[txHash] = struct{}{}
+		}
 	}
 
 	return abci.ResponseCheckTx{
@@ -475,8 +517,13 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 		result = app.runTx(runTxModeDeliver, txBytes, tx)
 	}
 
-	// Even though the Result.Code is not OK, there are still effects,
-	// namely fee deductions and sequence incrementing.
+	if app.txIndexer != nil {
+		app.deliveredTxs = append(app.deliveredTxs, DeliveredTx{Bytes: txBytes, Tx: tx, Result: result})
+	}
+
+	// A tx whose messages fail still has the effects of its ante handler,
+	// namely fee deductions and sequence incrementing. A tx rejected by the
+	// ante handler has no effects at all, as its writes are discarded.
 
 	// Tell the blockchain engine (i.e. Tendermint).
 	return abci.ResponseDeliverTx{
@@ -513,7 +560,10 @@ func validateBasicTxMsgs(msgs []sdk.Msg) sdk.Error {
 func (app *BaseApp) getContextForAnte(mode runTxMode, txBytes []byte) (ctx sdk.Context) {
 	// Get the context
 	ctx = getState(app, mode).ctx.WithTxBytes(txBytes)
-	if mode == runTxModeDeliver {
+	switch mode {
+	case runTxModeReCheck:
+		ctx = ctx.WithIsReCheckTx(true)
+	case runTxModeDeliver:
 		ctx = ctx.WithVoteInfos(app.voteInfos)
 	}
 	return
@@ -535,8 +585,8 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 		}
 
 		var msgResult sdk.Result
-		// Skip actual execution for CheckTx
-		if mode != runTxModeCheck {
+		// Skip actual execution for CheckTx and ReCheckTx
+		if mode != runTxModeCheck && mode != runTxModeReCheck {
 			msgResult = handler(ctx, msg)
 		}
 		msgResult.Tags = append(msgResult.Tags, sdk.MakeTag("action", []byte(msg.Name())))
@@ -575,11 +625,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 // Returns the applicantion's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func getState(app *BaseApp, mode runTxMode) *state {
-	if mode == runTxModeCheck || mode == runTxModeSimulate {
-		return app.checkState
+	if mode == runTxModeDeliver {
+		return app.deliverState
 	}
 
-	return app.deliverState
+	return app.checkState
 }
 
 func (app *BaseApp) initializeContext(ctx sdk.Context, mode runTxMode) sdk.Context {
@@ -589,6 +639,19 @@ func (app *BaseApp) initializeContext(ctx sdk.Context, mode runTxMode) sdk.Conte
 	return ctx
 }
 
+// cacheTxContext returns a new context based off of the provided context with
+// a cache wrapped multi-store of the state for the given mode.
+func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte, mode runTxMode) (sdk.Context, sdk.CacheMultiStore) {
+	msCache := getState(app, mode).CacheMultiStore()
+	if msCache.TracingEnabled() {
+		msCache = msCache.WithTracingContext(sdk.TraceContext(
+			map[string]interface{}{"txHash": cmn.HexBytes(tmhash.Sum(txBytes)).String()},
+		)).(sdk.CacheMultiStore)
+	}
+
+	return ctx.WithMultiStore(msCache), msCache
+}
+
 // runTx processes a transaction. The transactions is proccessed via an
 // anteHandler. txBytes may be nil in some cases, eg. in tests. Also, in the
 // future we may support "internal" transactions.
@@ -597,6 +660,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted int64
+	var anteTags sdk.Tags
 	var msCache sdk.CacheMultiStore
 	ctx := app.getContextForAnte(mode, txBytes)
 	ctx = app.initializeContext(ctx, mode)
@@ -624,7 +688,18 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 	// run the ante handler
 	if app.anteHandler != nil {
-		newCtx, result, abort := app.anteHandler(ctx, tx, (mode == runTxModeSimulate))
+		// Cache wrap the state written by the ante handler so that a tx which
+		// is aborted halfway (e.g. an invalid second signature) doesn't leave
+		// behind incremented sequences or deducted fees. Otherwise an account
+		// could not queue its next sequence after a failed CheckTx. Simulations
+		// already run on a throwaway cache.
+		anteCtx := ctx
+		var anteCache sdk.CacheMultiStore
+		if mode != runTxModeSimulate {
+			anteCtx, anteCache = app.cacheTxContext(ctx, txBytes, mode)
+		}
+
+		newCtx, result, abort := app.anteHandler(anteCtx, tx, (mode == runTxModeSimulate))
 		if abort {
 			return result
 		}
@@ -633,26 +708,26 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		}
 
 		gasWanted = result.GasWanted
+		anteTags = result.Tags
+
+		if anteCache != nil {
+			anteCache.Write()
+		}
 	}
 
 	if mode == runTxModeSimulate {
 		result = app.runMsgs(ctx, msgs, mode)
 		result.GasWanted = gasWanted
+		result.Tags = append(anteTags, result.Tags...)
 		return
 	}
 
 	// Keep the state in a transient CacheWrap in case processing the messages
 	// fails.
-	msCache = getState(app, mode).CacheMultiStore()
-	if msCache.TracingEnabled() {
-		msCache = msCache.WithTracingContext(sdk.TraceContext(
-			map[string]interface{}{"txHash": cmn.HexBytes(tmhash.Sum(txBytes)).String()},
-		)).(sdk.CacheMultiStore)
-	}
-
-	ctx = ctx.WithMultiStore(msCache)
+	ctx, msCache = app.cacheTxContext(ctx, txBytes, mode)
 	result = app.runMsgs(ctx, msgs, mode)
 	result.GasWanted = gasWanted
+	result.Tags = append(anteTags, result.Tags...)
 
 	// only update state if all messages pass
 	if result.IsOK() {
@@ -700,10 +775,56 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// Use the header from this latest block.
 	app.setCheckState(header)
 
+	// Txs that passed CheckTx and are still pending in the mempool will be
+	// re-checked against the new check state.
+	app.recheckTxs = app.checkedTxs
+	app.checkedTxs = make(map[string]struct{})
+
 	// Empty the Deliver state
 	app.deliverState = nil
 
+	// Index the transactions of the committed block
+	app.indexDeliveredTxs(header)
+
+	// Halt the node once the target block has been committed so that an export
+	// or binary upgrade can safely take place.
+	if app.shouldHalt(header) {
+		app.Logger.Info("halting node per configuration",
+			"height", header.Height, "haltHeight", app.haltHeight, "haltTime", app.haltTime)
+		app.halt()
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
 }
+
+// shouldHalt returns true if the given committed block header satisfies
+// either the configured halt height or halt time.
+func (app *BaseApp) shouldHalt(header abci.Header) bool {
+	switch {
+	case app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight:
+		return true
+	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
+		return true
+	}
+	return false
+}
+
+// halt attempts to gracefully shutdown the node via SIGINT so that Tendermint
+// can flush its state; if that fails the process exits directly.
+func (app *BaseApp) halt() {
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		// attempt cascading signals in case SIGINT fails (os dependent)
+		sigIntErr := p.Signal(syscall.SIGINT)
+		sigTermErr := p.Signal(syscall.SIGTERM)
+
+		if sigIntErr == nil || sigTermErr == nil {
+			return
+		}
+	}
+
+	// resort to exiting immediately if the process could not be found or killed
+	os.Exit(0)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -152,6 +153,34 @@ func testChangeNameHelper(name string) func(*BaseApp) {
 	}
 }
 
+func TestHaltOptions(t *testing.T) {
+	logger := defaultLogger()
+	db := dbm.NewMemDB()
+
+	// no halt conditions configured
+	app := NewBaseApp(t.Name(), logger, db, nil)
+	require.False(t, app.shouldHalt(abci.Header{Height: 100, Time: time.Now()}))
+
+	// halt height
+	app = NewBaseApp(t.Name(), logger, db, nil, SetHaltHeight(10))
+	require.Equal(t, uint64(10), app.haltHeight)
+	require.False(t, app.shouldHalt(abci.Header{Height: 9}))
+	require.True(t, app.shouldHalt(abci.Header{Height: 10}))
+	require.True(t, app.shouldHalt(abci.Header{Height: 11}))
+
+	// halt time
+	haltTime := time.Unix(1500000000, 0)
+	app = NewBaseApp(t.Name(), logger, db, nil, SetHaltTime(uint64(haltTime.Unix())))
+	require.Equal(t, uint64(haltTime.Unix()), app.haltTime)
+	require.False(t, app.shouldHalt(abci.Header{Height: 1, Time: haltTime.Add(-time.Second)}))
+	require.True(t, app.shouldHalt(abci.Header{Height: 1, Time: haltTime}))
+
+	// options cannot be applied to a sealed app
+	app.Seal()
+	require.Panics(t, func() { SetHaltHeight(1)(app) })
+	require.Panics(t, func() { SetHaltTime(1)(app) })
+}
+
 // Test that the app hash is static
 // TODO: https://github.com/cosmos/cosmos-sdk/issues/520
 /*func TestStaticAppHash(t *testing.T) {
@@ -477,6 +506,77 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// Test that txs which passed CheckTx are re-checked in re-check mode after a
+// commit, and that a failed CheckTx doesn't affect successive txs.
+func TestReCheckTx(t *testing.T) {
+	counterKey := []byte("counter-key")
+	reChecks := 0
+
+	// This ante handler behaves like a sequence check. It always increments
+	// the counter before validating it, so writes of aborted txs must be discarded.
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			store := ctx.KVStore(capKey1)
+			storedCounter := getIntFromStore(store, counterKey)
+			setIntOnStore(store, counterKey, storedCounter+1)
+
+			if tx.(txTest).Counter != storedCounter {
+				return ctx, sdk.ErrInvalidSequence("unexpected counter").Result(), true
+			}
+			if ctx.IsReCheckTx() {
+				reChecks++
+			}
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	// Create same codec used in txDecoder
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	checkTx := func(counter int64) abci.ResponseCheckTx {
+		txBytes, err := codec.MarshalBinary(newTxCounter(counter, 0))
+		require.NoError(t, err)
+		return app.CheckTx(txBytes)
+	}
+	storedCounter := func() int64 {
+		return getIntFromStore(app.checkState.ctx.KVStore(capKey1), counterKey)
+	}
+
+	require.True(t, checkTx(0).IsOK())
+	require.True(t, checkTx(1).IsOK())
+
+	// failed txs don't leave any writes behind
+	require.False(t, checkTx(1).IsOK())
+	require.False(t, checkTx(3).IsOK())
+	require.Equal(t, int64(2), storedCounter())
+
+	// so the next tx can still be queued
+	require.True(t, checkTx(2).IsOK())
+	require.Equal(t, 0, reChecks)
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Equal(t, int64(0), storedCounter())
+
+	// pending txs are re-checked against the new check state
+	for i := int64(0); i < 3; i++ {
+		require.True(t, checkTx(i).IsOK())
+	}
+	require.Equal(t, 3, reChecks)
+
+	// a tx is only re-checked once per commit
+	require.False(t, checkTx(0).IsOK())
+	require.Equal(t, 3, reChecks)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -655,6 +755,116 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// testTxIndexer records the indexed blocks and echoes queries.
+type testTxIndexer struct {
+	headers []abci.Header
+	txs     [][]DeliveredTx
+}
+
+func (idx *testTxIndexer) IndexBlock(header abci.Header, txs []DeliveredTx) error {
+	idx.headers = append(idx.headers, header)
+	idx.txs = append(idx.txs, txs)
+	return nil
+}
+
+func (idx *testTxIndexer) LastHeight() int64 {
+	if len(idx.headers) == 0 {
+		return 0
+	}
+	return idx.headers[len(idx.headers)-1].Height
+}
+
+func (idx *testTxIndexer) QueryTxs(data []byte) ([]byte, sdk.Error) {
+	return data, nil
+}
+
+func TestTxIndexer(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(typeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key")))
+	}
+
+	// queries fail while indexing is disabled
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	res := app.Query(abci.RequestQuery{Path: "/app/txs"})
+	require.False(t, res.IsOK())
+
+	indexer := &testTxIndexer{}
+	app = setupBaseApp(t, anteOpt, routerOpt, SetTxIndexer(indexer))
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	nBlocks := 2
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		header := abci.Header{Height: int64(blockN + 1)}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		txBytes, err := cdc.MarshalBinary(newTxCounter(int64(blockN), int64(blockN)))
+		require.NoError(t, err)
+		require.True(t, app.DeliverTx(txBytes).IsOK())
+		require.False(t, app.DeliverTx([]byte("garbage")).IsOK())
+
+		// transactions are indexed on commit only
+		require.Len(t, indexer.headers, blockN)
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	require.Len(t, indexer.headers, nBlocks)
+	for blockN, txs := range indexer.txs {
+		require.Equal(t, int64(blockN+1), indexer.headers[blockN].Height)
+		require.Len(t, txs, 2)
+		require.NotNil(t, txs[0].Tx)
+		require.True(t, txs[0].Result.IsOK())
+		require.Equal(t, []byte("garbage"), txs[1].Bytes)
+		require.Nil(t, txs[1].Tx)
+		require.False(t, txs[1].Result.IsOK())
+	}
+
+	res = app.Query(abci.RequestQuery{Path: "/app/txs", Data: []byte("query")})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("query"), res.Value)
+}
+
+func TestCatchUpTxIndex(t *testing.T) {
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	txBytes, err := cdc.MarshalBinary(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	var loaded []int64
+	loadBlock := func(height int64) (abci.Header, [][]byte, []abci.ResponseDeliverTx, error) {
+		loaded = append(loaded, height)
+		txs := [][]byte{txBytes, []byte("garbage")}
+		results := []abci.ResponseDeliverTx{{GasUsed: 10}, {Code: 1, Log: "failed"}}
+		return abci.Header{Height: height}, txs, results, nil
+	}
+
+	// an empty index is not caught up
+	indexer := &testTxIndexer{}
+	app := setupBaseApp(t, SetTxIndexer(indexer))
+	require.NoError(t, app.CatchUpTxIndex(3, loadBlock))
+	require.Empty(t, loaded)
+
+	// the blocks after the last indexed block are indexed
+	indexer.headers = []abci.Header{{Height: 1}}
+	indexer.txs = [][]DeliveredTx{nil}
+	require.NoError(t, app.CatchUpTxIndex(3, loadBlock))
+	require.Equal(t, []int64{2, 3}, loaded)
+	require.Equal(t, int64(3), indexer.LastHeight())
+
+	txs := indexer.txs[1]
+	require.Len(t, txs, 2)
+	require.NotNil(t, txs[0].Tx)
+	require.Equal(t, int64(10), txs[0].Result.GasUsed)
+	require.Nil(t, txs[1].Tx)
+	require.Equal(t, "failed", txs[1].Result.Log)
+
+	// the index is up to date
+	require.NoError(t, app.CatchUpTxIndex(3, loadBlock))
+	require.Len(t, loaded, 2)
+}
+
 //-------------------------------------------------------------------------------------------
 // Tx failure cases
 // TODO: add more
@@ -30,13 +30,25 @@ func SetPruning(pruning string) func(*BaseApp) {
 	}
 }
 
-// SetMinimumFees returns an option that sets the minimum fees on the app.
-func SetMinimumFees(minFees string) func(*BaseApp) {
-	fees, err := sdk.ParseCoins(minFees)
+// SetMinGasPrices returns an option that sets the minimum gas prices on the app.
+func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
+	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
 	if err != nil {
-		panic(fmt.Sprintf("invalid minimum fees: %v", err))
+		panic(fmt.Sprintf("invalid minimum gas prices: %v", err))
 	}
-	return func(bap *BaseApp) { bap.SetMinimumFees(fees) }
+	return func(bap *BaseApp) { bap.SetMinGasPrices(gasPrices) }
+}
+
+// SetHaltHeight returns an option that sets the block height at which the
+// node gracefully halts after committing.
+func SetHaltHeight(haltHeight uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltHeight(haltHeight) }
+}
+
+// SetHaltTime returns an option that sets the minimum block time (in Unix
+// seconds) at which the node gracefully halts after committing.
+func SetHaltTime(haltTime uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
 }
 
 func (app *BaseApp) SetName(name string) {
@@ -60,6 +72,20 @@ func (app *BaseApp) SetCMS(cms store.CommitMultiStore) {
 	app.cms = cms
 }
 
+func (app *BaseApp) setHaltHeight(haltHeight uint64) {
+	if app.sealed {
+		panic("SetHaltHeight() on sealed BaseApp")
+	}
+	app.haltHeight = haltHeight
+}
+
+func (app *BaseApp) setHaltTime(haltTime uint64) {
+	if app.sealed {
+		panic("SetHaltTime() on sealed BaseApp")
+	}
+	app.haltTime = haltTime
+}
+
 func (app *BaseApp) SetInitChainer(initChainer sdk.InitChainer) {
 	if app.sealed {
 		panic("SetInitChainer() on sealed BaseApp")
@@ -0,0 +1,123 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxIndexer indexes the transactions of committed blocks and answers the
+// queries sent to the "/app/txs" path. Unlike the Tendermint indexer, it has
+// access to the decoded transactions and to the results of their messages.
+type TxIndexer interface {
+	// IndexBlock indexes the transactions of a block once it has been
+	// committed, in the order they were delivered.
+	IndexBlock(header abci.Header, txs []DeliveredTx) error
+
+	// LastHeight returns the height of the last indexed block, or zero if
+	// no block was indexed.
+	LastHeight() int64
+
+	// QueryTxs answers a query over the indexed transactions. The encoding
+	// of the query and of its result is defined by the indexer.
+	QueryTxs(data []byte) ([]byte, sdk.Error)
+}
+
+// DeliveredTx is a transaction delivered in a block along with its result.
+type DeliveredTx struct {
+	Bytes  []byte
+	Tx     sdk.Tx // nil if the transaction could not be decoded
+	Result sdk.Result
+}
+
+// SetTxIndexer returns an option that sets the indexer of the transactions
+// of committed blocks. A nil indexer disables transaction indexing.
+func SetTxIndexer(indexer TxIndexer) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setTxIndexer(indexer) }
+}
+
+func (app *BaseApp) setTxIndexer(indexer TxIndexer) {
+	if app.sealed {
+		panic("SetTxIndexer() on sealed BaseApp")
+	}
+	app.txIndexer = indexer
+}
+
+// indexDeliveredTxs hands the transactions delivered in the block being
+// committed over to the indexer. Indexing failures are logged but do not
+// halt the node, as the index is not part of the application state.
+func (app *BaseApp) indexDeliveredTxs(header abci.Header) {
+	if app.txIndexer == nil {
+		return
+	}
+	if err := app.txIndexer.IndexBlock(header, app.deliveredTxs); err != nil {
+		app.Logger.Error("failed to index transactions", "height", header.Height, "err", err)
+	}
+	app.deliveredTxs = nil
+}
+
+// BlockLoader loads a committed block: its header, its transactions and
+// their DeliverTx results.
+type BlockLoader func(height int64) (header abci.Header, txs [][]byte, results []abci.ResponseDeliverTx, err error)
+
+// CatchUpTxIndex indexes the blocks committed after the last indexed block
+// up to the given height, e.g. when the node stopped after committing a
+// block but before indexing it. An empty index is not caught up, as blocks
+// committed before indexing was enabled are not indexed.
+func (app *BaseApp) CatchUpTxIndex(height int64, loadBlock BlockLoader) error {
+	if app.txIndexer == nil {
+		return nil
+	}
+	lastHeight := app.txIndexer.LastHeight()
+	if lastHeight == 0 {
+		return nil
+	}
+
+	for h := lastHeight + 1; h <= height; h++ {
+		header, txs, results, err := loadBlock(h)
+		if err != nil {
+			return err
+		}
+		if len(txs) != len(results) {
+			return fmt.Errorf("block %d has %d transactions but %d results", h, len(txs), len(results))
+		}
+
+		delivered := make([]DeliveredTx, len(txs))
+		for i, txBytes := range txs {
+			delivered[i] = DeliveredTx{
+				Bytes: txBytes,
+				Result: sdk.Result{
+					Code:      sdk.ABCICodeType(results[i].Code),
+					Data:      results[i].Data,
+					Log:       results[i].Log,
+					GasWanted: results[i].GasWanted,
+					GasUsed:   results[i].GasUsed,
+					Tags:      results[i].Tags,
+				},
+			}
+			if tx, err := app.txDecoder(txBytes); err == nil {
+				delivered[i].Tx = tx
+			}
+		}
+		if err := app.txIndexer.IndexBlock(header, delivered); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func handleQueryTxs(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	if app.txIndexer == nil {
+		return sdk.ErrUnknownRequest("transaction indexing is disabled on this node").QueryResult()
+	}
+	res, err := app.txIndexer.QueryTxs(req.Data)
+	if err != nil {
+		return err.QueryResult()
+	}
+	return abci.ResponseQuery{
+		Code:  uint32(sdk.ABCICodeOK),
+		Value: res,
+	}
+}
@@ -46,6 +46,7 @@ type CLIContext struct {
 	JSON          bool
 	PrintResponse bool
 	Verifier      tmlite.Verifier
+	RequireProofs bool
 	DryRun        bool
 	GenerateOnly  bool
 	fromAddress   types.AccAddress
@@ -226,3 +227,12 @@ func (ctx CLIContext) WithVerifier(verifier tmlite.Verifier) CLIContext {
 	ctx.Verifier = verifier
 	return ctx
 }
+
+// WithRequireProofs returns a copy of the context with an updated
+// RequireProofs flag. When set and the node is not trusted, queries whose
+// responses cannot be proven against a verified header fail instead of
+// returning unverified data.
+func (ctx CLIContext) WithRequireProofs(requireProofs bool) CLIContext {
+	ctx.RequireProofs = requireProofs
+	return ctx
+}
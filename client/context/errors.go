@@ -19,3 +19,11 @@ func ErrVerifyCommit(height int64) error {
 	return errors.Errorf(`The height of base truststore in gaia-lite is higher than height %d. 
 Can't verify blockchain proof at this height. Please set --trust-node to true and try again`, height)
 }
+
+// ErrUnverifiableQuery returns a common error reflecting that the response to
+// a query cannot be verified against the blockchain, as the node does not
+// return a proof for the given path.
+func ErrUnverifiableQuery(path string) error {
+	return errors.Errorf(`The response to query %s cannot be verified: the node provides no proof for it.
+Only proven queries are allowed when proofs are required`, path)
+}
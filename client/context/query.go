@@ -156,6 +156,12 @@ func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, err erro
 		return res, err
 	}
 
+	// custom and subspace queries carry no proof, refuse them upfront if only
+	// verified data may be returned
+	if ctx.RequireProofs && !ctx.TrustNode && !isQueryStoreWithProof(path) {
+		return res, ErrUnverifiableQuery(path)
+	}
+
 	opts := rpcclient.ABCIQueryOptions{
 		Height:  ctx.Height,
 		Trusted: ctx.TrustNode,
@@ -6,6 +6,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // nolint
@@ -15,7 +18,9 @@ const (
 	// occur between the tx simulation and the actual run.
 	DefaultGasAdjustment = 1.0
 	DefaultGasLimit      = 200000
-	GasFlagSimulate      = "simulate"
+	GasFlagAuto          = "auto"
+	// GasFlagSimulate is kept as a deprecated alias of GasFlagAuto.
+	GasFlagSimulate = "simulate"
 
 	FlagUseLedger      = "ledger"
 	FlagChainID        = "chain-id"
@@ -36,6 +41,13 @@ const (
 	FlagDryRun         = "dry-run"
 	FlagGenerateOnly   = "generate-only"
 	FlagIndentResponse = "indent"
+	FlagTimeoutHeight  = "timeout-height"
+	FlagKeyringBackend = "keyring-backend"
+	FlagPageKey        = "page-key"
+	FlagOffset         = "offset"
+	FlagLimit          = "limit"
+	FlagCountTotal     = "count-total"
+	FlagOrder          = "order"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -71,6 +83,7 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Int64(FlagSequence, 0, "Sequence number to sign the tx")
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFee, "", "Fee to pay along with transaction")
+		c.Flags().Int64(FlagTimeoutHeight, 0, "Last block height at which the transaction may be included; 0 disables the timeout")
 		c.Flags().String(FlagChainID, "", "Chain ID of tendermint node")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
@@ -81,9 +94,10 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Bool(FlagTrustNode, true, "Trust connected full node (don't verify proofs for responses)")
 		c.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
 		c.Flags().Bool(FlagGenerateOnly, false, "build an unsigned transaction and write it to STDOUT")
-		// --gas can accept integers and "simulate"
+		c.Flags().String(FlagKeyringBackend, keys.BackendDB, "Keyring backend to load keys from (db|file|pass|memory)")
+		// --gas can accept integers and "auto"
 		c.Flags().Var(&GasFlagVar, "gas", fmt.Sprintf(
-			"gas limit to set per-transaction; set to %q to calculate required gas automatically (default %d)", GasFlagSimulate, DefaultGasLimit))
+			"gas limit to set per-transaction; set to %q to calculate required gas automatically (default %d)", GasFlagAuto, DefaultGasLimit))
 		viper.BindPFlag(FlagTrustNode, c.Flags().Lookup(FlagTrustNode))
 		viper.BindPFlag(FlagUseLedger, c.Flags().Lookup(FlagUseLedger))
 		viper.BindPFlag(FlagChainID, c.Flags().Lookup(FlagChainID))
@@ -92,6 +106,17 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	return cmds
 }
 
+// PaginationFlags adds the flags selecting a page of the results of list
+// queries
+func PaginationFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(FlagPageKey, "", "Hex encoded key of the first result to return, as returned with the previous page")
+	cmd.Flags().Int64(FlagOffset, 0, "Number of results to skip; cannot be combined with --page-key")
+	cmd.Flags().Int64(FlagLimit, 0, fmt.Sprintf("Maximum number of results to return (default %d)", sdk.DefaultPageLimit))
+	cmd.Flags().Bool(FlagCountTotal, false, "Count the total number of results; cannot be combined with --page-key")
+	cmd.Flags().String(FlagOrder, "asc", "Order of the results by key: asc or desc")
+	return cmd
+}
+
 // Gas flag parsing functions
 
 // GasSetting encapsulates the possible values passed through the --gas flag.
@@ -111,7 +136,7 @@ func (v *GasSetting) Set(s string) (err error) {
 
 func (v *GasSetting) String() string {
 	if v.Simulate {
-		return GasFlagSimulate
+		return GasFlagAuto
 	}
 	return strconv.FormatInt(v.Gas, 10)
 }
@@ -121,12 +146,12 @@ func ReadGasFlag(s string) (simulate bool, gas int64, err error) {
 	switch s {
 	case "":
 		gas = DefaultGasLimit
-	case GasFlagSimulate:
+	case GasFlagAuto, GasFlagSimulate:
 		simulate = true
 	default:
 		gas, err = strconv.ParseInt(s, 10, 64)
 		if err != nil {
-			err = fmt.Errorf("gas must be either integer or %q", GasFlagSimulate)
+			err = fmt.Errorf("gas must be either integer or %q", GasFlagAuto)
 			return
 		}
 	}
@@ -18,5 +18,5 @@ func GetKeyBase(db dbm.DB) keys.Keybase {
 // useful for --dry-run to generate a seed phrase without
 // storing the key
 func MockKeyBase() keys.Keybase {
-	return GetKeyBase(dbm.NewMemDB())
+	return keys.NewInMemory()
 }
@@ -18,12 +18,14 @@ import (
 )
 
 const (
-	flagType     = "type"
-	flagRecover  = "recover"
-	flagNoBackup = "no-backup"
-	flagDryRun   = "dry-run"
-	flagAccount  = "account"
-	flagIndex    = "index"
+	flagType      = "type"
+	flagRecover   = "recover"
+	flagNoBackup  = "no-backup"
+	flagDryRun    = "dry-run"
+	flagAccount   = "account"
+	flagIndex     = "index"
+	flagRemote    = "remote"
+	flagRemoteKey = "remote-key"
 )
 
 func addKeyCommand() *cobra.Command {
@@ -42,6 +44,9 @@ phrase, otherwise, a new key will be generated.`,
 	cmd.Flags().Bool(flagDryRun, false, "Perform action, but don't add key to local keystore")
 	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
 	cmd.Flags().Uint32(flagIndex, 0, "Index number for HD derivation")
+	cmd.Flags().String(flagRemote, "", "Store a reference to a key held by the remote signer at this endpoint (https://<host>:<port> or unix://<socket>)")
+	cmd.Flags().String(flagRemoteKey, "", "Name of the key on the remote signer; defaults to <name>")
+	addRemoteSignerTLSFlags(cmd)
 	return cmd
 }
 
@@ -77,8 +82,9 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		// ask for a password when generating a local key
-		if !viper.GetBool(client.FlagUseLedger) {
+		// ask for a password when generating a local key, unless the keyring
+		// encrypts it
+		if !viper.GetBool(client.FlagUseLedger) && viper.GetString(flagRemote) == "" && !keyringEncrypted() {
 			pass, err = client.GetCheckPassword(
 				"Enter a passphrase for your key:",
 				"Repeat the passphrase:", buf)
@@ -98,6 +104,17 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		printCreate(info, "")
+	} else if endpoint := viper.GetString(flagRemote); endpoint != "" {
+		remoteName := viper.GetString(flagRemoteKey)
+		if remoteName == "" {
+			remoteName = name
+		}
+		info, err := kb.CreateRemote(name, endpoint, remoteName, remoteSignerTLSFromFlags())
+		if err != nil {
+			return err
+		}
+		viper.Set(flagNoBackup, true)
+		printCreate(info, "")
 	} else if viper.GetBool(flagRecover) {
 		seed, err := client.GetSeed(
 			"Enter your recovery seed phrase:", buf)
@@ -199,7 +216,7 @@ func AddNewKeyRequestHandler(indent bool) http.HandlerFunc {
 			w.Write([]byte(err.Error()))
 			return
 		}
-		if m.Password == "" {
+		if m.Password == "" && !keyringEncrypted() {
 			w.WriteHeader(http.StatusBadRequest)
 			err = errMissingPassword()
 			w.Write([]byte(err.Error()))
@@ -296,7 +313,7 @@ func RecoverRequestHandler(indent bool) http.HandlerFunc {
 			w.Write([]byte(err.Error()))
 			return
 		}
-		if m.Password == "" {
+		if m.Password == "" && !keyringEncrypted() {
 			w.WriteHeader(http.StatusBadRequest)
 			err = errMissingPassword()
 			w.Write([]byte(err.Error()))
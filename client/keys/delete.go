@@ -30,14 +30,17 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	_, err = kb.Get(name)
+	info, err := kb.Get(name)
 	if err != nil {
 		return err
 	}
 
+	prompt := "DANGER - enter password to permanently delete key:"
+	if !keys.NeedsPassphrase(info) {
+		prompt = "DANGER - enter 'yes' to permanently delete key:"
+	}
 	buf := client.BufferStdin()
-	oldpass, err := client.GetPassword(
-		"DANGER - enter password to permanently delete key:", buf)
+	oldpass, err := client.GetPassword(prompt, buf)
 	if err != nil {
 		return err
 	}
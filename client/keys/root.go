@@ -2,8 +2,10 @@ package keys
 
 import (
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // Commands registers a sub-tree of commands to interact with
@@ -25,7 +27,11 @@ func Commands() *cobra.Command {
 		client.LineBreak,
 		deleteKeyCommand(),
 		updateKeyCommand(),
+		client.LineBreak,
+		remoteSignerCommand(),
 	)
+	cmd.PersistentFlags().String(client.FlagKeyringBackend, keys.BackendDB, "Keyring backend to store keys in (db|file|pass|memory)")
+	viper.BindPFlag(client.FlagKeyringBackend, cmd.PersistentFlags().Lookup(client.FlagKeyringBackend))
 	return cmd
 }
 
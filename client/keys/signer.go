@@ -0,0 +1,187 @@
+package keys
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+const (
+	flagSignerLaddr = "laddr"
+	flagAuditLog    = "audit-log"
+	flagTLSCert     = "tls-cert"
+	flagTLSKey      = "tls-key"
+	flagTLSCA       = "tls-ca"
+)
+
+func remoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer <name>...",
+		Short: "Serve signing requests for the given local keys",
+		Long: `Run a remote signer holding the given local keys, so that they can be used
+from other machines that referenced them via 'keys add --remote'.
+The passphrase of each key is asked for once, at startup. Every request is
+appended to the audit log as a JSON object, one per line.
+
+Signers listening on tcp:// are served over mutual TLS: --tls-cert and
+--tls-key are presented to the clients, which must present a certificate
+issued by the CA in --tls-ca.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runRemoteSignerCmd,
+	}
+	cmd.Flags().String(flagSignerLaddr, "unix://remote-signer.sock", "The address to listen on (tcp://<host>:<port> or unix://<socket>)")
+	cmd.Flags().String(flagAuditLog, "remote-signer-audit.log", "The file to append the audit log to")
+	addRemoteSignerTLSFlags(cmd)
+	return cmd
+}
+
+func runRemoteSignerCmd(cmd *cobra.Command, args []string) error {
+	kb, err := GetKeyBase()
+	if err != nil {
+		return err
+	}
+
+	buf := client.BufferStdin()
+	privKeys := make(map[string]crypto.PrivKey, len(args))
+	for _, name := range args {
+		info, err := kb.Get(name)
+		if err != nil {
+			return err
+		}
+		var passphrase string
+		if keys.NeedsPassphrase(info) {
+			passphrase, err = client.GetPassword(fmt.Sprintf("Password to unlock '%s':", name), buf)
+			if err != nil {
+				return err
+			}
+		}
+		priv, err := kb.ExportPrivateKeyObject(name, passphrase)
+		if err != nil {
+			return err
+		}
+		privKeys[name] = priv
+	}
+
+	audit, err := os.OpenFile(viper.GetString(flagAuditLog), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	listener, err := listenRemoteSigner(viper.GetString(flagSignerLaddr), remoteSignerTLSFromFlags())
+	if err != nil {
+		audit.Close() // nolint: errcheck
+		return err
+	}
+
+	server := &http.Server{Handler: keys.NewRemoteSigner(privKeys, audit)}
+	go func() {
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			fmt.Fprintf(os.Stderr, "remote signer stopped: %v\n", err)
+		}
+	}()
+	fmt.Fprintf(os.Stderr, "remote signer listening on %s\n", viper.GetString(flagSignerLaddr))
+
+	// wait forever and cleanup
+	cmn.TrapSignal(func() {
+		server.Close() // nolint: errcheck
+		audit.Close()  // nolint: errcheck
+	})
+	return nil
+}
+
+// addRemoteSignerTLSFlags adds the flags locating the mutual TLS files of a
+// remote signer or of its clients.
+func addRemoteSignerTLSFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTLSCert, "", "Certificate presented for mutual TLS (PEM)")
+	cmd.Flags().String(flagTLSKey, "", "Private key of the TLS certificate (PEM)")
+	cmd.Flags().String(flagTLSCA, "", "CA certificate the peer's TLS certificate must be issued by (PEM)")
+}
+
+func remoteSignerTLSFromFlags() keys.RemoteSignerTLS {
+	return keys.RemoteSignerTLS{
+		CertFile: viper.GetString(flagTLSCert),
+		KeyFile:  viper.GetString(flagTLSKey),
+		CAFile:   viper.GetString(flagTLSCA),
+	}
+}
+
+// listenRemoteSigner listens on a tcp:// or unix:// address. The signer signs
+// anything it is sent, so tcp listeners require mutual TLS, and unix sockets
+// are only accessible by the current user.
+func listenRemoteSigner(laddr string, tlsFiles keys.RemoteSignerTLS) (net.Listener, error) {
+	u, err := url.Parse(laddr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "tcp":
+		config, err := tlsFiles.ServerConfig()
+		if err != nil {
+			return nil, fmt.Errorf("listening on tcp:// requires --%s, --%s and --%s: %v",
+				flagTLSCert, flagTLSKey, flagTLSCA, err)
+		}
+		listener, err := net.Listen("tcp", u.Host)
+		if err != nil {
+			return nil, err
+		}
+		return tls.NewListener(listener, config), nil
+	case "unix":
+		return listenPrivateUnix(u.Host + u.Path)
+	default:
+		return nil, fmt.Errorf("unsupported listen address %q: expected tcp:// or unix://", laddr)
+	}
+}
+
+// listenPrivateUnix listens on a unix socket only accessible by the current
+// user. The socket is created inside a new 0700 directory, so that nobody else
+// can connect to it before its permissions are restricted, then moved to path.
+func listenPrivateUnix(path string) (net.Listener, error) {
+	dir, err := ioutil.TempDir(filepath.Dir(path), ".remote-signer")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	tmpPath := filepath.Join(dir, "signer.sock")
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	listener.SetUnlinkOnClose(false)
+
+	if err := os.Chmod(tmpPath, 0600); err != nil {
+		listener.Close() // nolint: errcheck
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		listener.Close() // nolint: errcheck
+		return nil, err
+	}
+	return unixListener{listener, path}, nil
+}
+
+// unixListener removes its socket when closed.
+type unixListener struct {
+	*net.UnixListener
+	path string
+}
+
+func (l unixListener) Close() error {
+	err := l.UnixListener.Close()
+	os.Remove(l.path) // nolint: errcheck
+	return err
+}
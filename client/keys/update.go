@@ -30,6 +30,13 @@ func runUpdateCmd(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	info, err := kb.Get(name)
+	if err != nil {
+		return err
+	}
+	if !keys.NeedsPassphrase(info) {
+		return fmt.Errorf("key %s has no passphrase of its own", name)
+	}
 	oldpass, err := client.GetPassword(
 		"Enter the current passphrase:", buf)
 	if err != nil {
@@ -2,13 +2,11 @@ package keys
 
 import (
 	"fmt"
-	"path/filepath"
 
 	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/tendermint/tendermint/libs/cli"
-	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/cosmos/cosmos-sdk/client"
 
@@ -45,9 +43,10 @@ func GetKeyInfo(name string) (keys.Info, error) {
 }
 
 // GetPassphrase returns a passphrase for a given name. It will first retrieve
-// the key info for that name if the type is local, it'll fetch input from
-// STDIN. Otherwise, an empty passphrase is returned. An error is returned if
-// the key info cannot be fetched or reading from STDIN fails.
+// the key info for that name if the key is local and encrypted with its own
+// passphrase, it'll fetch input from STDIN. Otherwise, an empty passphrase is
+// returned. An error is returned if the key info cannot be fetched or reading
+// from STDIN fails.
 func GetPassphrase(name string) (string, error) {
 	var passphrase string
 
@@ -56,9 +55,10 @@ func GetPassphrase(name string) (string, error) {
 		return passphrase, err
 	}
 
-	// we only need a passphrase for locally stored keys
+	// we only need a passphrase for locally stored keys, unless the keyring
+	// encrypts them
 	// TODO: (ref: #864) address security concerns
-	if keyInfo.GetType() == keys.TypeLocal {
+	if keys.NeedsPassphrase(keyInfo) {
 		passphrase, err = ReadPassphraseFromStdin(name)
 		if err != nil {
 			return passphrase, err
@@ -82,19 +82,43 @@ func ReadPassphraseFromStdin(name string) (string, error) {
 	return passphrase, nil
 }
 
-// initialize a keybase based on the configuration
+// initialize a keybase based on the configuration, using the backend
+// selected via --keyring-backend
 func GetKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
 	if keybase == nil {
-		db, err := dbm.NewGoLevelDB(KeyDBName, filepath.Join(rootDir, "keys"))
+		backend := viper.GetString(client.FlagKeyringBackend)
+		if backend == "" {
+			backend = keys.BackendDB
+		}
+
+		kb, err := keys.NewKeyring(backend, KeyDBName, rootDir, readKeyringPassphrase)
 		if err != nil {
 			return nil, err
 		}
-		keybase = client.GetKeyBase(db)
+		keybase = kb
 	}
 	return keybase, nil
 }
 
-// used to set the keybase manually in test
+// keyringEncrypted tells whether the keyring backend selected via
+// --keyring-backend encrypts keys itself, in which case keys have no
+// passphrase of their own.
+func keyringEncrypted() bool {
+	return keys.EncryptedBackend(viper.GetString(client.FlagKeyringBackend))
+}
+
+// readKeyringPassphrase prompts for the passphrase protecting a file keyring,
+// asking for confirmation when the keyring is being created.
+func readKeyringPassphrase(newKeyring bool) (string, error) {
+	buf := client.BufferStdin()
+	if newKeyring {
+		return client.GetCheckPassword(
+			"Enter a passphrase to encrypt the new keyring:", "Repeat the passphrase:", buf)
+	}
+	return client.GetPassword("Enter keyring passphrase:", buf)
+}
+
+// used to set the keybase manually in test, or to wrap it
 func SetKeyBase(kb keys.Keybase) {
 	keybase = kb
 }
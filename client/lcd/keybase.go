@@ -0,0 +1,27 @@
+package lcd
+
+import (
+	"fmt"
+
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+// restKeybase is the keybase used by the handlers of the LCD. Signing with an
+// offline key prompts for the signature on stdin, which a handler must never
+// do, so offline keys are refused.
+type restKeybase struct {
+	crkeys.Keybase
+}
+
+// Sign implements Keybase.
+func (kb restKeybase) Sign(name, passphrase string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.GetType() == crkeys.TypeOffline {
+		return nil, nil, fmt.Errorf("offline key %s cannot sign through the LCD", name)
+	}
+	return kb.Keybase.Sign(name, passphrase, msg)
+}
@@ -0,0 +1,22 @@
+package lcd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+func TestRestKeybaseRefusesOfflineKeys(t *testing.T) {
+	kb := restKeybase{crkeys.NewInMemory()}
+	info, _, err := kb.CreateMnemonic("local", crkeys.English, "1234", crkeys.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.CreateOffline("offline", info.GetPubKey())
+	require.NoError(t, err)
+
+	_, _, err = kb.Sign("local", "1234", []byte("msg"))
+	require.NoError(t, err)
+	_, _, err = kb.Sign("offline", "", []byte("msg"))
+	require.Error(t, err)
+}
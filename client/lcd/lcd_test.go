@@ -290,7 +290,7 @@ func TestCoinSend(t *testing.T) {
 	require.Equal(t, http.StatusInternalServerError, res.StatusCode, body)
 
 	// test failure with wrong adjustment
-	res, body, _ = doSendWithGas(t, port, seed, name, password, addr, "simulate", 0.1, "")
+	res, body, _ = doSendWithGas(t, port, seed, name, password, addr, "auto", 0.1, "")
 	require.Equal(t, http.StatusInternalServerError, res.StatusCode, body)
 
 	// run simulation and test success with estimated gas
@@ -341,7 +341,7 @@ func TestCoinSendGenerateSignAndBroadcast(t *testing.T) {
 	acc := getAccount(t, port, addr)
 
 	// generate TX
-	res, body, _ := doSendWithGas(t, port, seed, name, password, addr, "simulate", 0, "?generate_only=true")
+	res, body, _ := doSendWithGas(t, port, seed, name, password, addr, "auto", 0, "?generate_only=true")
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var msg auth.StdTx
 	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &msg))
@@ -577,13 +577,13 @@ func TestBonding(t *testing.T) {
 	require.Equal(t, int64(40), coins.AmountOf("steak").Int64())
 
 	unbonding := getUndelegation(t, port, addr, operAddrs[0])
-	require.Equal(t, "60", unbonding.Balance.Amount.String())
+	require.Equal(t, "60", unbonding.Entries[0].Balance.Amount.String())
 
 	summary = getDelegationSummary(t, port, addr)
 
 	require.Len(t, summary.Delegations, 0, "Delegation summary holds all delegations")
 	require.Len(t, summary.UnbondingDelegations, 1, "Delegation summary holds all unbonding-delegations")
-	require.Equal(t, "60", summary.UnbondingDelegations[0].Balance.Amount.String())
+	require.Equal(t, "60", summary.UnbondingDelegations[0].Entries[0].Balance.Amount.String())
 
 	bondedValidators = getDelegatorValidators(t, port, addr)
 	require.Len(t, bondedValidators, 0, "There's no delegation as the user withdraw all funds")
@@ -0,0 +1,197 @@
+package lcd
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// HeaderVerification reports how the data served by the LCD was obtained:
+	// VerificationProven if the response of the full node was checked against
+	// a verified header, VerificationTrusted if the node is trusted, and
+	// VerificationPartial if only part of it was checked, such as the store
+	// queries carrying a proof or the inclusion of transactions but not their
+	// results. Routes served by the LCD alone do not carry it.
+	HeaderVerification = "X-Cosmos-Verification"
+	// HeaderVerifiedHeight reports the height of the latest header verified by
+	// the lite client, when running as a verifying proxy.
+	HeaderVerifiedHeight = "X-Cosmos-Verified-Height"
+
+	VerificationProven  = "proven"
+	VerificationTrusted = "trusted"
+	VerificationPartial = "partial"
+
+	headerSyncInterval = 10 * time.Second
+)
+
+var (
+	// routes serving data of the node which cannot be proven against a
+	// verified header, rejected by a verifying proxy
+	unverifiableRoutes = map[string]bool{
+		"/node_info":    true,
+		"/node_version": true,
+		"/syncing":      true,
+		"/websocket":    true,
+	}
+
+	// routes serving transactions whose inclusion in a block is proven, but
+	// neither their results nor the completeness of a search
+	partiallyVerifiedRoutes = map[string]bool{
+		"/txs/{hash}": true,
+		"/txs":        true,
+	}
+
+	// prefixes of the routes served by the LCD without querying the node
+	localRoutePrefixes = []string{"/version", "/keys", "/swagger-ui/"}
+)
+
+// verificationStatus returns the value of the verification header for the
+// given context.
+func verificationStatus(cliCtx context.CLIContext) string {
+	switch {
+	case cliCtx.TrustNode:
+		return VerificationTrusted
+	case cliCtx.RequireProofs:
+		return VerificationProven
+	default:
+		return VerificationPartial
+	}
+}
+
+// headerTracker keeps the lite verifier in sync with the latest headers of the
+// chain, so that proofs of recent queries can be checked without first
+// catching up on the validator set changes.
+type headerTracker struct {
+	cliCtx context.CLIContext
+	logger log.Logger
+
+	mtx    sync.RWMutex
+	height int64
+}
+
+func newHeaderTracker(cliCtx context.CLIContext, logger log.Logger) *headerTracker {
+	return &headerTracker{cliCtx: cliCtx, logger: logger}
+}
+
+// start verifies the latest header every headerSyncInterval, until quit is
+// closed.
+func (t *headerTracker) start(quit <-chan struct{}) {
+	go func() {
+		ticker := time.NewTicker(headerSyncInterval)
+		defer ticker.Stop()
+		for {
+			if err := t.update(); err != nil {
+				t.logger.Error("failed to verify latest header", "err", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-quit:
+				return
+			}
+		}
+	}()
+}
+
+// update verifies the latest header of the node.
+func (t *headerTracker) update() error {
+	node, err := t.cliCtx.GetNode()
+	if err != nil {
+		return err
+	}
+	status, err := node.Status()
+	if err != nil {
+		return err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight
+	if height <= t.verifiedHeight() {
+		return nil
+	}
+	if _, err := t.cliCtx.Verify(height); err != nil {
+		return err
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if height > t.height {
+		t.height = height
+	}
+	return nil
+}
+
+// verifiedHeight returns the height of the latest verified header, or 0 if
+// none was verified yet.
+func (t *headerTracker) verifiedHeight() int64 {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	return t.height
+}
+
+// verificationHeaders returns a middleware reporting the verification status
+// in the headers of the responses served from the data of the node. With
+// VerificationProven, the routes which cannot be proven are rejected and the
+// ones which are only partially proven, as well as the transactions sent to
+// the node, are reported as VerificationPartial. The tracker may be nil.
+func verificationHeaders(status string, tracker *headerTracker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := routeTemplate(r)
+			if isLocalRoute(route) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			routeStatus := status
+			if status == VerificationProven {
+				switch {
+				case unverifiableRoutes[route]:
+					utils.WriteErrorResponse(w, http.StatusForbidden, context.ErrUnverifiableQuery(route).Error())
+					return
+				case partiallyVerifiedRoutes[route] || r.Method != http.MethodGet:
+					routeStatus = VerificationPartial
+				}
+			}
+
+			w.Header().Set(HeaderVerification, routeStatus)
+			if tracker != nil {
+				if height := tracker.verifiedHeight(); height > 0 {
+					w.Header().Set(HeaderVerifiedHeight, strconv.FormatInt(height, 10))
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// routeTemplate returns the path template of the route matching the request,
+// or an empty string if the request was not routed.
+func routeTemplate(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return template
+}
+
+// isLocalRoute tells whether the route is served by the LCD without querying
+// the node.
+func isLocalRoute(route string) bool {
+	for _, prefix := range localRoutePrefixes {
+		if strings.HasPrefix(route, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package lcd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+func TestVerificationStatus(t *testing.T) {
+	cliCtx := context.CLIContext{}
+	require.Equal(t, VerificationPartial, verificationStatus(cliCtx))
+	require.Equal(t, VerificationProven, verificationStatus(cliCtx.WithRequireProofs(true)))
+	require.Equal(t, VerificationTrusted, verificationStatus(cliCtx.WithTrustNode(true).WithRequireProofs(true)))
+}
+
+func TestVerificationHeaders(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	verificationHeaders(VerificationTrusted, nil)(ok).ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	require.Equal(t, VerificationTrusted, rec.Header().Get(HeaderVerification))
+	require.Empty(t, rec.Header().Get(HeaderVerifiedHeight))
+
+	// the height is only reported once a header was verified
+	tracker := &headerTracker{}
+	handler := verificationHeaders(VerificationProven, tracker)(ok)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	require.Empty(t, rec.Header().Get(HeaderVerifiedHeight))
+
+	tracker.height = 42
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	require.Equal(t, VerificationProven, rec.Header().Get(HeaderVerification))
+	require.Equal(t, "42", rec.Header().Get(HeaderVerifiedHeight))
+}
+
+func TestVerificationHeadersPerRoute(t *testing.T) {
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	newRouter := func(status string) *mux.Router {
+		r := mux.NewRouter()
+		r.Use(verificationHeaders(status, nil))
+		r.HandleFunc("/keys/{name}", ok).Methods("GET")
+		r.HandleFunc("/node_info", ok).Methods("GET")
+		r.HandleFunc("/txs/{hash}", ok).Methods("GET")
+		r.HandleFunc("/txs", ok).Methods("POST")
+		r.HandleFunc("/auth/accounts/{address}", ok).Methods("GET")
+		return r
+	}
+	serve := func(r *mux.Router, method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	// a verifying proxy rejects the routes which cannot be proven and reports
+	// the ones which are only partially proven
+	r := newRouter(VerificationProven)
+	rec := serve(r, "GET", "/node_info")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Empty(t, rec.Header().Get(HeaderVerification))
+	require.Equal(t, VerificationPartial, serve(r, "GET", "/txs/AB").Header().Get(HeaderVerification))
+	require.Equal(t, VerificationPartial, serve(r, "POST", "/txs").Header().Get(HeaderVerification))
+	require.Equal(t, VerificationProven, serve(r, "GET", "/auth/accounts/cosmos1").Header().Get(HeaderVerification))
+
+	// local routes carry no verification status
+	rec = serve(r, "GET", "/keys/foo")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get(HeaderVerification))
+
+	// without verification the status of every route is the one of the LCD
+	r = newRouter(VerificationTrusted)
+	rec = serve(r, "GET", "/node_info")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, VerificationTrusted, rec.Header().Get(HeaderVerification))
+	require.Equal(t, VerificationTrusted, serve(r, "GET", "/txs/AB").Header().Get(HeaderVerification))
+}
@@ -12,8 +12,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	authcli "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	slashing "github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	stake "github.com/cosmos/cosmos-sdk/x/stake/client/rest"
@@ -34,6 +37,9 @@ const (
 	flagSSLHosts           = "ssl-hosts"
 	flagSSLCertFile        = "ssl-certfile"
 	flagSSLKeyFile         = "ssl-keyfile"
+	flagVerify             = "verify"
+	flagWSAllowedOrigins   = "ws-allowed-origins"
+	flagWSMaxConnections   = "ws-max-connections"
 )
 
 // ServeCommand will generate a long-running rest server
@@ -44,11 +50,24 @@ func ServeCommand(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rest-server",
 		Short: "Start LCD (light-client daemon), a local REST server",
+		Long: `Start LCD (light-client daemon), a local REST server.
+With --verify, the LCD runs as a verifying proxy: it keeps track of the chain
+headers through the lite client, checks the proof of every response from the
+full node against them, and rejects the queries for which the node provides
+no proof. The verification status is reported in the X-Cosmos-Verification
+and X-Cosmos-Verified-Height headers of the responses served from the data of
+the node: transactions are only partially proven, as their inclusion in a
+block is verified but not their results.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if viper.GetBool(flagVerify) && viper.GetBool(client.FlagTrustNode) {
+				return errors.New("--verify and --trust-node are mutually exclusive")
+			}
+
 			listenAddr := viper.GetString(flagListenAddr)
-			handler := createHandler(cdc)
-			registerSwaggerUI(handler)
 			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
+			quit := make(chan struct{})
+			handler := createHandler(cdc, logger, quit)
+			registerSwaggerUI(handler)
 			maxOpen := viper.GetInt(flagMaxOpenConnections)
 			sslHosts := viper.GetString(flagSSLHosts)
 			certFile := viper.GetString(flagSSLCertFile)
@@ -105,6 +124,7 @@ func ServeCommand(cdc *codec.Codec) *cobra.Command {
 			// wait forever and cleanup
 			cmn.TrapSignal(func() {
 				defer cleanupFunc()
+				close(quit)
 				err := listener.Close()
 				logger.Error("error closing listener", "err", err)
 			})
@@ -123,7 +143,11 @@ func ServeCommand(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "Address of the node to connect to")
 	cmd.Flags().Int(flagMaxOpenConnections, 1000, "The number of maximum open connections")
 	cmd.Flags().Bool(client.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	cmd.Flags().Bool(flagVerify, false, "Run as a verifying proxy: verify every response and reject queries that cannot be proven")
+	cmd.Flags().StringSlice(flagWSAllowedOrigins, nil, "Comma-separated origins of the pages allowed to open websocket connections besides the LCD itself (* for all)")
+	cmd.Flags().Int(flagWSMaxConnections, 100, "The number of maximum open websocket connections (0 for no limit)")
 	cmd.Flags().Bool(client.FlagIndentResponse, false, "Add indent to JSON response")
+	cmd.Flags().String(client.FlagKeyringBackend, crkeys.BackendDB, "Keyring backend to load keys from (db|file|pass|memory)")
 	viper.BindPFlag(client.FlagTrustNode, cmd.Flags().Lookup(client.FlagTrustNode))
 	viper.BindPFlag(client.FlagChainID, cmd.Flags().Lookup(client.FlagChainID))
 	viper.BindPFlag(client.FlagNode, cmd.Flags().Lookup(client.FlagNode))
@@ -131,15 +155,34 @@ func ServeCommand(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
-func createHandler(cdc *codec.Codec) *mux.Router {
+// createHandler returns the router of the LCD. The background tasks of a
+// verifying proxy run until quit is closed.
+func createHandler(cdc *codec.Codec, logger log.Logger, quit <-chan struct{}) *mux.Router {
 	r := mux.NewRouter()
 
+	// open the keybase before serving, so that a keyring passphrase is only
+	// ever prompted for on startup and never from a handler
 	kb, err := keys.GetKeyBase() //XXX
 	if err != nil {
 		panic(err)
 	}
+	kb = restKeybase{kb}
+	keys.SetKeyBase(kb)
+
+	verify := viper.GetBool(flagVerify)
+	if verify {
+		// make sure a lite verifier gets created
+		viper.Set(client.FlagTrustNode, false)
+	}
+
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithRequireProofs(verify)
 
-	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	var tracker *headerTracker
+	if verify {
+		tracker = newHeaderTracker(cliCtx, logger.With("module", "lite"))
+		tracker.start(quit)
+	}
+	r.Use(verificationHeaders(verificationStatus(cliCtx), tracker))
 
 	// TODO: make more functional? aka r = keys.RegisterRoutes(r)
 	r.HandleFunc("/version", CLIVersionRequestHandler).Methods("GET")
@@ -152,8 +195,17 @@ func createHandler(cdc *codec.Codec) *mux.Router {
 	bank.RegisterRoutes(cliCtx, r, cdc, kb)
 	stake.RegisterRoutes(cliCtx, r, cdc, kb)
 	slashing.RegisterRoutes(cliCtx, r, cdc, kb)
+	distr.RegisterRoutes(cliCtx, r, cdc, kb)
 	gov.RegisterRoutes(cliCtx, r, cdc)
 
+	wsConfig := WSConfig{
+		AllowedOrigins: viper.GetStringSlice(flagWSAllowedOrigins),
+		MaxConnections: viper.GetInt(flagWSMaxConnections),
+	}
+	r.HandleFunc("/websocket", WebsocketHandlerFn(
+		cdc, cliCtx.WithAccountDecoder(authcli.GetAccountDecoder(cdc)), logger.With("module", "websocket"), wsConfig,
+	)).Methods("GET")
+
 	return r
 }
 
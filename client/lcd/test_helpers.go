@@ -268,7 +268,7 @@ func startTM(
 //
 // NOTE: This causes the thread to block.
 func startLCD(logger log.Logger, listenAddr string, cdc *codec.Codec) (net.Listener, error) {
-	return tmrpc.StartHTTPServer(listenAddr, createHandler(cdc), logger, tmrpc.Config{})
+	return tmrpc.StartHTTPServer(listenAddr, createHandler(cdc, logger, nil), logger, tmrpc.Config{})
 }
 
 // Request makes a test LCD test request. It returns a response object and a
@@ -0,0 +1,496 @@
+package lcd
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/log"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// Subscription channels of the WebSocket API
+const (
+	ChannelBlocks   = "blocks"
+	ChannelTxs      = "txs"
+	ChannelBalances = "balances"
+)
+
+const (
+	wsSubscriber = "lcd"
+
+	// maximum size of a request read from a client
+	wsReadLimit = 4096
+	// time allowed to read the next pong from a client
+	wsPongWait = 60 * time.Second
+	// period of the pings sent to a client, must be less than wsPongWait
+	wsPingPeriod = wsPongWait * 9 / 10
+	// time allowed to write a message to a client
+	wsWriteWait = 10 * time.Second
+)
+
+// WSConfig configures the WebSocket API.
+type WSConfig struct {
+	// AllowedOrigins are the origins, besides the one of the LCD itself, of
+	// the pages allowed to connect; "*" allows any origin. Clients which send
+	// no Origin header, i.e. which are not browsers, are always allowed.
+	AllowedOrigins []string
+	// MaxConnections is the maximum number of open connections, 0 for no
+	// limit.
+	MaxConnections int
+}
+
+// WSRequest is a message sent by WebSocket clients. Subscribe requests need a
+// channel, along with the tags the transactions must match for the txs
+// channel, or the addresses to watch for the balances channel. The ID is
+// chosen by the client and identifies the subscription in later events and
+// unsubscribe requests.
+type WSRequest struct {
+	Type      string   `json:"type"` // subscribe or unsubscribe
+	ID        string   `json:"id"`
+	Channel   string   `json:"channel,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// WSResponse is a message sent to WebSocket clients: either the
+// acknowledgement of a request, an event of a subscription or an error.
+type WSResponse struct {
+	ID    string          `json:"id"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// BlockEvent is sent to the subscribers of the blocks channel.
+type BlockEvent struct {
+	Header tmtypes.Header `json:"header"`
+	Txs    []sdk.Tx       `json:"txs"`
+}
+
+// BalanceEvent is sent to the subscribers of the balances channel whenever
+// the coins of a watched account change, and once upon subscription with a
+// zero height.
+type BalanceEvent struct {
+	Address sdk.AccAddress `json:"address"`
+	Height  int64          `json:"height"`
+	Coins   sdk.Coins      `json:"coins"`
+}
+
+// WebsocketHandlerFn serves the WebSocket subscription API. Each connection
+// holds its own subscriptions to the events of the full node.
+func WebsocketHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, logger log.Logger, config WSConfig) http.HandlerFunc {
+	upgrader := websocket.Upgrader{CheckOrigin: checkOrigin(config.AllowedOrigins)}
+
+	var slots chan struct{}
+	if config.MaxConnections > 0 {
+		slots = make(chan struct{}, config.MaxConnections)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if slots != nil {
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			default:
+				utils.WriteErrorResponse(w, http.StatusServiceUnavailable, "too many open websocket connections")
+				return
+			}
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader has already replied
+			return
+		}
+
+		node := rpcclient.NewHTTP(cliCtx.NodeURI, "/websocket")
+		if err := node.Start(); err != nil {
+			conn.WriteJSON(WSResponse{Type: "error", Error: err.Error()}) // nolint: errcheck
+			conn.Close()                                                  // nolint: errcheck
+			return
+		}
+
+		s := &wsSession{
+			cdc:     cdc,
+			cliCtx:  cliCtx,
+			logger:  logger,
+			conn:    conn,
+			node:    node,
+			subs:    make(map[string]*wsSubscription),
+			cancels: make(map[string]gocontext.CancelFunc),
+		}
+		s.serve()
+	}
+}
+
+type wsSession struct {
+	cdc    *codec.Codec
+	cliCtx context.CLIContext
+	logger log.Logger
+	conn   *websocket.Conn
+	node   *rpcclient.HTTP
+
+	writeMtx sync.Mutex
+
+	mtx     sync.Mutex
+	subs    map[string]*wsSubscription      // subscription ID -> subscription
+	cancels map[string]gocontext.CancelFunc // tendermint query -> node subscription
+}
+
+// wsSubscription is a subscription of a client. The node only delivers the
+// events of a query once per connection, so the subscriptions sharing a query
+// share a single node subscription.
+type wsSubscription struct {
+	query  string
+	handle func(interface{})
+}
+
+// checkOrigin returns the origin check of the WebSocket upgrader, accepting
+// the requests of non-browser clients, same-origin requests and the requests
+// from the allowed origins.
+func checkOrigin(allowedOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(u.Host, r.Host) {
+			return true
+		}
+		for _, allowed := range allowedOrigins {
+			if allowed == "*" || strings.EqualFold(allowed, origin) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// serve handles the requests of the client until the connection is closed,
+// or until the client stops answering pings.
+func (s *wsSession) serve() {
+	defer s.close()
+
+	s.conn.SetReadLimit(wsReadLimit)
+	s.conn.SetReadDeadline(time.Now().Add(wsPongWait)) // nolint: errcheck
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+	quit := make(chan struct{})
+	defer close(quit)
+	go s.ping(quit)
+
+	for {
+		var req WSRequest
+		if err := s.conn.ReadJSON(&req); err != nil {
+			if _, ok := err.(*websocket.CloseError); !ok {
+				s.logger.Debug("closing websocket", "err", err)
+			}
+			return
+		}
+
+		var err error
+		switch req.Type {
+		case "subscribe":
+			err = s.subscribe(req)
+		case "unsubscribe":
+			err = s.unsubscribe(req.ID)
+		default:
+			err = fmt.Errorf("unknown request type %q", req.Type)
+		}
+		if err != nil {
+			s.send(WSResponse{ID: req.ID, Type: "error", Error: err.Error()})
+			continue
+		}
+		s.send(WSResponse{ID: req.ID, Type: req.Type})
+	}
+}
+
+func (s *wsSession) close() {
+	s.mtx.Lock()
+	for _, cancel := range s.cancels {
+		cancel()
+	}
+	s.mtx.Unlock()
+
+	s.node.UnsubscribeAll(gocontext.Background(), wsSubscriber) // nolint: errcheck
+	s.node.Stop()                                               // nolint: errcheck
+	s.conn.Close()                                              // nolint: errcheck
+}
+
+// ping keeps the connection alive by pinging the client every wsPingPeriod,
+// until quit is closed.
+func (s *wsSession) ping(quit <-chan struct{}) {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// control messages may be written concurrently with other messages
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				s.logger.Debug("failed to ping websocket", "err", err)
+				return
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+func (s *wsSession) send(res WSResponse) {
+	s.writeMtx.Lock()
+	defer s.writeMtx.Unlock()
+	s.conn.SetWriteDeadline(time.Now().Add(wsWriteWait)) // nolint: errcheck
+	if err := s.conn.WriteJSON(res); err != nil {
+		s.logger.Debug("failed to write to websocket", "err", err)
+	}
+}
+
+// sendEvent sends the amino JSON encoding of an event.
+func (s *wsSession) sendEvent(id, eventType string, event interface{}) {
+	bz, err := s.cdc.MarshalJSON(event)
+	if err != nil {
+		s.send(WSResponse{ID: id, Type: "error", Error: err.Error()})
+		return
+	}
+	s.send(WSResponse{ID: id, Type: eventType, Data: bz})
+}
+
+func (s *wsSession) subscribe(req WSRequest) error {
+	if req.ID == "" {
+		return fmt.Errorf("missing subscription id")
+	}
+
+	query, handle, err := s.prepareSubscription(req)
+	if err != nil {
+		return err
+	}
+	q, err := tmquery.New(query)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, ok := s.subs[req.ID]; ok {
+		return fmt.Errorf("subscription id %s already in use", req.ID)
+	}
+
+	if _, ok := s.cancels[query]; !ok {
+		ctx, cancel := gocontext.WithCancel(gocontext.Background())
+		out := make(chan interface{}, 100)
+		if err := s.node.Subscribe(ctx, wsSubscriber, q, out); err != nil {
+			cancel()
+			return err
+		}
+		s.cancels[query] = cancel
+		go s.dispatch(ctx, query, out)
+	}
+	s.subs[req.ID] = &wsSubscription{query: query, handle: handle}
+
+	if req.Channel == ChannelBalances {
+		go handle(nil)
+	}
+	return nil
+}
+
+// dispatch hands the events of a node subscription to the subscriptions of
+// the client sharing its query, until the subscription is cancelled or closed
+// by the node.
+func (s *wsSession) dispatch(ctx gocontext.Context, query string, out <-chan interface{}) {
+	for {
+		select {
+		case data, ok := <-out:
+			if !ok {
+				return
+			}
+			s.mtx.Lock()
+			var handles []func(interface{})
+			for _, sub := range s.subs {
+				if sub.query == query {
+					handles = append(handles, sub.handle)
+				}
+			}
+			s.mtx.Unlock()
+
+			for _, handle := range handles {
+				handle(data)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *wsSession) unsubscribe(id string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	sub, ok := s.subs[id]
+	if !ok {
+		return fmt.Errorf("unknown subscription id %s", id)
+	}
+	delete(s.subs, id)
+
+	// keep the node subscription while other subscriptions share its query
+	for _, other := range s.subs {
+		if other.query == sub.query {
+			return nil
+		}
+	}
+	s.cancels[sub.query]()
+	delete(s.cancels, sub.query)
+	return s.node.Unsubscribe(gocontext.Background(), wsSubscriber, tmquery.MustParse(sub.query))
+}
+
+// prepareSubscription returns the Tendermint query backing a subscription
+// request, and the function translating its events. For the balances channel,
+// the function is also called with nil to send the current balances.
+func (s *wsSession) prepareSubscription(req WSRequest) (string, func(interface{}), error) {
+	switch req.Channel {
+	case ChannelBlocks:
+		return tmtypes.EventQueryNewBlock.String(), func(data interface{}) {
+			if block, ok := data.(tmtypes.EventDataNewBlock); ok {
+				s.sendBlock(req.ID, block.Block)
+			}
+		}, nil
+
+	case ChannelTxs:
+		query := tmtypes.EventQueryTx.String()
+		if len(req.Tags) > 0 {
+			query = fmt.Sprintf("%s AND %s", query, tx.TagsQuery(req.Tags))
+		}
+		return query, func(data interface{}) {
+			if event, ok := data.(tmtypes.EventDataTx); ok {
+				s.sendTx(req.ID, event.TxResult)
+			}
+		}, nil
+
+	case ChannelBalances:
+		if len(req.Addresses) == 0 {
+			return "", nil, fmt.Errorf("at least one address to watch is required")
+		}
+		addrs := make([]sdk.AccAddress, len(req.Addresses))
+		for i, bech32 := range req.Addresses {
+			addr, err := sdk.AccAddressFromBech32(bech32)
+			if err != nil {
+				return "", nil, err
+			}
+			addrs[i] = addr
+		}
+
+		watcher := newBalanceWatcher(addrs)
+		return tmtypes.EventQueryNewBlockHeader.String(), func(data interface{}) {
+			switch event := data.(type) {
+			case nil:
+				// snapshot sent upon subscription
+				s.sendBalances(req.ID, watcher, 0)
+			case tmtypes.EventDataNewBlockHeader:
+				s.sendBalances(req.ID, watcher, event.Header.Height)
+			}
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("unknown channel %q", req.Channel)
+	}
+}
+
+func (s *wsSession) sendBlock(id string, block *tmtypes.Block) {
+	event := BlockEvent{Header: block.Header, Txs: make([]sdk.Tx, 0, len(block.Txs))}
+	for _, txBytes := range block.Txs {
+		var stdTx auth.StdTx
+		if err := s.cdc.UnmarshalBinary(txBytes, &stdTx); err != nil {
+			// a tx the SDK cannot decode must not hide the rest of the block
+			s.logger.Info("skipping undecodable tx", "height", block.Height, "hash", txBytes.Hash(), "err", err)
+			continue
+		}
+		event.Txs = append(event.Txs, stdTx)
+	}
+	s.sendEvent(id, "block", event)
+}
+
+func (s *wsSession) sendTx(id string, res tmtypes.TxResult) {
+	info, err := tx.FormatTxEvent(s.cdc, res)
+	if err != nil {
+		s.send(WSResponse{ID: id, Type: "error", Error: err.Error()})
+		return
+	}
+	s.sendEvent(id, "tx", info)
+}
+
+// sendBalances sends the balances of the watched accounts that changed since
+// the last call.
+func (s *wsSession) sendBalances(id string, watcher *balanceWatcher, height int64) {
+	watcher.mtx.Lock()
+	defer watcher.mtx.Unlock()
+
+	for _, addr := range watcher.addrs {
+		coins, err := s.queryCoins(addr)
+		if err != nil {
+			s.send(WSResponse{ID: id, Type: "error", Error: err.Error()})
+			continue
+		}
+		if !watcher.update(addr, coins) {
+			continue
+		}
+		s.sendEvent(id, "balance", BalanceEvent{Address: addr, Height: height, Coins: coins})
+	}
+}
+
+// queryCoins returns the coins held by an account, which are empty if the
+// account does not exist.
+func (s *wsSession) queryCoins(addr sdk.AccAddress) (sdk.Coins, error) {
+	res, err := s.cliCtx.QueryStore(auth.AddressStoreKey(addr), s.cliCtx.AccountStore)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	acc, err := s.cliCtx.AccDecoder(res)
+	if err != nil {
+		return nil, err
+	}
+	return acc.GetCoins(), nil
+}
+
+// balanceWatcher remembers the last balances sent for a set of accounts.
+type balanceWatcher struct {
+	mtx   sync.Mutex
+	addrs []sdk.AccAddress
+	last  map[string]sdk.Coins
+}
+
+func newBalanceWatcher(addrs []sdk.AccAddress) *balanceWatcher {
+	return &balanceWatcher{addrs: addrs, last: make(map[string]sdk.Coins, len(addrs))}
+}
+
+// update records the balance of an account and returns whether it changed.
+func (w *balanceWatcher) update(addr sdk.AccAddress, coins sdk.Coins) bool {
+	last, ok := w.last[addr.String()]
+	if ok && last.IsEqual(coins) {
+		return false
+	}
+	w.last[addr.String()] = coins
+	return true
+}
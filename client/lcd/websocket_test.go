@@ -0,0 +1,110 @@
+package lcd
+
+import (
+	gocontext "context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPrepareSubscription(t *testing.T) {
+	s := &wsSession{}
+
+	query, _, err := s.prepareSubscription(WSRequest{ID: "1", Channel: ChannelBlocks})
+	require.NoError(t, err)
+	require.Equal(t, "tm.event='NewBlock'", query)
+
+	query, _, err = s.prepareSubscription(WSRequest{ID: "2", Channel: ChannelTxs})
+	require.NoError(t, err)
+	require.Equal(t, "tm.event='Tx'", query)
+
+	query, _, err = s.prepareSubscription(WSRequest{ID: "3", Channel: ChannelTxs, Tags: []string{"action='send'", "sender='cosmos1'"}})
+	require.NoError(t, err)
+	require.Equal(t, "tm.event='Tx' AND action='send' AND sender='cosmos1'", query)
+
+	_, _, err = s.prepareSubscription(WSRequest{ID: "4", Channel: ChannelBalances})
+	require.Error(t, err)
+	_, _, err = s.prepareSubscription(WSRequest{ID: "5", Channel: ChannelBalances, Addresses: []string{"invalid"}})
+	require.Error(t, err)
+	_, _, err = s.prepareSubscription(WSRequest{ID: "6", Channel: "unknown"})
+	require.Error(t, err)
+}
+
+func TestDispatchSharedQuery(t *testing.T) {
+	received := make(chan string, 3)
+	handler := func(id string) func(interface{}) {
+		return func(interface{}) { received <- id }
+	}
+	s := &wsSession{subs: map[string]*wsSubscription{
+		"1": {query: "tm.event='NewBlockHeader'", handle: handler("1")},
+		"2": {query: "tm.event='NewBlockHeader'", handle: handler("2")},
+		"3": {query: "tm.event='NewBlock'", handle: handler("3")},
+	}}
+
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	out := make(chan interface{}, 1)
+	done := make(chan struct{})
+	go func() {
+		s.dispatch(ctx, "tm.event='NewBlockHeader'", out)
+		close(done)
+	}()
+	out <- struct{}{}
+
+	// both subscriptions sharing the query receive the event
+	ids := map[string]bool{<-received: true, <-received: true}
+	require.Equal(t, map[string]bool{"1": true, "2": true}, ids)
+
+	cancel()
+	<-done
+	require.Empty(t, received)
+}
+
+func TestDispatchClosedSubscription(t *testing.T) {
+	received := make(chan interface{}, 1)
+	s := &wsSession{subs: map[string]*wsSubscription{
+		"1": {query: "tm.event='NewBlockHeader'", handle: func(data interface{}) { received <- data }},
+	}}
+
+	// dispatching stops once the node closes the subscription
+	out := make(chan interface{})
+	close(out)
+	s.dispatch(gocontext.Background(), "tm.event='NewBlockHeader'", out)
+	require.Empty(t, received)
+}
+
+func TestCheckOrigin(t *testing.T) {
+	allowed := func(origin string) bool {
+		r := httptest.NewRequest("GET", "http://localhost:1317/websocket", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return checkOrigin([]string{"https://wallet.example.com"})(r)
+	}
+
+	// non-browser clients and same-origin pages are always allowed
+	require.True(t, allowed(""))
+	require.True(t, allowed("http://localhost:1317"))
+	require.True(t, allowed("https://wallet.example.com"))
+	require.False(t, allowed("https://evil.example.com"))
+	require.False(t, allowed("://invalid"))
+
+	r := httptest.NewRequest("GET", "http://localhost:1317/websocket", nil)
+	r.Header.Set("Origin", "https://evil.example.com")
+	require.True(t, checkOrigin([]string{"*"})(r))
+	require.False(t, checkOrigin(nil)(r))
+}
+
+func TestBalanceWatcher(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	w := newBalanceWatcher([]sdk.AccAddress{addr})
+
+	// the first balance is always reported, even if empty
+	require.True(t, w.update(addr, sdk.Coins{}))
+	require.False(t, w.update(addr, sdk.Coins{}))
+	require.True(t, w.update(addr, sdk.Coins{sdk.NewInt64Coin("steak", 1)}))
+	require.False(t, w.update(addr, sdk.Coins{sdk.NewInt64Coin("steak", 1)}))
+	require.True(t, w.update(addr, sdk.Coins{sdk.NewInt64Coin("steak", 2)}))
+}
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"github.com/tendermint/tendermint/libs/common"
@@ -10,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -68,6 +70,9 @@ func queryTx(cdc *codec.Codec, cliCtx context.CLIContext, hashHexStr string) ([]
 	}
 
 	if !cliCtx.TrustNode {
+		if !bytes.Equal(res.Hash, hash) {
+			return nil, fmt.Errorf("node returned transaction %X instead of %X", res.Hash, hash)
+		}
 		err := ValidateTxResult(cliCtx, res)
 		if err != nil {
 			return nil, err
@@ -85,8 +90,17 @@ func queryTx(cdc *codec.Codec, cliCtx context.CLIContext, hashHexStr string) ([]
 	return cdc.MarshalJSON(info)
 }
 
-// ValidateTxResult performs transaction verification
+// ValidateTxResult performs transaction verification: the returned
+// transaction must be the one the inclusion proof is about, and the proof
+// must match the data hash of a verified header.
 func ValidateTxResult(cliCtx context.CLIContext, res *ctypes.ResultTx) error {
+	if !bytes.Equal(res.Tx.Hash(), res.Hash) {
+		return fmt.Errorf("transaction does not match hash %X", res.Hash)
+	}
+	if !bytes.Equal(res.Proof.Data, res.Tx) {
+		return fmt.Errorf("inclusion proof is not about transaction %X", res.Hash)
+	}
+
 	check, err := cliCtx.Verify(res.Height)
 	if err != nil {
 		return err
@@ -113,6 +127,18 @@ func formatTxResult(cdc *codec.Codec, res *ctypes.ResultTx) (Info, error) {
 	}, nil
 }
 
+// FormatTxEvent converts a transaction published by Tendermint to the
+// subscribers of its events into an Info.
+func FormatTxEvent(cdc *codec.Codec, res tmtypes.TxResult) (Info, error) {
+	return formatTxResult(cdc, &ctypes.ResultTx{
+		Hash:     res.Tx.Hash(),
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: res.Result,
+		Tx:       res.Tx,
+	})
+}
+
 // Info is used to prepare info to display
 type Info struct {
 	Hash   common.HexBytes        `json:"hash"`
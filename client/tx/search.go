@@ -1,10 +1,13 @@
 package tx
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -16,55 +19,93 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/server/txindex"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 const (
-	flagTags = "tag"
-	flagAny  = "any"
+	flagTags      = "tag"
+	flagAny       = "any"
+	flagSender    = "sender"
+	flagRecipient = "recipient"
+	flagMsgType   = "msg-type"
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+	flagMemo      = "memo"
+
+	queryArgSender    = "sender"
+	queryArgRecipient = "recipient"
+	queryArgMsgType   = "msg_type"
+	queryArgMinHeight = "min_height"
+	queryArgMaxHeight = "max_height"
+	queryArgMemo      = "memo"
+
+	// maximum number of results per page supported by Tendermint
+	tmSearchPageSize = 100
 )
 
-// default client command to search through tagged transactions
+// default client command to search through transactions, either by tags or
+// through the transaction index of the node
 func SearchTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "txs",
-		Short: "Search for all transactions that match the given tags.",
+		Short: "Search for all transactions that match the given tags or filters.",
 		Long: strings.TrimSpace(`
 Search for transactions that match the given tags. By default, transactions must match ALL tags 
 passed to the --tags option. To match any transaction, use the --any option.
 
 For example:
 
-$ gaiacli tendermint txs --tag test1,test2
+$ gaiacli query txs --tag test1,test2
 
 will match any transaction tagged with both test1,test2. To match a transaction tagged with either
 test1 or test2, use:
 
-$ gaiacli tendermint txs --tag test1,test2 --any
+$ gaiacli query txs --tag test1,test2 --any
+
+Without tags, transactions are searched through the transaction index of the node, which must be
+started with --tx-index, by sender, recipient, message type, height range and memo:
+
+$ gaiacli query txs --sender cosmos1... --msg-type send --min-height 100 --order desc
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			tags := viper.GetStringSlice(flagTags)
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			txs, err := searchTxs(cliCtx, cdc, tags)
-			if err != nil {
-				return err
+			if len(tags) > 0 {
+				if indexFlagsSet(cmd) {
+					return errors.New("tags cannot be combined with the filters of the transaction index")
+				}
+				req, err := utils.ReadPageRequestFlags()
+				if err != nil {
+					return err
+				}
+				txs, page, err := searchTxs(cliCtx, cdc, tags, req)
+				if err != nil {
+					return err
+				}
+				if req.CountTotal {
+					fmt.Fprintf(os.Stderr, "%d matching transactions in total\n", page.Total)
+				}
+				utils.PrintNextPageHint(page)
+				return printTxs(cliCtx, cdc, txs)
 			}
 
-			var output []byte
-			if cliCtx.Indent {
-				output, err = cdc.MarshalJSONIndent(txs, "", "  ")
-			} else {
-				output, err = cdc.MarshalJSON(txs)
+			params, err := readIndexQueryFlags()
+			if err != nil {
+				return err
 			}
-
+			txs, page, err := searchIndexedTxs(cliCtx, cdc, params)
 			if err != nil {
 				return err
 			}
-
-			fmt.Println(string(output))
-			return nil
+			if params.Pagination.CountTotal {
+				fmt.Fprintf(os.Stderr, "%d matching transactions in total\n", page.Total)
+			}
+			utils.PrintNextPageHint(page)
+			return printTxs(cliCtx, cdc, txs)
 		},
 	}
 
@@ -76,48 +117,270 @@ $ gaiacli tendermint txs --tag test1,test2 --any
 	viper.BindPFlag(client.FlagTrustNode, cmd.Flags().Lookup(client.FlagTrustNode))
 	cmd.Flags().StringSlice(flagTags, nil, "Comma-separated list of tags that must match")
 	cmd.Flags().Bool(flagAny, false, "Return transactions that match ANY tag, rather than ALL")
-	return cmd
+
+	// filters of the transaction index
+	cmd.Flags().String(flagSender, "", "Bech32 address of an account that signed the transactions")
+	cmd.Flags().String(flagRecipient, "", "Bech32 address of an account that received coins in the transactions")
+	cmd.Flags().String(flagMsgType, "", "Type of a message of the transactions, as in their action tags (e.g. send)")
+	cmd.Flags().Int64(flagMinHeight, 0, "Minimum height of the transactions")
+	cmd.Flags().Int64(flagMaxHeight, 0, "Maximum height of the transactions")
+	cmd.Flags().String(flagMemo, "", "Text the memo of the transactions must contain")
+	return client.PaginationFlags(cmd)
+}
+
+// indexFlagsSet tells whether any filter flag of the transaction index was
+// set.
+func indexFlagsSet(cmd *cobra.Command) bool {
+	for _, flag := range []string{flagSender, flagRecipient, flagMsgType, flagMinHeight, flagMaxHeight, flagMemo} {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+	return false
 }
 
-func searchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string) ([]Info, error) {
+// readIndexQueryFlags returns the query of the transaction index given via
+// the flags.
+func readIndexQueryFlags() (params txindex.QueryTxsParams, err error) {
+	page, err := utils.ReadPageRequestFlags()
+	if err != nil {
+		return params, err
+	}
+	return parseIndexQuery(
+		viper.GetString(flagSender), viper.GetString(flagRecipient), viper.GetString(flagMsgType),
+		viper.GetString(flagMinHeight), viper.GetString(flagMaxHeight), viper.GetString(flagMemo), page,
+	)
+}
+
+// parseIndexQuery builds the query of the transaction index from its string
+// encoded filters, empty filters being ignored.
+func parseIndexQuery(sender, recipient, msgType, minHeight, maxHeight, memo string,
+	page sdk.PageRequest) (params txindex.QueryTxsParams, err error) {
+
+	params = txindex.QueryTxsParams{MsgType: msgType, Memo: memo, Pagination: page}
+	if sender != "" {
+		if params.Sender, err = sdk.AccAddressFromBech32(sender); err != nil {
+			return params, fmt.Errorf("invalid sender: %v", err)
+		}
+	}
+	if recipient != "" {
+		if params.Recipient, err = sdk.AccAddressFromBech32(recipient); err != nil {
+			return params, fmt.Errorf("invalid recipient: %v", err)
+		}
+	}
+	if minHeight != "" {
+		if params.MinHeight, err = strconv.ParseInt(minHeight, 10, 64); err != nil {
+			return params, fmt.Errorf("invalid minimum height: %v", err)
+		}
+	}
+	if maxHeight != "" {
+		if params.MaxHeight, err = strconv.ParseInt(maxHeight, 10, 64); err != nil {
+			return params, fmt.Errorf("invalid maximum height: %v", err)
+		}
+	}
+	return params, params.ValidateBasic()
+}
+
+func printTxs(cliCtx context.CLIContext, cdc *codec.Codec, txs []Info) error {
+	var output []byte
+	var err error
+	if cliCtx.Indent {
+		output, err = cdc.MarshalJSONIndent(txs, "", "  ")
+	} else {
+		output, err = cdc.MarshalJSON(txs)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+	return nil
+}
+
+// searchTxs returns the page of the transactions matching the tags selected
+// by req, in ascending order of height unless req.Descending is set. Page keys
+// are big-endian offsets of transactions in that order.
+func searchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string, req sdk.PageRequest) ([]Info, sdk.PageResponse, error) {
+	var page sdk.PageResponse
 	if len(tags) == 0 {
-		return nil, errors.New("must declare at least one tag to search")
+		return nil, page, errors.New("must declare at least one tag to search")
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, page, err
+	}
+	offset := req.Offset
+	if len(req.Key) > 0 {
+		if len(req.Key) != 8 {
+			return nil, page, errors.New("invalid page key")
+		}
+		offset = int64(binary.BigEndian.Uint64(req.Key))
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = sdk.DefaultPageLimit
 	}
 
-	// XXX: implement ANY
-	query := strings.Join(tags, " AND ")
+	query := TagsQuery(tags)
 
 	// get the node
 	node, err := cliCtx.GetNode()
 	if err != nil {
-		return nil, err
+		return nil, page, err
 	}
 
 	prove := !cliCtx.TrustNode
 
-	// TODO: take these as args
-	page := 0
-	perPage := 100
-	res, err := node.TxSearch(query, prove, page, perPage)
+	// Tendermint pages through the results in ascending order only, the
+	// first page tells the total, hence where a descending page lies
+	tmPages := make(map[int][]*ctypes.ResultTx)
+	res, err := node.TxSearch(query, prove, 1, tmSearchPageSize)
 	if err != nil {
-		return nil, err
+		return nil, page, err
+	}
+	tmPages[1] = res.Txs
+	total := int64(res.TotalCount)
+
+	start, end := offset, offset+limit
+	if end > total {
+		end = total
+	}
+	if req.Descending {
+		start, end = total-end, total-offset
+	}
+
+	var results []*ctypes.ResultTx
+	for i := start; i < end; {
+		tmPage := int(i/tmSearchPageSize) + 1
+		txs, ok := tmPages[tmPage]
+		if !ok {
+			res, err := node.TxSearch(query, prove, tmPage, tmSearchPageSize)
+			if err != nil {
+				return nil, page, err
+			}
+			txs = res.Txs
+			tmPages[tmPage] = txs
+		}
+		j := int(i % tmSearchPageSize)
+		if j >= len(txs) {
+			// fewer transactions than expected from the total
+			break
+		}
+		for ; j < len(txs) && i < end; j++ {
+			results = append(results, txs[j])
+			i++
+		}
+	}
+	if req.Descending {
+		for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+			results[i], results[j] = results[j], results[i]
+		}
 	}
 
 	if prove {
-		for _, tx := range res.Txs {
+		for _, tx := range results {
 			err := ValidateTxResult(cliCtx, tx)
 			if err != nil {
-				return nil, err
+				return nil, page, err
 			}
 		}
 	}
 
-	info, err := FormatTxResults(cdc, res.Txs)
+	info, err := FormatTxResults(cdc, results)
 	if err != nil {
-		return nil, err
+		return nil, page, err
 	}
 
-	return info, nil
+	if offset+limit < total {
+		page.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(page.NextKey, uint64(offset+limit))
+	}
+	if req.CountTotal {
+		page.Total = total
+	}
+	return info, page, nil
+}
+
+// searchIndexedTxs returns a page of the transactions matching the params
+// from the transaction index of the node. Unless the node is trusted, the
+// inclusion of each transaction in the block at its height is verified; the
+// index itself and the results of the transactions are not.
+func searchIndexedTxs(cliCtx context.CLIContext, cdc *codec.Codec, params txindex.QueryTxsParams) ([]Info, sdk.PageResponse, error) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, sdk.PageResponse{}, err
+	}
+	// the index carries no proof, even when proofs are required the inclusion
+	// of the returned transactions is proven instead
+	res, err := cliCtx.WithRequireProofs(false).QueryWithData("/app/txs", bz)
+	if err != nil {
+		return nil, sdk.PageResponse{}, err
+	}
+
+	var resp txindex.QueryTxsResponse
+	if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+		return nil, sdk.PageResponse{}, err
+	}
+
+	results := make([]*ctypes.ResultTx, len(resp.Txs))
+	for i, record := range resp.Txs {
+		results[i] = &ctypes.ResultTx{
+			Hash:     record.Hash,
+			Height:   record.Height,
+			Index:    record.Index,
+			TxResult: record.Result,
+			Tx:       record.Tx,
+		}
+	}
+	if !cliCtx.TrustNode {
+		if err := proveTxResults(cliCtx, results); err != nil {
+			return nil, sdk.PageResponse{}, err
+		}
+	}
+
+	info, err := FormatTxResults(cdc, results)
+	if err != nil {
+		return nil, sdk.PageResponse{}, err
+	}
+	return info, resp.Pagination, nil
+}
+
+// proveTxResults fills in the inclusion proofs of transactions from the
+// blocks at their heights and validates them.
+func proveTxResults(cliCtx context.CLIContext, results []*ctypes.ResultTx) error {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	blockTxs := make(map[int64]tmtypes.Txs)
+	for _, res := range results {
+		txs, ok := blockTxs[res.Height]
+		if !ok {
+			height := res.Height
+			block, err := node.Block(&height)
+			if err != nil {
+				return err
+			}
+			txs = block.Block.Data.Txs
+			blockTxs[res.Height] = txs
+		}
+
+		if int(res.Index) >= len(txs) {
+			return fmt.Errorf("block %d has no transaction at index %d", res.Height, res.Index)
+		}
+		res.Proof = txs.Proof(int(res.Index))
+		if err := ValidateTxResult(cliCtx, res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TagsQuery builds the Tendermint query matching the transactions tagged with
+// all the given key='value' pairs.
+func TagsQuery(tags []string) string {
+	// XXX: implement ANY
+	return strings.Join(tags, " AND ")
 }
 
 // parse the indexed txs into an array of Info
@@ -141,8 +404,7 @@ func SearchTxRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.
 	return func(w http.ResponseWriter, r *http.Request) {
 		tag := r.FormValue("tag")
 		if tag == "" {
-			w.WriteHeader(400)
-			w.Write([]byte("You need to provide at least a tag as a key=value pair to search for. Postfix the key with _bech32 to search bech32-encoded addresses or public keys"))
+			searchIndexedTxsREST(w, r, cliCtx, cdc)
 			return
 		}
 
@@ -169,12 +431,19 @@ func SearchTxRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.
 			tag = strings.TrimRight(key, "_bech32") + "='" + sdk.AccAddress(bz).String() + "'"
 		}
 
-		txs, err := searchTxs(cliCtx, cdc, []string{tag})
+		req, err := utils.ParsePageRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txs, page, err := searchTxs(cliCtx, cdc, []string{tag}, req)
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(err.Error()))
 			return
 		}
+		utils.WritePageHeaders(w, req, page)
 
 		if len(txs) == 0 {
 			w.Write([]byte("[]"))
@@ -184,3 +453,36 @@ func SearchTxRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.
 		utils.PostProcessResponse(w, cdc, txs, cliCtx.Indent)
 	}
 }
+
+// searchIndexedTxsREST serves the searches through the transaction index of
+// the node, made without tags.
+func searchIndexedTxsREST(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, cdc *codec.Codec) {
+	page, err := utils.ParsePageRequest(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	query := r.URL.Query()
+	params, err := parseIndexQuery(
+		query.Get(queryArgSender), query.Get(queryArgRecipient), query.Get(queryArgMsgType),
+		query.Get(queryArgMinHeight), query.Get(queryArgMaxHeight), query.Get(queryArgMemo), page,
+	)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	txs, resPage, err := searchIndexedTxs(cliCtx, cdc, params)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.WritePageHeaders(w, page, resPage)
+
+	if len(txs) == 0 {
+		w.Write([]byte("[]"))
+		return
+	}
+
+	utils.PostProcessResponse(w, cdc, txs, cliCtx.Indent)
+}
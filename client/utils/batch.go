@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ReadBatchMsgs reads the messages to be batched into a single transaction
+// from the given files, in order. Each file contains either a StdTx, as
+// printed by --generate-only, or a list of messages of any registered type.
+// Files with a .yaml or .yml extension are read as YAML, others as JSON.
+// Fees, memos and signatures of the input transactions are discarded.
+func ReadBatchMsgs(cdc *codec.Codec, filenames []string) ([]sdk.Msg, error) {
+	var msgs []sdk.Msg
+	for _, filename := range filenames {
+		bz, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		fileMsgs, err := ParseBatchMsgs(cdc, bz, isYAMLFile(filename))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", filename, err)
+		}
+		msgs = append(msgs, fileMsgs...)
+	}
+
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no messages to batch")
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid message #%d: %v", i, err)
+		}
+	}
+	return msgs, nil
+}
+
+// ParseBatchMsgs parses either a StdTx or a list of messages, see
+// ReadBatchMsgs.
+func ParseBatchMsgs(cdc *codec.Codec, bz []byte, isYAML bool) ([]sdk.Msg, error) {
+	if isYAML {
+		var err error
+		if bz, err = yamlToJSON(bz); err != nil {
+			return nil, err
+		}
+	}
+
+	var stdTx auth.StdTx
+	if err := cdc.UnmarshalJSON(bz, &stdTx); err == nil {
+		return stdTx.GetMsgs(), nil
+	}
+
+	var msgs []sdk.Msg
+	if err := cdc.UnmarshalJSON(bz, &msgs); err != nil {
+		return nil, fmt.Errorf("expected a transaction or a list of messages: %v", err)
+	}
+	return msgs, nil
+}
+
+func isYAMLFile(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// yamlToJSON converts a YAML document into its JSON equivalent.
+func yamlToJSON(bz []byte) ([]byte, error) {
+	var o interface{}
+	if err := yaml.Unmarshal(bz, &o); err != nil {
+		return nil, err
+	}
+	o, err := jsonCompatible(o)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(o)
+}
+
+// jsonCompatible converts the map[interface{}]interface{} values produced by
+// the YAML decoder into map[string]interface{} ones. Numbers are converted
+// into strings, as amino encodes 64-bit integers, Int and Dec as strings.
+func jsonCompatible(o interface{}) (interface{}, error) {
+	switch v := o.(type) {
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			strKey, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported non-string key %v", key)
+			}
+			converted, err := jsonCompatible(value)
+			if err != nil {
+				return nil, err
+			}
+			m[strKey] = converted
+		}
+		return m, nil
+	case []interface{}:
+		for i, value := range v {
+			converted, err := jsonCompatible(value)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+		return v, nil
+	default:
+		return o, nil
+	}
+}
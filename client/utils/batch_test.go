@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	bankclient "github.com/cosmos/cosmos-sdk/x/bank/client"
+)
+
+func TestReadBatchMsgs(t *testing.T) {
+	cdc := app.MakeCodec()
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	coins := sdk.Coins{sdk.NewInt64Coin("steak", 10)}
+	msg1 := bankclient.CreateMsg(addr1, addr2, coins)
+	msg2 := bankclient.CreateMsg(addr2, addr1, coins)
+
+	dir, err := ioutil.TempDir("", "batch")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// a --generate-only output
+	stdTx := auth.NewStdTx([]sdk.Msg{msg1}, auth.NewStdFee(50000, sdk.NewInt64Coin("steak", 1)), nil, "memo")
+	txFile := filepath.Join(dir, "tx.json")
+	require.NoError(t, ioutil.WriteFile(txFile, cdc.MustMarshalJSON(stdTx), 0600))
+
+	// a list of messages, in JSON and YAML
+	msgsFile := filepath.Join(dir, "msgs.json")
+	require.NoError(t, ioutil.WriteFile(msgsFile, cdc.MustMarshalJSON([]sdk.Msg{msg2}), 0600))
+	msgsYAML := fmt.Sprintf(`- type: cosmos-sdk/Send
+  value:
+    inputs:
+    - address: %s
+      coins:
+      - denom: steak
+        amount: 10
+    outputs:
+    - address: %s
+      coins:
+      - denom: steak
+        amount: 10
+`, addr2, addr1)
+	yamlFile := filepath.Join(dir, "msgs.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte(msgsYAML), 0600))
+
+	msgs, err := ReadBatchMsgs(cdc, []string{txFile, msgsFile, yamlFile})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{msg1, msg2, msg2}, msgs)
+
+	invalidFile := filepath.Join(dir, "invalid.json")
+	require.NoError(t, ioutil.WriteFile(invalidFile, []byte(`{"foo":"bar"}`), 0600))
+	_, err = ReadBatchMsgs(cdc, []string{txFile, invalidFile})
+	require.Error(t, err)
+
+	emptyFile := filepath.Join(dir, "empty.json")
+	require.NoError(t, ioutil.WriteFile(emptyFile, []byte(`[]`), 0600))
+	_, err = ReadBatchMsgs(cdc, []string{emptyFile})
+	require.Error(t, err)
+}
+
+func TestYAMLToJSON(t *testing.T) {
+	bz, err := yamlToJSON([]byte("type: cosmos-sdk/Send\nvalue:\n  inputs:\n  - address: cosmos1\n    coins: []\n"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"cosmos-sdk/Send","value":{"inputs":[{"address":"cosmos1","coins":[]}]}}`, string(bz))
+
+	// numbers are strings in amino JSON
+	bz, err = yamlToJSON([]byte("amount: 10\nshares: 1.5\ngas: 18446744073709551615\n"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"10","shares":"1.5","gas":"18446744073709551615"}`, string(bz))
+
+	_, err = yamlToJSON([]byte("1: one\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// AccountInfo holds the account data required to sign transactions on a
+// machine that has no access to a full node.
+type AccountInfo struct {
+	Address       sdk.AccAddress `json:"address"`
+	AccountNumber int64          `json:"account_number"`
+	Sequence      int64          `json:"sequence"`
+}
+
+// NewAccountInfo returns the signing related information of an account.
+func NewAccountInfo(acc auth.Account) AccountInfo {
+	return AccountInfo{
+		Address:       acc.GetAddress(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}
+}
+
+// WriteAccountInfo writes an account info file.
+func WriteAccountInfo(cdc *codec.Codec, filename string, info AccountInfo) error {
+	bz, err := cdc.MarshalJSONIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, bz, 0644)
+}
+
+// ReadAccountInfo reads an account info file written by WriteAccountInfo.
+func ReadAccountInfo(cdc *codec.Codec, filename string) (info AccountInfo, err error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+	if err = cdc.UnmarshalJSON(bz, &info); err != nil {
+		return info, fmt.Errorf("%s: %v", filename, err)
+	}
+	if len(info.Address) == 0 {
+		return info, fmt.Errorf("%s: missing account address", filename)
+	}
+	return
+}
+
+// MergeStdTxSignatures merges the signatures attached to several copies of
+// the same transaction, e.g. signed on different machines. Signatures are
+// sorted in the order of the transaction's signers, as required by the ante
+// handler. It fails if the copies differ in anything that is signed, or if
+// any signature does not belong to a signer.
+func MergeStdTxSignatures(txs []auth.StdTx) (auth.StdTx, error) {
+	if len(txs) == 0 {
+		return auth.StdTx{}, fmt.Errorf("no transactions to merge")
+	}
+
+	base := txs[0]
+	baseBytes := unsignedStdTxBytes(base)
+	signers := base.GetSigners()
+	isSigner := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		isSigner[signer.String()] = true
+	}
+
+	sigsByAddr := make(map[string]auth.StdSignature)
+	for i, stdTx := range txs {
+		if !bytes.Equal(baseBytes, unsignedStdTxBytes(stdTx)) {
+			return auth.StdTx{}, fmt.Errorf("transaction #%d differs from the first one", i)
+		}
+		for j, sig := range stdTx.GetSignatures() {
+			if sig.PubKey == nil {
+				return auth.StdTx{}, fmt.Errorf("signature #%d of transaction #%d has no public key", j, i)
+			}
+			addr := sdk.AccAddress(sig.Address()).String()
+			if !isSigner[addr] {
+				return auth.StdTx{}, fmt.Errorf("%s signed the transaction but is not one of its signers", addr)
+			}
+			if _, ok := sigsByAddr[addr]; !ok {
+				sigsByAddr[addr] = sig
+			}
+		}
+	}
+
+	var sigs []auth.StdSignature
+	for _, signer := range signers {
+		if sig, ok := sigsByAddr[signer.String()]; ok {
+			sigs = append(sigs, sig)
+		}
+	}
+
+	return auth.NewStdTx(base.GetMsgs(), base.Fee, sigs, base.GetMemo()).
+		WithTimeoutHeight(base.GetTimeoutHeight()), nil
+}
+
+// unsignedStdTxBytes returns the bytes that identify the signed content of a
+// transaction, regardless of the chain and account it is signed for.
+func unsignedStdTxBytes(stdTx auth.StdTx) []byte {
+	return auth.StdSignBytes("", 0, 0, stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(), stdTx.GetTimeoutHeight())
+}
+
+// SignatureStatus reports whether and how a signer has signed a transaction.
+type SignatureStatus struct {
+	Signer sdk.AccAddress
+	// Index of the signer's signature, or -1 if it is missing
+	Index int
+	Valid bool
+}
+
+// Ok returns true if the signature is valid and sits at the expected index.
+func (s SignatureStatus) Ok(signerIndex int) bool {
+	return s.Valid && s.Index == signerIndex
+}
+
+// VerifyStdTxSignatures verifies the signatures of a transaction for the
+// given chain without querying any node: each signature must match the bytes
+// built from its own account number and sequence. The returned statuses
+// follow the order of the transaction's signers.
+func VerifyStdTxSignatures(chainID string, stdTx auth.StdTx) []SignatureStatus {
+	sigs := stdTx.GetSignatures()
+	signers := stdTx.GetSigners()
+	statuses := make([]SignatureStatus, len(signers))
+	for i, signer := range signers {
+		statuses[i] = SignatureStatus{Signer: signer, Index: -1}
+		for j, sig := range sigs {
+			if sig.PubKey == nil || !bytes.Equal(sig.Address(), signer) {
+				continue
+			}
+			signBytes := auth.StdSignBytes(chainID, sig.AccountNumber, sig.Sequence,
+				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(), stdTx.GetTimeoutHeight())
+			statuses[i].Index = j
+			statuses[i].Valid = sig.PubKey.VerifyBytes(signBytes, sig.Signature)
+			break
+		}
+	}
+	return statuses
+}
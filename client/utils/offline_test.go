@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	bankclient "github.com/cosmos/cosmos-sdk/x/bank/client"
+)
+
+func TestAccountInfoFile(t *testing.T) {
+	cdc := app.MakeCodec()
+	dir, err := ioutil.TempDir("", "offline")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	acc := auth.NewBaseAccountWithAddress(sdk.AccAddress([]byte("addr1_______________")))
+	acc.AccountNumber = 0
+	acc.Sequence = 7
+	filename := filepath.Join(dir, "account.json")
+	require.NoError(t, WriteAccountInfo(cdc, filename, NewAccountInfo(&acc)))
+
+	info, err := ReadAccountInfo(cdc, filename)
+	require.NoError(t, err)
+	require.Equal(t, AccountInfo{Address: acc.Address, AccountNumber: 0, Sequence: 7}, info)
+
+	require.NoError(t, ioutil.WriteFile(filename, []byte(`{"sequence":"1"}`), 0600))
+	_, err = ReadAccountInfo(cdc, filename)
+	require.Error(t, err)
+}
+
+func signStdTx(chainID string, priv crypto.PrivKey, accnum, seq int64, stdTx auth.StdTx) auth.StdTx {
+	signBytes := auth.StdSignBytes(chainID, accnum, seq, stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(), stdTx.GetTimeoutHeight())
+	sig, err := priv.Sign(signBytes)
+	if err != nil {
+		panic(err)
+	}
+	sigs := append(stdTx.GetSignatures(), auth.StdSignature{
+		PubKey: priv.PubKey(), Signature: sig, AccountNumber: accnum, Sequence: seq,
+	})
+	return auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+}
+
+func TestMergeAndVerifyStdTxSignatures(t *testing.T) {
+	priv1, priv2, priv3 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	coins := sdk.Coins{sdk.NewInt64Coin("steak", 10)}
+	msgs := []sdk.Msg{
+		bankclient.CreateMsg(addr1, addr2, coins),
+		bankclient.CreateMsg(addr2, addr1, coins),
+	}
+	unsigned := auth.NewStdTx(msgs, auth.NewStdFee(50000, sdk.NewInt64Coin("steak", 1)), nil, "")
+
+	// signatures are collected in the wrong order on separate machines
+	signed2 := signStdTx("test-chain", priv2, 1, 3, unsigned)
+	signed1 := signStdTx("test-chain", priv1, 0, 0, unsigned)
+
+	statuses := VerifyStdTxSignatures("test-chain", signed2)
+	require.Equal(t, -1, statuses[0].Index)
+	require.True(t, statuses[1].Valid)
+	require.False(t, statuses[1].Ok(1))
+
+	merged, err := MergeStdTxSignatures([]auth.StdTx{signed2, signed1})
+	require.NoError(t, err)
+	require.Len(t, merged.GetSignatures(), 2)
+	for i, status := range VerifyStdTxSignatures("test-chain", merged) {
+		require.True(t, status.Ok(i))
+	}
+	for _, status := range VerifyStdTxSignatures("other-chain", merged) {
+		require.False(t, status.Valid)
+	}
+
+	// copies must not differ in their content
+	tampered := signed1
+	tampered.Memo = "tampered"
+	_, err = MergeStdTxSignatures([]auth.StdTx{signed2, tampered})
+	require.Error(t, err)
+
+	// signatures by non-signers are rejected
+	_, err = MergeStdTxSignatures([]auth.StdTx{signed2, signStdTx("test-chain", priv3, 2, 0, unsigned)})
+	require.Error(t, err)
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Query parameters and response headers of paginated REST endpoints
+const (
+	QueryArgPageKey    = "page_key"
+	QueryArgOffset     = "offset"
+	QueryArgLimit      = "limit"
+	QueryArgCountTotal = "count_total"
+	QueryArgOrder      = "order"
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+
+	HeaderNextKey    = "X-Next-Key"
+	HeaderTotalCount = "X-Total-Count"
+)
+
+// ReadPageRequestFlags returns the page request given via the flags added by
+// client.PaginationFlags.
+func ReadPageRequestFlags() (sdk.PageRequest, error) {
+	page := sdk.PageRequest{
+		Offset:     viper.GetInt64(client.FlagOffset),
+		Limit:      viper.GetInt64(client.FlagLimit),
+		CountTotal: viper.GetBool(client.FlagCountTotal),
+	}
+	key, err := hex.DecodeString(viper.GetString(client.FlagPageKey))
+	if err != nil {
+		return page, fmt.Errorf("invalid page key: %v", err)
+	}
+	page.Key = key
+	if page.Descending, err = parseOrder(viper.GetString(client.FlagOrder)); err != nil {
+		return page, err
+	}
+	return page, page.ValidateBasic()
+}
+
+// PrintNextPageHint tells on stderr how to fetch the page following the one
+// that was printed, if any.
+func PrintNextPageHint(page sdk.PageResponse) {
+	if len(page.NextKey) > 0 {
+		fmt.Fprintf(os.Stderr, "more results available with --%s=%X\n", client.FlagPageKey, []byte(page.NextKey))
+	}
+}
+
+// ParsePageRequest returns the page request given via the query parameters
+// of a paginated REST endpoint.
+func ParsePageRequest(r *http.Request) (page sdk.PageRequest, err error) {
+	query := r.URL.Query()
+	if page.Key, err = hex.DecodeString(query.Get(QueryArgPageKey)); err != nil {
+		return page, fmt.Errorf("invalid %s: %v", QueryArgPageKey, err)
+	}
+	if s := query.Get(QueryArgOffset); s != "" {
+		if page.Offset, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return page, fmt.Errorf("invalid %s: %v", QueryArgOffset, err)
+		}
+	}
+	if s := query.Get(QueryArgLimit); s != "" {
+		if page.Limit, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return page, fmt.Errorf("invalid %s: %v", QueryArgLimit, err)
+		}
+	}
+	if s := query.Get(QueryArgCountTotal); s != "" {
+		if page.CountTotal, err = strconv.ParseBool(s); err != nil {
+			return page, fmt.Errorf("invalid %s: %v", QueryArgCountTotal, err)
+		}
+	}
+	if page.Descending, err = parseOrder(query.Get(QueryArgOrder)); err != nil {
+		return page, err
+	}
+	return page, page.ValidateBasic()
+}
+
+// parseOrder tells whether an order of results is descending, an empty order
+// being ascending.
+func parseOrder(order string) (descending bool, err error) {
+	switch order {
+	case "", OrderAsc:
+		return false, nil
+	case OrderDesc:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid order %q, expected %s or %s", order, OrderAsc, OrderDesc)
+	}
+}
+
+// WritePageHeaders reports the key of the next page and, if it was requested,
+// the total number of results in the headers of a paginated REST response.
+func WritePageHeaders(w http.ResponseWriter, req sdk.PageRequest, page sdk.PageResponse) {
+	if len(page.NextKey) > 0 {
+		w.Header().Set(HeaderNextKey, fmt.Sprintf("%X", []byte(page.NextKey)))
+	}
+	if req.CountTotal {
+		w.Header().Set(HeaderTotalCount, strconv.FormatInt(page.Total, 10))
+	}
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParsePageRequest(t *testing.T) {
+	page, err := ParsePageRequest(httptest.NewRequest("GET", "/list?page_key=0A0B&limit=5&order=desc", nil))
+	require.NoError(t, err)
+	require.Equal(t, sdk.PageRequest{Key: []byte{0x0a, 0x0b}, Limit: 5, Descending: true}, page)
+
+	page, err = ParsePageRequest(httptest.NewRequest("GET", "/list?offset=2&count_total=true&order=asc", nil))
+	require.NoError(t, err)
+	require.Equal(t, sdk.PageRequest{Key: []byte{}, Offset: 2, CountTotal: true}, page)
+
+	for _, query := range []string{"order=up", "limit=x", "page_key=zz", "page_key=0A&offset=1"} {
+		_, err = ParsePageRequest(httptest.NewRequest("GET", "/list?"+query, nil))
+		require.Error(t, err, query)
+	}
+}
@@ -89,7 +89,8 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, txBldr authtxb.TxBuilder,
 		return
 	}
 
-	output, err := txBldr.Codec.MarshalJSON(auth.NewStdTx(stdMsg.Msgs, stdMsg.Fee, nil, stdMsg.Memo))
+	stdTx := auth.NewStdTx(stdMsg.Msgs, stdMsg.Fee, nil, stdMsg.Memo).WithTimeoutHeight(stdMsg.TimeoutHeight)
+	output, err := txBldr.Codec.MarshalJSON(stdTx)
 	if err != nil {
 		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -114,6 +115,7 @@ type BaseReq struct {
 	Sequence      int64  `json:"sequence"`
 	Gas           string `json:"gas"`
 	GasAdjustment string `json:"gas_adjustment"`
+	TimeoutHeight int64  `json:"timeout_height"`
 }
 
 // Sanitize performs basic sanitization on a BaseReq object.
@@ -126,6 +128,7 @@ func (br BaseReq) Sanitize() BaseReq {
 		GasAdjustment: strings.TrimSpace(br.GasAdjustment),
 		AccountNumber: br.AccountNumber,
 		Sequence:      br.Sequence,
+		TimeoutHeight: br.TimeoutHeight,
 	}
 }
 
@@ -207,6 +210,7 @@ func CompleteAndBroadcastTxREST(w http.ResponseWriter, r *http.Request, cliCtx c
 		ChainID:       baseReq.ChainID,
 		AccountNumber: baseReq.AccountNumber,
 		Sequence:      baseReq.Sequence,
+		TimeoutHeight: baseReq.TimeoutHeight,
 	}
 
 	if HasDryRunArg(r) || txBldr.SimulateGas {
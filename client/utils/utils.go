@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 
@@ -11,9 +12,44 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/common"
 )
 
+// SimulationResponse defines the outcome of a transaction simulation, i.e. the
+// gas it consumed along with the log and tags its execution would produce.
+type SimulationResponse struct {
+	Code        sdk.ABCICodeType `json:"code"`
+	Log         string           `json:"log"`
+	GasEstimate int64            `json:"gas_estimate"`
+	GasAdjusted int64            `json:"gas_adjusted"`
+	Tags        []SimulationTag  `json:"tags"`
+}
+
+// SimulationTag is the human readable representation of a tag emitted by a
+// simulated transaction.
+type SimulationTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewSimulationResponse converts the result of a simulation into a
+// SimulationResponse, adjusting the consumed gas by the given factor.
+func NewSimulationResponse(res sdk.Result, adjustment float64) SimulationResponse {
+	tags := make([]SimulationTag, len(res.Tags))
+	for i, tag := range res.Tags {
+		tags[i] = SimulationTag{Key: string(tag.Key), Value: string(tag.Value)}
+	}
+
+	return SimulationResponse{
+		Code:        res.Code,
+		Log:         res.Log,
+		GasEstimate: res.GasUsed,
+		GasAdjusted: adjustGasEstimate(res.GasUsed, adjustment),
+		Tags:        tags,
+	}
+}
+
 // CompleteAndBroadcastTxCli implements a utility function that
 // facilitates sending a series of messages in a signed
 // transaction given a TxBuilder and a QueryContext. It ensures
@@ -71,21 +107,71 @@ func EnrichCtxWithGas(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name
 
 // CalculateGas simulates the execution of a transaction and returns
 // both the estimate obtained by the query and the adjusted amount.
+// An error is returned if the simulated transaction fails.
 func CalculateGas(queryFunc func(string, common.HexBytes) ([]byte, error), cdc *amino.Codec, txBytes []byte, adjustment float64) (estimate, adjusted int64, err error) {
 	// run a simulation (via /app/simulate query) to
 	// estimate gas and update TxBuilder accordingly
-	rawRes, err := queryFunc("/app/simulate", txBytes)
+	res, err := SimulateTx(queryFunc, cdc, txBytes)
 	if err != nil {
 		return
 	}
-	estimate, err = parseQueryResponse(cdc, rawRes)
-	if err != nil {
+	if !res.IsOK() {
+		err = errors.New(res.Log)
 		return
 	}
+	estimate = res.GasUsed
 	adjusted = adjustGasEstimate(estimate, adjustment)
 	return
 }
 
+// SimulateTx runs the given transaction through the /app/simulate query and
+// returns the result of its execution. Signatures are not verified during a
+// simulation, but the gas needed to verify them is charged nonetheless.
+func SimulateTx(queryFunc func(string, common.HexBytes) ([]byte, error), cdc *amino.Codec, txBytes []byte) (sdk.Result, error) {
+	rawRes, err := queryFunc("/app/simulate", txBytes)
+	if err != nil {
+		return sdk.Result{}, err
+	}
+	return parseQueryResponse(cdc, rawRes)
+}
+
+// PrepareSimulationTx fills in a placeholder signature for each signer of an
+// unsigned StdTx so that it can be simulated. Account numbers and sequences
+// are fetched from the chain. The given public keys are matched to signers
+// positionally; signers without one fall back to their on-chain public key.
+func PrepareSimulationTx(cliCtx context.CLIContext, stdTx auth.StdTx, pubkeys []crypto.PubKey) (auth.StdTx, error) {
+	signers := stdTx.GetSigners()
+	if len(pubkeys) > len(signers) {
+		return stdTx, fmt.Errorf("got %d public keys but the transaction has %d signers", len(pubkeys), len(signers))
+	}
+
+	sigs := make([]auth.StdSignature, len(signers))
+	copy(sigs, stdTx.Signatures)
+	for i := len(stdTx.Signatures); i < len(signers); i++ {
+		acc, err := cliCtx.GetAccount(signers[i])
+		if err != nil {
+			return stdTx, err
+		}
+		if acc == nil {
+			return stdTx, fmt.Errorf("no account with address %s was found in the state", signers[i])
+		}
+
+		pubkey := acc.GetPubKey()
+		if i < len(pubkeys) && pubkeys[i] != nil {
+			pubkey = pubkeys[i]
+		}
+
+		sigs[i] = auth.StdSignature{
+			PubKey:        pubkey,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+		}
+	}
+
+	stdTx.Signatures = sigs
+	return stdTx, nil
+}
+
 // PrintUnsignedStdTx builds an unsigned StdTx and prints it to os.Stdout.
 // Don't perform online validation or lookups if offline is true.
 func PrintUnsignedStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg, offline bool) (err error) {
@@ -107,7 +193,9 @@ func PrintUnsignedStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, msg
 
 // SignStdTx appends a signature to a StdTx and returns a copy of a it. If appendSig
 // is false, it replaces the signatures already attached with the new signature.
-func SignStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name string, stdTx auth.StdTx, appendSig bool) (auth.StdTx, error) {
+// If offline is true, the account number and sequence are taken from the
+// TxBuilder as they are and no node is queried.
+func SignStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name string, stdTx auth.StdTx, appendSig bool, offline bool) (auth.StdTx, error) {
 	var signedStdTx auth.StdTx
 
 	keybase, err := keys.GetKeyBase()
@@ -125,7 +213,7 @@ func SignStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name string,
 		fmt.Fprintf(os.Stderr, "WARNING: The generated transaction's intended signer does not match the given signer: '%v'", name)
 	}
 
-	if txBldr.AccountNumber == 0 {
+	if !offline && txBldr.AccountNumber == 0 {
 		accNum, err := cliCtx.GetAccountNumber(addr)
 		if err != nil {
 			return signedStdTx, err
@@ -133,7 +221,7 @@ func SignStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name string,
 		txBldr = txBldr.WithAccountNumber(accNum)
 	}
 
-	if txBldr.Sequence == 0 {
+	if !offline && txBldr.Sequence == 0 {
 		accSeq, err := cliCtx.GetAccountSequence(addr)
 		if err != nil {
 			return signedStdTx, err
@@ -148,7 +236,6 @@ func SignStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name string,
 	return txBldr.SignStdTx(name, passphrase, stdTx, appendSig)
 }
 
-// nolint
 // SimulateMsgs simulates the transaction and returns the gas estimate and the adjusted value.
 func simulateMsgs(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name string, msgs []sdk.Msg) (estimated, adjusted int64, err error) {
 	txBytes, err := txBldr.BuildWithPubKey(name, msgs)
@@ -163,12 +250,12 @@ func adjustGasEstimate(estimate int64, adjustment float64) int64 {
 	return int64(adjustment * float64(estimate))
 }
 
-func parseQueryResponse(cdc *amino.Codec, rawRes []byte) (int64, error) {
+func parseQueryResponse(cdc *amino.Codec, rawRes []byte) (sdk.Result, error) {
 	var simulationResult sdk.Result
 	if err := cdc.UnmarshalBinary(rawRes, &simulationResult); err != nil {
-		return 0, err
+		return sdk.Result{}, err
 	}
-	return simulationResult.GasUsed, nil
+	return simulationResult, nil
 }
 
 func prepareTxBuilder(txBldr authtxb.TxBuilder, cliCtx context.CLIContext) (authtxb.TxBuilder, error) {
@@ -231,7 +318,8 @@ func buildUnsignedStdTxOffline(txBldr authtxb.TxBuilder, cliCtx context.CLIConte
 	if err != nil {
 		return
 	}
-	return auth.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo), nil
+	return auth.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo).
+		WithTimeoutHeight(stdSignMsg.TimeoutHeight), nil
 }
 
 func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
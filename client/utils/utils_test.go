@@ -13,27 +13,31 @@ import (
 func TestParseQueryResponse(t *testing.T) {
 	cdc := app.MakeCodec()
 	sdkResBytes := cdc.MustMarshalBinary(sdk.Result{GasUsed: 10})
-	gas, err := parseQueryResponse(cdc, sdkResBytes)
-	assert.Equal(t, gas, int64(10))
+	res, err := parseQueryResponse(cdc, sdkResBytes)
+	assert.Equal(t, res.GasUsed, int64(10))
 	assert.Nil(t, err)
-	gas, err = parseQueryResponse(cdc, []byte("fuzzy"))
-	assert.Equal(t, gas, int64(0))
+	res, err = parseQueryResponse(cdc, []byte("fuzzy"))
+	assert.Equal(t, res.GasUsed, int64(0))
 	assert.NotNil(t, err)
 }
 
 func TestCalculateGas(t *testing.T) {
 	cdc := app.MakeCodec()
-	makeQueryFunc := func(gasUsed int64, wantErr bool) func(string, common.HexBytes) ([]byte, error) {
+	makeQueryFunc := func(gasUsed int64, wantErr, txFails bool) func(string, common.HexBytes) ([]byte, error) {
 		return func(string, common.HexBytes) ([]byte, error) {
 			if wantErr {
 				return nil, errors.New("")
 			}
+			if txFails {
+				return cdc.MustMarshalBinary(sdk.ErrInsufficientCoins("").Result()), nil
+			}
 			return cdc.MustMarshalBinary(sdk.Result{GasUsed: gasUsed}), nil
 		}
 	}
 	type args struct {
 		queryFuncGasUsed int64
 		queryFuncWantErr bool
+		queryFuncTxFails bool
 		adjustment       float64
 	}
 	tests := []struct {
@@ -43,12 +47,13 @@ func TestCalculateGas(t *testing.T) {
 		wantAdjusted int64
 		wantErr      bool
 	}{
-		{"error", args{0, true, 1.2}, 0, 0, true},
-		{"adjusted gas", args{10, false, 1.2}, 10, 12, false},
+		{"error", args{0, true, false, 1.2}, 0, 0, true},
+		{"failed tx", args{10, false, true, 1.2}, 0, 0, true},
+		{"adjusted gas", args{10, false, false, 1.2}, 10, 12, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			queryFunc := makeQueryFunc(tt.args.queryFuncGasUsed, tt.args.queryFuncWantErr)
+			queryFunc := makeQueryFunc(tt.args.queryFuncGasUsed, tt.args.queryFuncWantErr, tt.args.queryFuncTxFails)
 			gotEstimate, gotAdjusted, err := CalculateGas(queryFunc, cdc, []byte(""), tt.args.adjustment)
 			assert.Equal(t, err != nil, tt.wantErr)
 			assert.Equal(t, gotEstimate, tt.wantEstimate)
@@ -56,3 +61,16 @@ func TestCalculateGas(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSimulationResponse(t *testing.T) {
+	res := sdk.Result{
+		GasUsed: 10,
+		Log:     "ok",
+		Tags:    sdk.NewTags("action", []byte("send")),
+	}
+	simRes := NewSimulationResponse(res, 1.5)
+	assert.Equal(t, int64(10), simRes.GasEstimate)
+	assert.Equal(t, int64(15), simRes.GasAdjusted)
+	assert.Equal(t, "ok", simRes.Log)
+	assert.Equal(t, []SimulationTag{{Key: "action", Value: "send"}}, simRes.Tags)
+}
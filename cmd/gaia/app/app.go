@@ -10,17 +10,22 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sgrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	distrgrpc "github.com/cosmos/cosmos-sdk/x/distribution/client/grpc"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	govgrpc "github.com/cosmos/cosmos-sdk/x/gov/client/grpc"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
+	stakegrpc "github.com/cosmos/cosmos-sdk/x/stake/client/grpc"
 )
 
 const (
@@ -112,13 +117,13 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		app.cdc,
 		app.keyDistr,
 		app.paramsKeeper.Subspace(distr.DefaultParamspace),
-		app.bankKeeper, app.stakeKeeper, app.feeCollectionKeeper,
+		app.bankKeeper, &app.stakeKeeper, app.feeCollectionKeeper,
 		app.RegisterCodespace(stake.DefaultCodespace),
 	)
 	app.slashingKeeper = slashing.NewKeeper(
 		app.cdc,
 		app.keySlashing,
-		app.stakeKeeper, app.paramsKeeper.Subspace(slashing.DefaultParamspace),
+		&app.stakeKeeper, app.paramsKeeper.Subspace(slashing.DefaultParamspace),
 		app.RegisterCodespace(slashing.DefaultCodespace),
 	)
 	app.govKeeper = gov.NewKeeper(
@@ -129,6 +134,8 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	)
 
 	// register the staking hooks
+	// NOTE: the distribution and slashing keepers hold a pointer to the stake
+	// keeper, so that the hooks also run for the staking operations they call
 	app.stakeKeeper = app.stakeKeeper.WithHooks(
 		NewHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()))
 
@@ -141,7 +148,9 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		AddRoute("gov", gov.NewHandler(app.govKeeper))
 
 	app.QueryRouter().
+		AddRoute("distr", distr.NewQuerier(app.distrKeeper)).
 		AddRoute("gov", gov.NewQuerier(app.govKeeper)).
+		AddRoute("slashing", slashing.NewQuerier(app.slashingKeeper)).
 		AddRoute("stake", stake.NewQuerier(app.stakeKeeper, app.cdc))
 
 	// initialize BaseApp
@@ -257,10 +266,11 @@ func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 	app.accountMapper.IterateAccounts(ctx, appendAccount)
 
 	genState := GenesisState{
-		Accounts:  accounts,
-		StakeData: stake.WriteGenesis(ctx, app.stakeKeeper),
-		DistrData: distr.WriteGenesis(ctx, app.distrKeeper),
-		GovData:   gov.WriteGenesis(ctx, app.govKeeper),
+		Accounts:     accounts,
+		StakeData:    stake.WriteGenesis(ctx, app.stakeKeeper),
+		DistrData:    distr.WriteGenesis(ctx, app.distrKeeper),
+		GovData:      gov.WriteGenesis(ctx, app.govKeeper),
+		SlashingData: slashing.WriteGenesis(ctx, app.slashingKeeper),
 	}
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
@@ -270,6 +280,13 @@ func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 	return appState, validators, nil
 }
 
+// register the gRPC query services of the modules
+func (app *GaiaApp) RegisterGRPCServices(srv *grpc.Server, querier sgrpc.Querier) {
+	stakegrpc.RegisterQueryService(srv, app.cdc, querier)
+	govgrpc.RegisterQueryService(srv, app.cdc, querier)
+	distrgrpc.RegisterQueryService(srv, app.cdc, querier)
+}
+
 //______________________________________________________________________________________________
 
 // Combined Staking Hooks
@@ -300,12 +317,21 @@ func (h Hooks) OnValidatorBonded(ctx sdk.Context, addr sdk.ConsAddress) {
 func (h Hooks) OnValidatorBeginUnbonding(ctx sdk.Context, addr sdk.ConsAddress) {
 	h.sh.OnValidatorBeginUnbonding(ctx, addr)
 }
+func (h Hooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	h.sh.OnValidatorConsPubKeyRotated(ctx, valAddr, oldConsAddr, newConsAddr)
+}
+func (h Hooks) OnValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	h.dh.OnValidatorSlashed(ctx, valAddr, fraction)
+}
 func (h Hooks) OnDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.dh.OnDelegationCreated(ctx, delAddr, valAddr)
 }
 func (h Hooks) OnDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.dh.OnDelegationSharesModified(ctx, delAddr, valAddr)
 }
+func (h Hooks) OnDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.dh.OnDelegationModified(ctx, delAddr, valAddr)
+}
 func (h Hooks) OnDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.dh.OnDelegationRemoved(ctx, delAddr, valAddr)
 }
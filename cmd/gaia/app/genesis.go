@@ -253,6 +253,14 @@ func GaiaValidateGenesisState(genesisState GenesisState) (err error) {
 	if err != nil {
 		return
 	}
+	err = slashing.ValidateGenesis(genesisState.SlashingData)
+	if err != nil {
+		return
+	}
+	err = distr.ValidateGenesis(genesisState.DistrData)
+	if err != nil {
+		return
+	}
 	return
 }
 
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	distrsim "github.com/cosmos/cosmos-sdk/x/distribution/simulation"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
@@ -64,10 +65,8 @@ func appStateFn(r *rand.Rand, accs []simulation.Account) json.RawMessage {
 
 	// XXX Try different numbers of initially bonded validators
 	numInitiallyBonded := int64(50)
-	valAddrs := make([]sdk.ValAddress, numInitiallyBonded)
 	for i := 0; i < int(numInitiallyBonded); i++ {
 		valAddr := sdk.ValAddress(accs[i].Address)
-		valAddrs[i] = valAddr
 
 		validator := stake.NewValidator(valAddr, accs[i].PubKey, stake.Description{})
 		validator.Tokens = sdk.NewDec(100)
@@ -86,7 +85,7 @@ func appStateFn(r *rand.Rand, accs []simulation.Account) json.RawMessage {
 	genesis := GenesisState{
 		Accounts:     genesisAccounts,
 		StakeData:    stakeGenesis,
-		DistrData:    distr.DefaultGenesisWithValidators(valAddrs),
+		DistrData:    distr.DefaultGenesisState(),
 		SlashingData: slashingGenesis,
 		GovData:      govGenesis,
 	}
@@ -107,10 +106,18 @@ func testAndRunTxs(app *GaiaApp) []simulation.WeightedOperation {
 		{100, govsim.SimulateMsgDeposit(app.govKeeper, app.stakeKeeper)},
 		{100, stakesim.SimulateMsgCreateValidator(app.accountMapper, app.stakeKeeper)},
 		{5, stakesim.SimulateMsgEditValidator(app.stakeKeeper)},
+		{5, stakesim.SimulateMsgRotateConsPubKey(app.stakeKeeper)},
 		{100, stakesim.SimulateMsgDelegate(app.accountMapper, app.stakeKeeper)},
 		{100, stakesim.SimulateMsgBeginUnbonding(app.accountMapper, app.stakeKeeper)},
 		{100, stakesim.SimulateMsgBeginRedelegate(app.accountMapper, app.stakeKeeper)},
+		{50, stakesim.SimulateMsgTransferDelegation(app.accountMapper, app.stakeKeeper)},
+		{50, stakesim.SimulateMsgCancelUnbondingDelegation(app.accountMapper, app.stakeKeeper)},
 		{100, slashingsim.SimulateMsgUnjail(app.slashingKeeper)},
+		{50, distrsim.SimulateMsgSetWithdrawAddress(app.distrKeeper)},
+		{50, distrsim.SimulateMsgSetAutoCompound(app.distrKeeper)},
+		{50, distrsim.SimulateMsgWithdrawDelegatorRewardsAll(app.distrKeeper)},
+		{50, distrsim.SimulateMsgWithdrawDelegatorReward(app.distrKeeper)},
+		{50, distrsim.SimulateMsgWithdrawValidatorRewardsAll(app.distrKeeper)},
 	}
 }
 
@@ -120,6 +127,7 @@ func invariants(app *GaiaApp) []simulation.Invariant {
 		govsim.AllInvariants(),
 		stakesim.AllInvariants(app.bankKeeper, app.stakeKeeper, app.accountMapper),
 		slashingsim.AllInvariants(),
+		distrsim.AllInvariants(app.distrKeeper),
 	}
 }
 
@@ -29,6 +29,7 @@ import (
 
 const (
 	storeAcc      = "acc"
+	storeDistr    = "distr"
 	storeGov      = "gov"
 	storeSlashing = "slashing"
 	storeStake    = "stake"
@@ -68,21 +69,31 @@ func main() {
 	queryCmd.AddCommand(client.LineBreak)
 	queryCmd.AddCommand(client.GetCommands(
 		authcmd.GetAccountCmd(storeAcc, cdc, authcmd.GetAccountDecoder(cdc)),
+		distrcmd.GetCmdQueryAutoCompound(storeDistr, cdc),
+		distrcmd.GetCmdQueryCommunityPool(storeDistr, cdc),
 		stakecmd.GetCmdQueryDelegation(storeStake, cdc),
 		stakecmd.GetCmdQueryDelegations(storeStake, cdc),
+		distrcmd.GetCmdQueryParams(storeDistr, cdc),
+		distrcmd.GetCmdQueryFeePool(storeDistr, cdc),
+		slashingcmd.GetCmdQueryMissedBlocks(storeSlashing, cdc),
 		stakecmd.GetCmdQueryParams(storeStake, cdc),
 		stakecmd.GetCmdQueryPool(storeStake, cdc),
 		govcmd.GetCmdQueryProposal(storeGov, cdc),
 		govcmd.GetCmdQueryProposals(storeGov, cdc),
 		stakecmd.GetCmdQueryRedelegation(storeStake, cdc),
 		stakecmd.GetCmdQueryRedelegations(storeStake, cdc),
+		distrcmd.GetCmdQueryRewards(storeDistr, cdc),
 		slashingcmd.GetCmdQuerySigningInfo(storeSlashing, cdc),
+		slashingcmd.GetCmdQuerySigningInfos(storeSlashing, cdc),
 		stakecmd.GetCmdQueryUnbondingDelegation(storeStake, cdc),
 		stakecmd.GetCmdQueryUnbondingDelegations(storeStake, cdc),
 		stakecmd.GetCmdQueryValidator(storeStake, cdc),
+		distrcmd.GetCmdQueryValidatorCommission(storeDistr, cdc),
+		distrcmd.GetCmdQueryValidatorOutstandingRewards(storeDistr, cdc),
 		stakecmd.GetCmdQueryValidators(storeStake, cdc),
 		govcmd.GetCmdQueryVote(storeGov, cdc),
 		govcmd.GetCmdQueryVotes(storeGov, cdc),
+		distrcmd.GetCmdQueryWithdrawAddr(storeDistr, cdc),
 	)...)
 
 	//Add query commands
@@ -96,6 +107,10 @@ func main() {
 		client.PostCommands(
 			bankcmd.GetBroadcastCommand(cdc),
 			authcmd.GetSignCommand(cdc, authcmd.GetAccountDecoder(cdc)),
+			authcmd.GetSimulateCommand(cdc, authcmd.GetAccountDecoder(cdc)),
+			authcmd.GetBatchCommand(cdc, authcmd.GetAccountDecoder(cdc)),
+			authcmd.GetMultiSignCommand(cdc),
+			authcmd.GetValidateSignaturesCommand(cdc),
 		)...)
 	txCmd.AddCommand(client.LineBreak)
 
@@ -103,11 +118,15 @@ func main() {
 		client.PostCommands(
 			stakecmd.GetCmdCreateValidator(cdc),
 			stakecmd.GetCmdEditValidator(cdc),
+			stakecmd.GetCmdRotateConsPubKey(cdc),
 			stakecmd.GetCmdDelegate(cdc),
 			stakecmd.GetCmdRedelegate(storeStake, cdc),
 			stakecmd.GetCmdUnbond(storeStake, cdc),
+			stakecmd.GetCmdTransferDelegation(storeStake, cdc),
+			stakecmd.GetCmdCancelUnbonding(cdc),
 			distrcmd.GetCmdWithdrawRewards(cdc),
 			distrcmd.GetCmdSetWithdrawAddr(cdc),
+			distrcmd.GetCmdSetAutoCompound(cdc),
 			govcmd.GetCmdDeposit(cdc),
 			bankcmd.SendTxCmd(cdc),
 			govcmd.GetCmdSubmitProposal(cdc),
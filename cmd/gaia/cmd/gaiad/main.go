@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	gaiaInit "github.com/cosmos/cosmos-sdk/cmd/gaia/init"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/txindex"
 )
 
 func main() {
@@ -48,10 +49,25 @@ func main() {
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
 	return app.NewGaiaApp(logger, db, traceStore,
 		baseapp.SetPruning(viper.GetString("pruning")),
-		baseapp.SetMinimumFees(viper.GetString("minimum_fees")),
+		baseapp.SetMinGasPrices(viper.GetString("minimum_gas_prices")),
+		baseapp.SetHaltHeight(uint64(viper.GetInt64("halt-height"))),
+		baseapp.SetHaltTime(uint64(viper.GetInt64("halt-time"))),
+		baseapp.SetTxIndexer(openTxIndexer()),
 	)
 }
 
+// open the transaction index if enabled, in the data directory of the node
+func openTxIndexer() baseapp.TxIndexer {
+	if !viper.GetBool("tx-index") {
+		return nil
+	}
+	indexer, err := txindex.Open(viper.GetString(cli.HomeFlag))
+	if err != nil {
+		panic(err)
+	}
+	return indexer
+}
+
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer,
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
@@ -44,6 +44,7 @@ const (
 	Italian
 	addressSuffix = "address"
 	infoSuffix    = "info"
+	privKeySuffix = "privkey"
 )
 
 var (
@@ -59,7 +60,11 @@ var (
 // dbKeybase combines encryption and storage implementation to provide
 // a full-featured key manager
 type dbKeybase struct {
-	db dbm.DB
+	db keyStore
+	// encryptedStore is set if the store encrypts its records itself, in
+	// which case private keys are kept in their own record rather than
+	// encrypted with a passphrase of their own
+	encryptedStore bool
 }
 
 // New creates a new keybase instance using the passed DB for reading and writing keys.
@@ -157,6 +162,19 @@ func (kb dbKeybase) CreateOffline(name string, pub tmcrypto.PubKey) (Info, error
 	return kb.writeOfflineKey(pub, name), nil
 }
 
+// CreateRemote creates a new reference to a key held by a remote signer.
+// It returns the created key info and an error if the signer could not be
+// queried for the key's public key.
+func (kb dbKeybase) CreateRemote(name, endpoint, remoteName string, tlsFiles RemoteSignerTLS) (Info, error) {
+	pub, err := fetchRemotePubKey(endpoint, remoteName, tlsFiles)
+	if err != nil {
+		return nil, err
+	}
+	info := newRemoteInfo(name, pub, endpoint, remoteName, tlsFiles)
+	kb.writeInfo(info, name)
+	return info, nil
+}
+
 func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath string) (info Info, err error) {
 	// create master key and derive first key:
 	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
@@ -165,9 +183,9 @@ func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath str
 		return
 	}
 
-	// if we have a password, use it to encrypt the private key and store it
-	// else store the public key only
-	if passwd != "" {
+	// if we have a password or the store encrypts keys itself, store the
+	// private key, else store the public key only
+	if passwd != "" || kb.encryptedStore {
 		info = kb.writeLocalKey(secp256k1.PrivKeySecp256k1(derivedPriv), name, passwd)
 	} else {
 		pubk := secp256k1.PrivKeySecp256k1(derivedPriv).PubKey()
@@ -224,12 +242,7 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub t
 	var priv tmcrypto.PrivKey
 	switch info.(type) {
 	case localInfo:
-		linfo := info.(localInfo)
-		if linfo.PrivKeyArmor == "" {
-			err = fmt.Errorf("private key not available")
-			return
-		}
-		priv, err = unarmorDecryptPrivKey(linfo.PrivKeyArmor, passphrase)
+		priv, err = kb.localPrivKey(info.(localInfo), passphrase)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -257,6 +270,16 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub t
 		}
 		cdc.MustUnmarshalBinary([]byte(signed), sig)
 		return sig, linfo.GetPubKey(), nil
+	case remoteInfo:
+		rinfo := info.(remoteInfo)
+		sig, pub, err = signRemote(rinfo.Endpoint, rinfo.RemoteName, rinfo.TLS, msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !pub.Equals(rinfo.PubKey) {
+			return nil, nil, fmt.Errorf("remote signer signed with an unexpected key for %s", name)
+		}
+		return sig, pub, nil
 	}
 	sig, err = priv.Sign(msg)
 	if err != nil {
@@ -274,12 +297,7 @@ func (kb dbKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcr
 	var priv tmcrypto.PrivKey
 	switch info.(type) {
 	case localInfo:
-		linfo := info.(localInfo)
-		if linfo.PrivKeyArmor == "" {
-			err = fmt.Errorf("private key not available")
-			return nil, err
-		}
-		priv, err = unarmorDecryptPrivKey(linfo.PrivKeyArmor, passphrase)
+		priv, err = kb.localPrivKey(info.(localInfo), passphrase)
 		if err != nil {
 			return nil, err
 		}
@@ -287,6 +305,8 @@ func (kb dbKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcr
 		return nil, errors.New("Only works on local private keys")
 	case offlineInfo:
 		return nil, errors.New("Only works on local private keys")
+	case remoteInfo:
+		return nil, errors.New("Only works on local private keys")
 	}
 	return priv, nil
 }
@@ -350,7 +370,8 @@ func (kb dbKeybase) ImportPubKey(name string, armor string) (err error) {
 // Delete removes key forever, but we must present the
 // proper passphrase before deleting it (for security).
 // A passphrase of 'yes' is used to delete stored
-// references to offline and Ledger / HW wallet keys
+// references to offline, remote and Ledger / HW wallet keys,
+// and keys without a passphrase of their own
 func (kb dbKeybase) Delete(name, passphrase string) error {
 	// verify we have the proper password before deleting
 	info, err := kb.Get(name)
@@ -360,15 +381,19 @@ func (kb dbKeybase) Delete(name, passphrase string) error {
 	switch info.(type) {
 	case localInfo:
 		linfo := info.(localInfo)
-		_, err = unarmorDecryptPrivKey(linfo.PrivKeyArmor, passphrase)
-		if err != nil {
+		if linfo.PrivKeyArmor == "" {
+			if passphrase != "yes" {
+				return fmt.Errorf("enter 'yes' exactly to delete the key - this cannot be undone")
+			}
+			kb.db.DeleteSync(privKeyKey(name))
+		} else if _, err = unarmorDecryptPrivKey(linfo.PrivKeyArmor, passphrase); err != nil {
 			return err
 		}
 		kb.db.DeleteSync(addrKey(linfo.GetAddress()))
 		kb.db.DeleteSync(infoKey(name))
 		return nil
 	case ledgerInfo:
-	case offlineInfo:
+	case offlineInfo, remoteInfo:
 		if passphrase != "yes" {
 			return fmt.Errorf("enter 'yes' exactly to delete the key - this cannot be undone")
 		}
@@ -394,6 +419,9 @@ func (kb dbKeybase) Update(name, oldpass string, getNewpass func() (string, erro
 	switch info.(type) {
 	case localInfo:
 		linfo := info.(localInfo)
+		if linfo.PrivKeyArmor == "" {
+			return fmt.Errorf("key %s has no passphrase of its own, it is protected by the keyring", name)
+		}
 		key, err := unarmorDecryptPrivKey(linfo.PrivKeyArmor, oldpass)
 		if err != nil {
 			return err
@@ -409,9 +437,28 @@ func (kb dbKeybase) Update(name, oldpass string, getNewpass func() (string, erro
 	}
 }
 
+// localPrivKey returns the private key of a local key, read from its own
+// record if the store encrypts it, otherwise decrypted using passphrase.
+func (kb dbKeybase) localPrivKey(linfo localInfo, passphrase string) (tmcrypto.PrivKey, error) {
+	if linfo.PrivKeyArmor != "" {
+		return unarmorDecryptPrivKey(linfo.PrivKeyArmor, passphrase)
+	}
+	bz := kb.db.Get(privKeyKey(linfo.Name))
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("private key not available")
+	}
+	return cryptoAmino.PrivKeyFromBytes(bz)
+}
+
 func (kb dbKeybase) writeLocalKey(priv tmcrypto.PrivKey, name, passphrase string) Info {
-	// encrypt private key using passphrase
-	privArmor := encryptArmorPrivKey(priv, passphrase)
+	// store the private key as is if the store encrypts it, otherwise
+	// encrypt it using passphrase
+	var privArmor string
+	if kb.encryptedStore {
+		kb.db.SetSync(privKeyKey(name), priv.Bytes())
+	} else {
+		privArmor = encryptArmorPrivKey(priv, passphrase)
+	}
 	// make Info
 	pub := priv.PubKey()
 	info := newLocalInfo(name, pub, privArmor)
@@ -446,3 +493,7 @@ func addrKey(address types.AccAddress) []byte {
 func infoKey(name string) []byte {
 	return []byte(fmt.Sprintf("%s.%s", name, infoSuffix))
 }
+
+func privKeyKey(name string) []byte {
+	return []byte(fmt.Sprintf("%s.%s", name, privKeySuffix))
+}
@@ -0,0 +1,157 @@
+package keys
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Keyring backends a Keybase can be built on top of.
+const (
+	// BackendDB stores keys in a LevelDB database, encrypting each private
+	// key with its own passphrase. This is the default backend.
+	BackendDB = "db"
+	// BackendFile stores each record in its own file, encrypted with a key
+	// derived from the keyring passphrase. Private keys have no passphrase of
+	// their own.
+	BackendFile = "file"
+	// BackendPass stores records in the password store managed by pass(1),
+	// encrypted with its GPG keys. Private keys have no passphrase of their
+	// own.
+	BackendPass = "pass"
+	// BackendMemory keeps keys in memory only; they are lost on exit.
+	BackendMemory = "memory"
+)
+
+var _ keyStore = dbm.DB(nil)
+
+// keyStore is the storage a Keybase persists its records to. It is satisfied
+// by tendermint's dbm.DB; like dbm.DB, implementations panic on I/O errors.
+type keyStore interface {
+	Get(key []byte) []byte
+	Set(key, value []byte)
+	SetSync(key, value []byte)
+	DeleteSync(key []byte)
+	Iterator(start, end []byte) dbm.Iterator
+}
+
+// PassphraseFn returns the passphrase protecting a keyring. newKeyring is true
+// if the keyring does not exist yet and is about to be created.
+type PassphraseFn func(newKeyring bool) (string, error)
+
+// NewInMemory creates a new keybase that keeps its keys in memory only.
+func NewInMemory() Keybase {
+	return New(dbm.NewMemDB())
+}
+
+// NewFile creates a new keybase that stores one encrypted file per record in
+// dir. The records are encrypted with a key derived from the keyring
+// passphrase, which is verified before the keybase is returned. Private keys
+// are stored without a passphrase of their own, the passphrases given to the
+// keybase for them are ignored.
+func NewFile(dir string, getPassphrase PassphraseFn) (Keybase, error) {
+	store, err := newFileStore(dir, getPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	return dbKeybase{db: store, encryptedStore: true}, nil
+}
+
+// NewPass creates a new keybase that stores its records in the pass(1)
+// password store, under the given prefix. Private keys are stored without a
+// passphrase of their own, the passphrases given to the keybase for them are
+// ignored.
+func NewPass(prefix string) (Keybase, error) {
+	store, err := newPassStore(prefix)
+	if err != nil {
+		return nil, err
+	}
+	return dbKeybase{db: store, encryptedStore: true}, nil
+}
+
+// EncryptedBackend tells whether a keyring backend encrypts the keys itself,
+// in which case they have no passphrase of their own.
+func EncryptedBackend(backend string) bool {
+	return backend == BackendFile || backend == BackendPass
+}
+
+// NewKeyring creates a new keybase for the given backend. The db backend keeps
+// its data in the dbName database of the "keys" subdirectory of rootDir, and
+// the file backend in the "keyring-file" subdirectory; getPassphrase is only
+// called by the file backend.
+func NewKeyring(backend, dbName, rootDir string, getPassphrase PassphraseFn) (Keybase, error) {
+	switch backend {
+	case BackendDB:
+		db, err := dbm.NewGoLevelDB(dbName, filepath.Join(rootDir, "keys"))
+		if err != nil {
+			return nil, err
+		}
+		return New(db), nil
+	case BackendFile:
+		return NewFile(filepath.Join(rootDir, "keyring-file"), getPassphrase)
+	case BackendPass:
+		return NewPass("cosmos")
+	case BackendMemory:
+		return NewInMemory(), nil
+	default:
+		return nil, fmt.Errorf("unknown keyring backend %q", backend)
+	}
+}
+
+//____________________________________________________________________
+
+// keyIterator iterates over a sorted set of keys, fetching values lazily.
+type keyIterator struct {
+	start, end []byte
+	keys       [][]byte
+	get        func(key []byte) []byte
+}
+
+var _ dbm.Iterator = (*keyIterator)(nil)
+
+// newKeyIterator returns an iterator over the keys within [start, end).
+// A nil start or end leaves the respective side of the domain unbounded.
+func newKeyIterator(keys [][]byte, start, end []byte, get func([]byte) []byte) *keyIterator {
+	var inDomain [][]byte
+	for _, key := range keys {
+		if start != nil && bytes.Compare(key, start) < 0 {
+			continue
+		}
+		if end != nil && bytes.Compare(key, end) >= 0 {
+			continue
+		}
+		inDomain = append(inDomain, key)
+	}
+	sort.Slice(inDomain, func(i, j int) bool {
+		return bytes.Compare(inDomain[i], inDomain[j]) < 0
+	})
+	return &keyIterator{start: start, end: end, keys: inDomain, get: get}
+}
+
+func (it *keyIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+func (it *keyIterator) Valid() bool              { return len(it.keys) > 0 }
+func (it *keyIterator) Close()                   { it.keys = nil }
+
+func (it *keyIterator) Next() {
+	it.assertValid()
+	it.keys = it.keys[1:]
+}
+
+func (it *keyIterator) Key() []byte {
+	it.assertValid()
+	return it.keys[0]
+}
+
+func (it *keyIterator) Value() []byte {
+	it.assertValid()
+	return it.get(it.keys[0])
+}
+
+func (it *keyIterator) assertValid() {
+	if !it.Valid() {
+		panic("keyIterator is invalid")
+	}
+}
@@ -0,0 +1,178 @@
+package keys
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// keyringMetaFile holds the KDF salt and a token used to verify the
+	// keyring passphrase.
+	keyringMetaFile  = ".keyring"
+	keyringSaltLen   = 32
+	keyringCheckText = "cosmos-sdk keyring"
+)
+
+// Make the scrypt cost parameter a var so that it can be lowered in tests.
+// See BcryptSecurityParameter for the security considerations.
+var KeyringScryptN = 1 << 15
+
+// ErrWrongKeyringPassphrase is returned when the passphrase supplied to a file
+// keyring fails to decrypt it.
+var ErrWrongKeyringPassphrase = errors.New("invalid keyring passphrase")
+
+// fileStore is a keyStore that writes each record to its own file. Values are
+// encrypted with xsalsa20-poly1305 using a key derived via scrypt from the
+// keyring passphrase.
+type fileStore struct {
+	dir    string
+	secret []byte
+}
+
+var _ keyStore = fileStore{}
+
+func newFileStore(dir string, getPassphrase PassphraseFn) (fileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fileStore{}, err
+	}
+
+	metaPath := filepath.Join(dir, keyringMetaFile)
+	meta, err := ioutil.ReadFile(metaPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fileStore{}, err
+	}
+	newKeyring := os.IsNotExist(err)
+
+	passphrase, err := getPassphrase(newKeyring)
+	if err != nil {
+		return fileStore{}, err
+	}
+
+	if newKeyring {
+		salt := crypto.CRandBytes(keyringSaltLen)
+		secret, err := deriveKeyringSecret(passphrase, salt)
+		if err != nil {
+			return fileStore{}, err
+		}
+		check := xsalsa20symmetric.EncryptSymmetric([]byte(keyringCheckText), secret)
+		if err := writeFileSync(metaPath, append(salt, check...)); err != nil {
+			return fileStore{}, err
+		}
+		return fileStore{dir: dir, secret: secret}, nil
+	}
+
+	if len(meta) < keyringSaltLen {
+		return fileStore{}, errors.New("corrupted keyring metadata")
+	}
+	secret, err := deriveKeyringSecret(passphrase, meta[:keyringSaltLen])
+	if err != nil {
+		return fileStore{}, err
+	}
+	check, err := xsalsa20symmetric.DecryptSymmetric(meta[keyringSaltLen:], secret)
+	if err != nil || !bytes.Equal(check, []byte(keyringCheckText)) {
+		return fileStore{}, ErrWrongKeyringPassphrase
+	}
+	return fileStore{dir: dir, secret: secret}, nil
+}
+
+func deriveKeyringSecret(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, KeyringScryptN, 8, 1, 32)
+}
+
+// Get implements keyStore. It returns nil if the record does not exist.
+func (fs fileStore) Get(key []byte) []byte {
+	bz, err := ioutil.ReadFile(fs.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		panic(err)
+	}
+	value, err := xsalsa20symmetric.DecryptSymmetric(bz, fs.secret)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Set implements keyStore.
+func (fs fileStore) Set(key, value []byte) {
+	fs.SetSync(key, value)
+}
+
+// SetSync implements keyStore.
+func (fs fileStore) SetSync(key, value []byte) {
+	bz := xsalsa20symmetric.EncryptSymmetric(value, fs.secret)
+	if err := writeFileSync(fs.path(key), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteSync implements keyStore.
+func (fs fileStore) DeleteSync(key []byte) {
+	err := os.Remove(fs.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
+}
+
+// Iterator implements keyStore.
+func (fs fileStore) Iterator(start, end []byte) dbm.Iterator {
+	files, err := ioutil.ReadDir(fs.dir)
+	if err != nil {
+		panic(err)
+	}
+
+	var keys [][]byte
+	for _, file := range files {
+		// skip the keyring metadata and temporary files
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+		key, err := url.PathUnescape(file.Name())
+		if err != nil {
+			panic(err)
+		}
+		keys = append(keys, []byte(key))
+	}
+	return newKeyIterator(keys, start, end, fs.Get)
+}
+
+// path returns the file a record is stored in. Leading dots are escaped so
+// that record files never clash with the keyring's own files.
+func (fs fileStore) path(key []byte) string {
+	name := url.PathEscape(string(key))
+	if strings.HasPrefix(name, ".") {
+		name = "%2E" + name[1:]
+	}
+	return filepath.Join(fs.dir, name)
+}
+
+// writeFileSync atomically replaces the file at path with the given data.
+func writeFileSync(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
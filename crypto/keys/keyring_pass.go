@@ -0,0 +1,125 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+const passFileExt = ".gpg"
+
+// passStore is a keyStore backed by pass(1), the standard unix password
+// manager. Each record is an entry of the password store under prefix, so
+// that entries are encrypted with the store's GPG keys and can be managed
+// with pass itself. Values are base64 encoded as entries are text.
+type passStore struct {
+	prefix   string
+	storeDir string
+}
+
+var _ keyStore = passStore{}
+
+func newPassStore(prefix string) (passStore, error) {
+	if _, err := exec.LookPath("pass"); err != nil {
+		return passStore{}, fmt.Errorf("pass keyring backend requires pass(1) to be installed: %v", err)
+	}
+
+	storeDir := os.Getenv("PASSWORD_STORE_DIR")
+	if storeDir == "" {
+		storeDir = os.ExpandEnv("$HOME/.password-store")
+	}
+	if _, err := os.Stat(storeDir); err != nil {
+		return passStore{}, fmt.Errorf("password store %s is not initialized, run 'pass init' first: %v", storeDir, err)
+	}
+
+	return passStore{prefix: prefix, storeDir: storeDir}, nil
+}
+
+// Get implements keyStore. It returns nil if the record does not exist.
+func (ps passStore) Get(key []byte) []byte {
+	name := ps.entryName(key)
+	if _, err := os.Stat(filepath.Join(ps.storeDir, name+passFileExt)); os.IsNotExist(err) {
+		return nil
+	}
+
+	out, err := ps.run(nil, "show", name)
+	if err != nil {
+		panic(err)
+	}
+	value, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Set implements keyStore.
+func (ps passStore) Set(key, value []byte) {
+	ps.SetSync(key, value)
+}
+
+// SetSync implements keyStore.
+func (ps passStore) SetSync(key, value []byte) {
+	entry := base64.StdEncoding.EncodeToString(value) + "\n"
+	if _, err := ps.run([]byte(entry), "insert", "--multiline", "--force", ps.entryName(key)); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteSync implements keyStore.
+func (ps passStore) DeleteSync(key []byte) {
+	if _, err := ps.run(nil, "rm", "--force", ps.entryName(key)); err != nil {
+		panic(err)
+	}
+}
+
+// Iterator implements keyStore.
+func (ps passStore) Iterator(start, end []byte) dbm.Iterator {
+	var keys [][]byte
+	err := filepath.Walk(filepath.Join(ps.storeDir, ps.prefix), func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return filepath.SkipDir
+		} else if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), passFileExt) {
+			return nil
+		}
+
+		key, err := url.PathUnescape(strings.TrimSuffix(info.Name(), passFileExt))
+		if err != nil {
+			return err
+		}
+		keys = append(keys, []byte(key))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return newKeyIterator(keys, start, end, ps.Get)
+}
+
+// entryName returns the name of the password store entry holding a record.
+func (ps passStore) entryName(key []byte) string {
+	return ps.prefix + "/" + url.PathEscape(string(key))
+}
+
+func (ps passStore) run(stdin []byte, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("pass", args...)
+	cmd.Env = append(os.Environ(), "PASSWORD_STORE_DIR="+ps.storeDir)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pass %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
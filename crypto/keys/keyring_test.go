@@ -0,0 +1,131 @@
+package keys
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	KeyringScryptN = 2
+}
+
+func passphrase(pass string) PassphraseFn {
+	return func(bool) (string, error) { return pass, nil }
+}
+
+func TestFileKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var created bool
+	kb, err := NewFile(dir, func(newKeyring bool) (string, error) {
+		created = newKeyring
+		return "keyring-pass", nil
+	})
+	require.NoError(t, err)
+	require.True(t, created)
+
+	// keys need no passphrase of their own
+	info, _, err := kb.CreateMnemonic("alice", English, "", Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, TypeLocal, info.GetType())
+	require.False(t, NeedsPassphrase(info))
+	_, _, err = kb.CreateMnemonic(".bob", English, "1234", Secp256k1)
+	require.NoError(t, err)
+
+	// reopening the keyring requires the same passphrase
+	_, err = NewFile(dir, passphrase("wrong"))
+	require.Equal(t, ErrWrongKeyringPassphrase, err)
+
+	kb, err = NewFile(dir, func(newKeyring bool) (string, error) {
+		created = newKeyring
+		return "keyring-pass", nil
+	})
+	require.NoError(t, err)
+	require.False(t, created)
+
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, ".bob", infos[0].GetName())
+	require.Equal(t, "alice", infos[1].GetName())
+
+	byAddr, err := kb.GetByAddress(info.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), byAddr.GetPubKey())
+
+	sig, pub, err := kb.Sign("alice", "", []byte("msg"))
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes([]byte("msg"), sig))
+	sig, pub, err = kb.Sign(".bob", "", []byte("msg"))
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes([]byte("msg"), sig))
+	require.Error(t, kb.Update("alice", "", func() (string, error) { return "5678", nil }))
+
+	// records are encrypted at rest
+	bz, err := ioutil.ReadFile(kb.(dbKeybase).db.(fileStore).path(infoKey("alice")))
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "alice")
+	store := kb.(dbKeybase).db
+	require.NotEmpty(t, store.Get(privKeyKey("alice")))
+
+	// deleting a key without passphrase requires confirmation
+	require.Error(t, kb.Delete("alice", "1234"))
+	require.NoError(t, kb.Delete("alice", "yes"))
+	require.Empty(t, store.Get(privKeyKey("alice")))
+	infos, err = kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	_, err = kb.GetByAddress(info.GetAddress())
+	require.Error(t, err)
+}
+
+func TestNeedsPassphrase(t *testing.T) {
+	kb := NewInMemory()
+	info, _, err := kb.CreateMnemonic("alice", English, "1234", Secp256k1)
+	require.NoError(t, err)
+	require.True(t, NeedsPassphrase(info))
+	info, err = kb.Get("alice")
+	require.NoError(t, err)
+	require.True(t, NeedsPassphrase(info))
+
+	info, err = kb.CreateOffline("bob", info.GetPubKey())
+	require.NoError(t, err)
+	require.False(t, NeedsPassphrase(info))
+}
+
+func TestNewKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kb, err := NewKeyring(BackendMemory, "keys", dir, nil)
+	require.NoError(t, err)
+	_, _, err = kb.CreateMnemonic("alice", English, "1234", Secp256k1)
+	require.NoError(t, err)
+
+	_, err = NewKeyring(BackendFile, "keys", dir, passphrase("keyring-pass"))
+	require.NoError(t, err)
+
+	_, err = NewKeyring("unknown", "keys", dir, nil)
+	require.Error(t, err)
+}
+
+func TestKeyIterator(t *testing.T) {
+	keys := [][]byte{[]byte("c"), []byte("a"), []byte("b"), []byte("d")}
+	it := newKeyIterator(keys, []byte("b"), []byte("d"), func(key []byte) []byte {
+		return append([]byte("value-"), key...)
+	})
+
+	var got []string
+	for ; it.Valid(); it.Next() {
+		got = append(got, string(it.Key())+"="+string(it.Value()))
+	}
+	it.Close()
+	require.Equal(t, []string{"b=value-b", "c=value-c"}, got)
+	require.Panics(t, func() { it.Key() })
+}
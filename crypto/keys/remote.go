@@ -0,0 +1,321 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Paths served by a remote signer.
+const (
+	RemoteSignerSignPath   = "/sign"
+	RemoteSignerPubKeyPath = "/pubkey"
+)
+
+// RemoteSignerTimeout bounds the time a remote signer may take to reply.
+var RemoteSignerTimeout = 30 * time.Second
+
+// RemoteSignRequest is sent to a remote signer. SignBytes is empty for
+// requests to RemoteSignerPubKeyPath.
+type RemoteSignRequest struct {
+	KeyName   string `json:"key_name"`
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+// RemoteSignResponse is returned by a remote signer. Signature is empty for
+// requests to RemoteSignerPubKeyPath. Error is set if the request failed.
+type RemoteSignResponse struct {
+	Signature []byte        `json:"signature"`
+	PubKey    crypto.PubKey `json:"pub_key"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// MarshalRemoteSignerJSON encodes remote signer requests and responses.
+func MarshalRemoteSignerJSON(o interface{}) ([]byte, error) {
+	return cdc.MarshalJSON(o)
+}
+
+// UnmarshalRemoteSignerJSON decodes remote signer requests and responses.
+func UnmarshalRemoteSignerJSON(bz []byte, ptr interface{}) error {
+	return cdc.UnmarshalJSON(bz, ptr)
+}
+
+// RemoteSignerTLS locates the files used for the mutual TLS authentication of
+// a remote signer and its clients: the certificate and key presented to the
+// other side, and the CA the certificate of the other side must be issued by.
+type RemoteSignerTLS struct {
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	CAFile   string `json:"ca_file"`
+}
+
+// IsEmpty returns true if no TLS file is set.
+func (t RemoteSignerTLS) IsEmpty() bool {
+	return t.CertFile == "" && t.KeyFile == "" && t.CAFile == ""
+}
+
+// ServerConfig returns the TLS configuration of a remote signer, which only
+// accepts clients presenting a certificate issued by the CA.
+func (t RemoteSignerTLS) ServerConfig() (*tls.Config, error) {
+	cert, pool, err := t.load()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ClientConfig returns the TLS configuration of a client of a remote signer,
+// which only trusts signers presenting a certificate issued by the CA.
+func (t RemoteSignerTLS) ClientConfig() (*tls.Config, error) {
+	cert, pool, err := t.load()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (t RemoteSignerTLS) load() (tls.Certificate, *x509.CertPool, error) {
+	if t.CertFile == "" || t.KeyFile == "" || t.CAFile == "" {
+		return tls.Certificate{}, nil, errors.New("mutual TLS requires a certificate, a key and a CA certificate")
+	}
+	cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+	caPEM, err := ioutil.ReadFile(t.CAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("no CA certificate found in %s", t.CAFile)
+	}
+	return cert, pool, nil
+}
+
+// signRemote asks the signer listening on endpoint to sign msg with the key it
+// holds under keyName. An endpoint is either an https:// URL, authenticated
+// with mutual TLS, or a unix:///path/to/socket address.
+func signRemote(endpoint, keyName string, tlsFiles RemoteSignerTLS, msg []byte) ([]byte, crypto.PubKey, error) {
+	res, err := callRemoteSigner(endpoint, tlsFiles, RemoteSignerSignPath, RemoteSignRequest{KeyName: keyName, SignBytes: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.PubKey == nil || !res.PubKey.VerifyBytes(msg, res.Signature) {
+		return nil, nil, errors.New("remote signer returned an invalid signature")
+	}
+	return res.Signature, res.PubKey, nil
+}
+
+// fetchRemotePubKey returns the public key of the key a remote signer holds
+// under keyName.
+func fetchRemotePubKey(endpoint, keyName string, tlsFiles RemoteSignerTLS) (crypto.PubKey, error) {
+	res, err := callRemoteSigner(endpoint, tlsFiles, RemoteSignerPubKeyPath, RemoteSignRequest{KeyName: keyName})
+	if err != nil {
+		return nil, err
+	}
+	if res.PubKey == nil {
+		return nil, errors.New("remote signer returned no public key")
+	}
+	return res.PubKey, nil
+}
+
+func callRemoteSigner(endpoint string, tlsFiles RemoteSignerTLS, path string,
+	req RemoteSignRequest) (res RemoteSignResponse, err error) {
+
+	httpClient, baseURL, err := remoteSignerClient(endpoint, tlsFiles)
+	if err != nil {
+		return
+	}
+
+	bz, err := MarshalRemoteSignerJSON(req)
+	if err != nil {
+		return
+	}
+	httpRes, err := httpClient.Post(baseURL+path, "application/json", bytes.NewReader(bz))
+	if err != nil {
+		return
+	}
+	defer httpRes.Body.Close() // nolint: errcheck
+
+	body, err := ioutil.ReadAll(httpRes.Body)
+	if err != nil {
+		return
+	}
+	if err = UnmarshalRemoteSignerJSON(body, &res); err != nil {
+		return res, fmt.Errorf("invalid response from remote signer (status %d): %v", httpRes.StatusCode, err)
+	}
+	if res.Error != "" {
+		return res, fmt.Errorf("remote signer: %s", res.Error)
+	}
+	if httpRes.StatusCode != http.StatusOK {
+		return res, fmt.Errorf("remote signer replied with status %d", httpRes.StatusCode)
+	}
+	return res, nil
+}
+
+// remoteSignerClient returns an HTTP client able to reach endpoint along with
+// the base URL requests should be made to. Signers reached over the network
+// must be authenticated with mutual TLS, as they sign anything they are sent.
+func remoteSignerClient(endpoint string, tlsFiles RemoteSignerTLS) (*http.Client, string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch u.Scheme {
+	case "https":
+		config, err := tlsFiles.ClientConfig()
+		if err != nil {
+			return nil, "", err
+		}
+		transport := &http.Transport{TLSClientConfig: config}
+		return &http.Client{Timeout: RemoteSignerTimeout, Transport: transport}, strings.TrimSuffix(endpoint, "/"), nil
+	case "unix":
+		socket := u.Host + u.Path
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		}
+		return &http.Client{Timeout: RemoteSignerTimeout, Transport: transport}, "http://remote-signer", nil
+	default:
+		return nil, "", fmt.Errorf("unsupported remote signer endpoint %q: expected https:// or unix://", endpoint)
+	}
+}
+
+//____________________________________________________________________
+
+// RemoteSigner is a reference remote signer. It serves signing requests for a
+// set of private keys over HTTP and records every request to an audit log.
+type RemoteSigner struct {
+	keys map[string]crypto.PrivKey
+
+	mtx   sync.Mutex
+	audit io.Writer
+}
+
+var _ http.Handler = (*RemoteSigner)(nil)
+
+// RemoteSignerAuditEntry is the audit log record of a single request served by
+// a RemoteSigner. Entries are written as one JSON object per line.
+type RemoteSignerAuditEntry struct {
+	Time          time.Time `json:"time"`
+	RemoteAddr    string    `json:"remote_addr"`
+	Path          string    `json:"path"`
+	KeyName       string    `json:"key_name"`
+	SignBytes     string    `json:"sign_bytes,omitempty"`
+	SignBytesHash string    `json:"sign_bytes_sha256,omitempty"`
+	Success       bool      `json:"success"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// NewRemoteSigner returns a RemoteSigner signing with the given keys, indexed
+// by the name clients refer to them with, and writing its audit log to audit.
+func NewRemoteSigner(keys map[string]crypto.PrivKey, audit io.Writer) *RemoteSigner {
+	return &RemoteSigner{keys: keys, audit: audit}
+}
+
+// ServeHTTP implements http.Handler.
+func (rs *RemoteSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	entry := RemoteSignerAuditEntry{
+		Time:       time.Now().UTC(),
+		RemoteAddr: r.RemoteAddr,
+		Path:       r.URL.Path,
+	}
+
+	res, status := rs.handle(r, &entry)
+	entry.Success = res.Error == ""
+	entry.Error = res.Error
+	if err := rs.writeAuditEntry(entry); err != nil {
+		// never sign without leaving a trace
+		res, status = RemoteSignResponse{Error: "failed to write audit log"}, http.StatusInternalServerError
+	}
+
+	bz, err := MarshalRemoteSignerJSON(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(bz) // nolint: errcheck
+}
+
+func (rs *RemoteSigner) handle(r *http.Request, entry *RemoteSignerAuditEntry) (RemoteSignResponse, int) {
+	if r.Method != http.MethodPost {
+		return RemoteSignResponse{Error: "method not allowed"}, http.StatusMethodNotAllowed
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return RemoteSignResponse{Error: err.Error()}, http.StatusBadRequest
+	}
+	var req RemoteSignRequest
+	if err := UnmarshalRemoteSignerJSON(body, &req); err != nil {
+		return RemoteSignResponse{Error: err.Error()}, http.StatusBadRequest
+	}
+	entry.KeyName = req.KeyName
+
+	priv, ok := rs.keys[req.KeyName]
+	if !ok {
+		return RemoteSignResponse{Error: fmt.Sprintf("unknown key %s", req.KeyName)}, http.StatusNotFound
+	}
+
+	switch r.URL.Path {
+	case RemoteSignerPubKeyPath:
+		return RemoteSignResponse{PubKey: priv.PubKey()}, http.StatusOK
+
+	case RemoteSignerSignPath:
+		hash := sha256.Sum256(req.SignBytes)
+		entry.SignBytes = string(req.SignBytes)
+		entry.SignBytesHash = hex.EncodeToString(hash[:])
+
+		sig, err := priv.Sign(req.SignBytes)
+		if err != nil {
+			return RemoteSignResponse{Error: err.Error()}, http.StatusInternalServerError
+		}
+		return RemoteSignResponse{Signature: sig, PubKey: priv.PubKey()}, http.StatusOK
+
+	default:
+		return RemoteSignResponse{Error: "not found"}, http.StatusNotFound
+	}
+}
+
+func (rs *RemoteSigner) writeAuditEntry(entry RemoteSignerAuditEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+	_, err = rs.audit.Write(append(bz, '\n'))
+	return err
+}
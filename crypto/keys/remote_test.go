@@ -0,0 +1,174 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	audit := new(bytes.Buffer)
+	signer := NewRemoteSigner(map[string]crypto.PrivKey{"custody": priv}, audit)
+	serverTLS, clientTLS := generateTestTLS(t)
+	serverConfig, err := serverTLS.ServerConfig()
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(signer)
+	server.TLS = serverConfig
+	server.StartTLS()
+	defer server.Close()
+
+	kb := NewInMemory()
+
+	// the referenced key must exist on the signer
+	_, err = kb.CreateRemote("operator", server.URL, "unknown", clientTLS)
+	require.Error(t, err)
+
+	info, err := kb.CreateRemote("operator", server.URL, "custody", clientTLS)
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, info.GetType())
+	require.Equal(t, priv.PubKey(), info.GetPubKey())
+
+	// the stored reference survives a round trip through the keybase
+	info, err = kb.Get("operator")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, info.GetType())
+
+	msg := []byte(`{"msg":"sign me"}`)
+	sig, pub, err := kb.Sign("operator", "", msg)
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	_, err = kb.ExportPrivateKeyObject("operator", "")
+	require.Error(t, err)
+
+	// every request is audited
+	lines := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	require.Len(t, lines, 3)
+
+	var entry RemoteSignerAuditEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, "unknown", entry.KeyName)
+	require.False(t, entry.Success)
+
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &entry))
+	require.Equal(t, RemoteSignerSignPath, entry.Path)
+	require.Equal(t, "custody", entry.KeyName)
+	require.Equal(t, string(msg), entry.SignBytes)
+	require.True(t, entry.Success)
+
+	require.Error(t, kb.Delete("operator", "no"))
+	require.NoError(t, kb.Delete("operator", "yes"))
+}
+
+func TestRemoteSignerMutualTLS(t *testing.T) {
+	signer := NewRemoteSigner(map[string]crypto.PrivKey{"custody": secp256k1.GenPrivKey()}, new(bytes.Buffer))
+	serverTLS, _ := generateTestTLS(t)
+	serverConfig, err := serverTLS.ServerConfig()
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(signer)
+	server.TLS = serverConfig
+	server.StartTLS()
+	defer server.Close()
+
+	// clients with a certificate from another CA are rejected, and so is the
+	// signer by these clients
+	_, otherTLS := generateTestTLS(t)
+	_, err = fetchRemotePubKey(server.URL, "custody", otherTLS)
+	require.Error(t, err)
+}
+
+func TestRemoteSignerEndpoint(t *testing.T) {
+	_, clientTLS := generateTestTLS(t)
+
+	_, _, err := remoteSignerClient("ftp://localhost", clientTLS)
+	require.Error(t, err)
+
+	// signers reached over the network must use mutual TLS
+	_, _, err = remoteSignerClient("http://localhost:8080/", clientTLS)
+	require.Error(t, err)
+	_, _, err = remoteSignerClient("https://localhost:8080/", RemoteSignerTLS{})
+	require.Error(t, err)
+
+	_, baseURL, err := remoteSignerClient("https://localhost:8080/", clientTLS)
+	require.NoError(t, err)
+	require.Equal(t, "https://localhost:8080", baseURL)
+
+	_, baseURL, err = remoteSignerClient("unix:///tmp/signer.sock", RemoteSignerTLS{})
+	require.NoError(t, err)
+	require.Equal(t, "http://remote-signer", baseURL)
+}
+
+// generateTestTLS writes a new CA, and a server and a client certificate it
+// issued, to a temporary directory
+func generateTestTLS(t *testing.T) (server, client RemoteSignerTLS) {
+	dir, err := ioutil.TempDir("", "remote-signer-tls")
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caFile := filepath.Join(dir, "ca.pem")
+	writeTestPEM(t, caFile, "CERTIFICATE", caDER)
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) RemoteSignerTLS {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+
+		files := RemoteSignerTLS{
+			CertFile: filepath.Join(dir, name+".pem"),
+			KeyFile:  filepath.Join(dir, name+"-key.pem"),
+			CAFile:   caFile,
+		}
+		writeTestPEM(t, files.CertFile, "CERTIFICATE", der)
+		writeTestPEM(t, files.KeyFile, "EC PRIVATE KEY", keyDER)
+		return files
+	}
+	return issue("server", 2, x509.ExtKeyUsageServerAuth), issue("client", 3, x509.ExtKeyUsageClientAuth)
+}
+
+func writeTestPEM(t *testing.T, path, blockType string, der []byte) {
+	bz := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, ioutil.WriteFile(path, bz, 0600))
+}
@@ -34,6 +34,11 @@ type Keybase interface {
 	// Create, store, and return a new offline key reference
 	CreateOffline(name string, pubkey crypto.PubKey) (info Info, err error)
 
+	// Create, store, and return a new reference to a key held by the remote
+	// signer listening on endpoint under the name remoteName, authenticated
+	// with the given TLS files if reached over https
+	CreateRemote(name, endpoint, remoteName string, tlsFiles RemoteSignerTLS) (info Info, err error)
+
 	// The following operations will *only* work on locally-stored keys
 	Update(name, oldpass string, getNewpass func() (string, error)) error
 	Import(name string, armor string) (err error)
@@ -53,12 +58,14 @@ const (
 	TypeLocal   KeyType = 0
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
+	TypeRemote  KeyType = 3
 )
 
 var keyTypes = map[KeyType]string{
 	TypeLocal:   "local",
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
+	TypeRemote:  "remote",
 }
 
 // String implements the stringer interface for KeyType.
@@ -81,6 +88,7 @@ type Info interface {
 var _ Info = &localInfo{}
 var _ Info = &ledgerInfo{}
 var _ Info = &offlineInfo{}
+var _ Info = &remoteInfo{}
 
 // localInfo is the public information about a locally stored key
 type localInfo struct {
@@ -113,6 +121,20 @@ func (i localInfo) GetAddress() types.AccAddress {
 	return i.PubKey.Address().Bytes()
 }
 
+// NeedsPassphrase tells whether a passphrase is required to sign with a key,
+// i.e. whether it is a local key encrypted with a passphrase of its own rather
+// than by the keyring it is stored in.
+func NeedsPassphrase(info Info) bool {
+	switch i := info.(type) {
+	case localInfo:
+		return i.PrivKeyArmor != ""
+	case *localInfo:
+		return i.PrivKeyArmor != ""
+	default:
+		return false
+	}
+}
+
 // ledgerInfo is the public information about a Ledger key
 type ledgerInfo struct {
 	Name   string                 `json:"name"`
@@ -173,6 +195,41 @@ func (i offlineInfo) GetAddress() types.AccAddress {
 	return i.PubKey.Address().Bytes()
 }
 
+// remoteInfo is the public information about a key held by a remote signer
+type remoteInfo struct {
+	Name       string          `json:"name"`
+	PubKey     crypto.PubKey   `json:"pubkey"`
+	Endpoint   string          `json:"endpoint"`
+	RemoteName string          `json:"remote_name"`
+	TLS        RemoteSignerTLS `json:"tls"`
+}
+
+func newRemoteInfo(name string, pub crypto.PubKey, endpoint, remoteName string, tlsFiles RemoteSignerTLS) Info {
+	return &remoteInfo{
+		Name:       name,
+		PubKey:     pub,
+		Endpoint:   endpoint,
+		RemoteName: remoteName,
+		TLS:        tlsFiles,
+	}
+}
+
+func (i remoteInfo) GetType() KeyType {
+	return TypeRemote
+}
+
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+func (i remoteInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+func (i remoteInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
 // encoding info
 func writeInfo(i Info) []byte {
 	return cdc.MustMarshalBinary(i)
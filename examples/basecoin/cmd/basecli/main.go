@@ -70,9 +70,12 @@ func main() {
 			ibccmd.IBCRelayCmd(cdc),
 			stakecmd.GetCmdCreateValidator(cdc),
 			stakecmd.GetCmdEditValidator(cdc),
+			stakecmd.GetCmdRotateConsPubKey(cdc),
 			stakecmd.GetCmdDelegate(cdc),
 			stakecmd.GetCmdUnbond("stake", cdc),
 			stakecmd.GetCmdRedelegate("stake", cdc),
+			stakecmd.GetCmdTransferDelegation("stake", cdc),
+			stakecmd.GetCmdCancelUnbonding(cdc),
 			slashingcmd.GetCmdUnjail(cdc),
 		)...)
 
@@ -116,6 +116,11 @@ func (vs *ValidatorSet) TotalPower(ctx sdk.Context) sdk.Dec {
 	return res
 }
 
+// ValidatorPowerAt implements sdk.ValidatorSet
+func (vs *ValidatorSet) ValidatorPowerAt(_ sdk.Context, _ sdk.ConsAddress, _ int64) (int64, bool) {
+	panic("not implemented")
+}
+
 // Helper function for adding new validator
 func (vs *ValidatorSet) AddValidator(val Validator) {
 	vs.Validators = append(vs.Validators, val)
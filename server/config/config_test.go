@@ -9,11 +9,12 @@ import (
 
 func TestDefaultConfig(t *testing.T) {
 	cfg := DefaultConfig()
-	require.True(t, cfg.MinimumFees().IsZero())
+	require.True(t, cfg.GetMinGasPrices().IsZero())
 }
 
-func TestSetMinimumFees(t *testing.T) {
+func TestSetMinGasPrices(t *testing.T) {
 	cfg := DefaultConfig()
-	cfg.SetMinimumFees(sdk.Coins{sdk.NewCoin("foo", sdk.NewInt(100))})
-	require.Equal(t, "100foo", cfg.MinFees)
+	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewDecCoin("foo", 5)})
+	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin("foo", 5)}, cfg.GetMinGasPrices())
 }
@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// MetadataBlockHeight is the request metadata selecting the height queries
+// are run at, and the response header holding the height they were run at.
+// Queries run at the latest height by default.
+const MetadataBlockHeight = "x-cosmos-block-height"
+
+// Application is implemented by the applications exposing the queriers of
+// their modules over gRPC.
+type Application interface {
+	RegisterGRPCServices(srv *gogrpc.Server, querier Querier)
+}
+
+// Querier runs an ABCI query at the given height, or at the latest one if
+// zero, and returns its result and the height it was run at.
+type Querier func(path string, data []byte, height int64) ([]byte, int64, error)
+
+// NodeQuerier returns a querier running ABCI queries through a node.
+func NodeQuerier(node rpcclient.Client) Querier {
+	return func(path string, data []byte, height int64) ([]byte, int64, error) {
+		opts := rpcclient.ABCIQueryOptions{Height: height, Trusted: true}
+		res, err := node.ABCIQueryWithOptions(path, data, opts)
+		if err != nil {
+			return nil, 0, status.Error(codes.Unavailable, err.Error())
+		}
+		if !res.Response.IsOK() {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "query failed: (%d) %s", res.Response.Code, res.Response.Log)
+		}
+		return res.Response.Value, res.Response.Height, nil
+	}
+}
+
+// Query runs the ABCI query at path, at the height selected by the request
+// metadata, and decodes its amino JSON result into res. params, which may be
+// nil for queries without parameters, are amino JSON encoded. The height the
+// query was run at is sent in the response header.
+func Query(ctx context.Context, cdc *codec.Codec, querier Querier, path string, params, res interface{}) error {
+	height, err := requestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if params != nil {
+		data, err = cdc.MarshalJSON(params)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	bz, resHeight, err := querier(path, data, height)
+	if err != nil {
+		return err
+	}
+	if err := cdc.UnmarshalJSON(bz, res); err != nil {
+		return status.Errorf(codes.Internal, "could not decode the query result: %v", err)
+	}
+
+	// fails only if the context does not belong to a server call, e.g. if
+	// the service is called directly
+	gogrpc.SetHeader(ctx, metadata.Pairs(MetadataBlockHeight, strconv.FormatInt(resHeight, 10))) // nolint: errcheck
+	return nil
+}
+
+// requestHeight returns the height selected by the metadata of a request.
+func requestHeight(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(MetadataBlockHeight)) == 0 {
+		return 0, nil
+	}
+	height, err := strconv.ParseInt(md.Get(MetadataBlockHeight)[0], 10, 64)
+	if err != nil || height < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s metadata", MetadataBlockHeight)
+	}
+	return height, nil
+}
+
+// InvalidArgument returns the status error of a request that cannot be
+// converted into the params of a query, e.g. because of a malformed address.
+func InvalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// StartServer starts a gRPC server listening on addr, serving the transaction
+// service, the query services of the application if it has any, and server
+// reflection. JSON transactions are decoded with cdc.
+func StartServer(addr string, cdc *codec.Codec, node rpcclient.Client, app interface{}, logger log.Logger) (*gogrpc.Server, error) {
+	protocol, address := cmn.ProtocolAndAddress(addr)
+	listener, err := net.Listen(protocol, address)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := gogrpc.NewServer()
+	RegisterTxService(srv, cdc, node)
+	if app, ok := app.(Application); ok {
+		app.RegisterGRPCServices(srv, NodeQuerier(node))
+	}
+	reflection.Register(srv)
+
+	go func() {
+		if err := srv.Serve(listener); err != nil {
+			logger.Error("gRPC server stopped", "err", err)
+		}
+	}()
+	logger.Info("Starting gRPC server", "addr", addr)
+	return srv, nil
+}
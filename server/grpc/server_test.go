@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+type testParams struct {
+	Value string
+}
+
+type testResult struct {
+	Path string
+	Data string
+}
+
+// testQuerier answers with the path and data of the query, at the requested
+// height or at height 10.
+func testQuerier(path string, data []byte, height int64) ([]byte, int64, error) {
+	if height > 10 {
+		return nil, 0, status.Error(codes.InvalidArgument, "height too high")
+	}
+	if height == 0 {
+		height = 10
+	}
+	return codec.New().MustMarshalJSON(testResult{Path: path, Data: string(data)}), height, nil
+}
+
+func TestQuery(t *testing.T) {
+	cdc := codec.New()
+
+	var res testResult
+	err := Query(context.Background(), cdc, testQuerier, "custom/test/echo", testParams{Value: "foo"}, &res)
+	require.NoError(t, err)
+	require.Equal(t, testResult{Path: "custom/test/echo", Data: `{"Value":"foo"}`}, res)
+
+	err = Query(context.Background(), cdc, testQuerier, "custom/test/latest", nil, &res)
+	require.NoError(t, err)
+	require.Equal(t, testResult{Path: "custom/test/latest"}, res)
+
+	// errors of the querier are returned as is
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataBlockHeight, "11"))
+	err = Query(ctx, cdc, testQuerier, "custom/test/latest", nil, &res)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataBlockHeight, "-1"))
+	err = Query(ctx, cdc, testQuerier, "custom/test/latest", nil, &res)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the result must decode into the response
+	var wrong []string
+	err = Query(context.Background(), cdc, testQuerier, "custom/test/latest", nil, &wrong)
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestRequestHeight(t *testing.T) {
+	height, err := requestHeight(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataBlockHeight, "5"))
+	height, err = requestHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), height)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataBlockHeight, "five"))
+	_, err = requestHeight(ctx)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTxBytes(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+	s := txServer{cdc: cdc}
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	stdTx := auth.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, auth.NewStdFee(5000), nil, "memo")
+	aminoTx := cdc.MustMarshalBinary(stdTx)
+
+	// JSON transactions are amino encoded
+	bz, err := s.txBytes(nil, string(cdc.MustMarshalJSON(stdTx)))
+	require.NoError(t, err)
+	require.Equal(t, aminoTx, bz)
+
+	bz, err = s.txBytes(aminoTx, "")
+	require.NoError(t, err)
+	require.Equal(t, aminoTx, bz)
+
+	_, err = s.txBytes(nil, "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.txBytes(aminoTx, "{}")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.txBytes(nil, `{"type":"unknown"}`)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReflection(t *testing.T) {
+	srv := gogrpc.NewServer()
+	RegisterTxService(srv, codec.New(), nil)
+	reflection.Register(srv)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(listener) // nolint: errcheck
+	defer srv.Stop()
+
+	conn, err := gogrpc.Dial(listener.Addr().String(), gogrpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	defer stream.CloseSend() // nolint: errcheck
+
+	// services are listed
+	err = stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	})
+	require.NoError(t, err)
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	var services []string
+	for _, svc := range res.GetListServicesResponse().Service {
+		services = append(services, svc.Name)
+	}
+	require.Contains(t, services, "cosmos.tx.Service")
+
+	// the file declaring a service is served
+	err = stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "cosmos.tx.Service"},
+	})
+	require.NoError(t, err)
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	require.Nil(t, res.GetErrorResponse())
+	require.Len(t, res.GetFileDescriptorResponse().FileDescriptorProto, 1)
+}
+
+func TestPageRequest(t *testing.T) {
+	req := &PageRequest{Key: []byte("key"), Offset: 10, Limit: 5, CountTotal: true}
+	bz, err := proto.Marshal(req)
+	require.NoError(t, err)
+
+	var decoded PageRequest
+	require.NoError(t, proto.Unmarshal(bz, &decoded))
+	require.True(t, proto.Equal(req, &decoded))
+
+	sdkReq := decoded.SDKPageRequest()
+	require.Equal(t, []byte("key"), []byte(sdkReq.Key))
+	require.Equal(t, int64(10), sdkReq.Offset)
+	require.Equal(t, int64(5), sdkReq.Limit)
+	require.True(t, sdkReq.CountTotal)
+
+	var nilReq *PageRequest
+	require.Zero(t, nilReq.SDKPageRequest())
+}
@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// RegisterTxService registers the transaction service, which broadcasts and
+// simulates transactions through a node. JSON transactions are decoded with
+// cdc, which must know the messages of the application.
+func RegisterTxService(srv *gogrpc.Server, cdc *codec.Codec, node rpcclient.Client) {
+	RegisterServiceServer(srv, txServer{cdc: cdc, node: node, querier: NodeQuerier(node)})
+}
+
+type txServer struct {
+	cdc     *codec.Codec
+	node    rpcclient.Client
+	querier Querier
+}
+
+var _ ServiceServer = txServer{}
+
+// BroadcastTx implements ServiceServer.
+func (s txServer) BroadcastTx(ctx context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
+	txBytes, err := s.txBytes(req.Tx, req.JsonTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Commit {
+		res, err := s.node.BroadcastTxSync(txBytes)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return &BroadcastTxResponse{
+			Hash: res.Hash,
+			Code: res.Code,
+			Log:  res.Log,
+			Data: res.Data,
+		}, nil
+	}
+
+	res, err := s.node.BroadcastTxCommit(txBytes)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if !res.CheckTx.IsOK() {
+		return &BroadcastTxResponse{
+			Hash:      res.Hash,
+			Code:      res.CheckTx.Code,
+			Log:       res.CheckTx.Log,
+			GasWanted: res.CheckTx.GasWanted,
+			GasUsed:   res.CheckTx.GasUsed,
+			Data:      res.CheckTx.Data,
+		}, nil
+	}
+	return &BroadcastTxResponse{
+		Hash:      res.Hash,
+		Height:    res.Height,
+		Code:      res.DeliverTx.Code,
+		Log:       res.DeliverTx.Log,
+		GasWanted: res.DeliverTx.GasWanted,
+		GasUsed:   res.DeliverTx.GasUsed,
+		Data:      res.DeliverTx.Data,
+	}, nil
+}
+
+// Simulate implements ServiceServer.
+func (s txServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
+	txBytes, err := s.txBytes(req.Tx, req.JsonTx)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := requestHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bz, _, err := s.querier("/app/simulate", txBytes, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var result sdk.Result
+	if err := codec.Cdc.UnmarshalBinary(bz, &result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !result.IsOK() {
+		return nil, status.Errorf(codes.InvalidArgument, "simulation failed: (%d) %s", result.Code, result.Log)
+	}
+	return &SimulateResponse{GasUsed: result.GasUsed, Log: result.Log}, nil
+}
+
+// txBytes returns the amino encoding of a transaction given either amino or
+// JSON encoded.
+func (s txServer) txBytes(txBytes []byte, jsonTx string) ([]byte, error) {
+	switch {
+	case len(txBytes) > 0 && jsonTx != "":
+		return nil, status.Error(codes.InvalidArgument, "only one of tx and json_tx may be set")
+	case len(txBytes) > 0:
+		return txBytes, nil
+	case jsonTx == "":
+		return nil, status.Error(codes.InvalidArgument, "missing transaction")
+	}
+
+	var stdTx auth.StdTx
+	if err := s.cdc.UnmarshalJSON([]byte(jsonTx), &stdTx); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid JSON transaction: %v", err)
+	}
+	bz, err := s.cdc.MarshalBinary(stdTx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return bz, nil
+}
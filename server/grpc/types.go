@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SDKPageRequest converts the page request, which may be nil, to the type used
+// by the queriers.
+func (m *PageRequest) SDKPageRequest() sdk.PageRequest {
+	if m == nil {
+		return sdk.PageRequest{}
+	}
+	return sdk.PageRequest{
+		Key:        m.Key,
+		Offset:     int64(m.Offset),
+		Limit:      int64(m.Limit),
+		CountTotal: m.CountTotal,
+	}
+}
+
+// NewPageResponse converts the page response of a querier.
+func NewPageResponse(page sdk.PageResponse) *PageResponse {
+	return &PageResponse{NextKey: page.NextKey, Total: uint64(page.Total)}
+}
+
+// NewCoins converts coins to their protobuf messages.
+func NewCoins(coins sdk.Coins) []*Coin {
+	res := make([]*Coin, len(coins))
+	for i, coin := range coins {
+		res[i] = NewCoin(coin)
+	}
+	return res
+}
+
+// NewCoin converts a coin to its protobuf message.
+func NewCoin(coin sdk.Coin) *Coin {
+	return &Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+}
+
+// NewDecCoins converts decimal coins to their protobuf messages.
+func NewDecCoins(coins sdk.DecCoins) []*DecCoin {
+	res := make([]*DecCoin, len(coins))
+	for i, coin := range coins {
+		res[i] = &DecCoin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return res
+}
+
+// NewTimestamp converts a time to a protobuf timestamp.
+func NewTimestamp(t time.Time) *timestamp.Timestamp {
+	return &timestamp.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+}
+
+// NewDuration converts a duration to a protobuf duration.
+func NewDuration(d time.Duration) *duration.Duration {
+	return &duration.Duration{Seconds: int64(d / time.Second), Nanos: int32(d % time.Second)}
+}
@@ -4,8 +4,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 
 	"github.com/tendermint/tendermint/abci/server"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -13,6 +15,12 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sgrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 )
 
 const (
@@ -20,12 +28,16 @@ const (
 	flagAddress        = "address"
 	flagTraceStore     = "trace-store"
 	flagPruning        = "pruning"
-	flagMinimumFees    = "minimum_fees"
+	flagMinGasPrices   = "minimum_gas_prices"
+	flagHaltHeight     = "halt-height"
+	flagHaltTime       = "halt-time"
+	flagGRPCAddress    = "grpc-laddr"
+	flagTxIndex        = "tx-index"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
-// Tendermint.
-func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+// Tendermint. The codec decodes the JSON transactions received over gRPC.
+func StartCmd(ctx *Context, cdc *codec.Codec, appCreator AppCreator) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "start",
 		Short: "Run the full node",
@@ -37,7 +49,7 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 
 			ctx.Logger.Info("Starting ABCI with Tendermint")
 
-			_, err := startInProcess(ctx, appCreator)
+			_, err := startInProcess(ctx, cdc, appCreator)
 			return err
 		},
 	}
@@ -47,7 +59,11 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
-	cmd.Flags().String(flagMinimumFees, "", "Minimum fees validator will accept for transactions")
+	cmd.Flags().String(flagMinGasPrices, "", "Minimum gas prices to accept for transactions; any fee in a tx must meet this minimum (e.g. 0.01photino,0.0001stake)")
+	cmd.Flags().Uint64(flagHaltHeight, 0, "Height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Uint64(flagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Bool(flagTxIndex, false, "Index the transactions of committed blocks by sender, recipient, message type and memo to serve rich transaction searches")
+	cmd.Flags().String(flagGRPCAddress, "", "Serve the gRPC query and transaction services on this address (e.g. tcp://0.0.0.0:9090); disabled if empty")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -94,7 +110,7 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 }
 
 // nolint: unparam
-func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
+func startInProcess(ctx *Context, cdc *codec.Codec, appCreator AppCreator) (*node.Node, error) {
 	cfg := ctx.Config
 	home := cfg.RootDir
 	traceWriterFile := viper.GetString(flagTraceStore)
@@ -130,12 +146,81 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
+	// the application has replayed the stored blocks it missed, blocks
+	// committed from now on are indexed as usual
+	indexer, catchUp := app.(txIndexCatchUp)
+	var lastHeight int64
+	if catchUp {
+		lastHeight = indexer.LastBlockHeight()
+	}
+
 	err = tmNode.Start()
 	if err != nil {
 		return nil, err
 	}
 
+	// index the blocks committed before a crash but missing from the
+	// transaction index
+	if catchUp {
+		go func() {
+			err := indexer.CatchUpTxIndex(lastHeight, loadBlock(rpcclient.NewLocal(tmNode)))
+			if err != nil {
+				ctx.Logger.Error("failed to catch up the transaction index", "err", err)
+			}
+		}()
+	}
+
+	// serve gRPC against the local node, if enabled
+	var grpcSrv *grpc.Server
+	if grpcAddr := viper.GetString(flagGRPCAddress); grpcAddr != "" {
+		grpcSrv, err = sgrpc.StartServer(grpcAddr, cdc, rpcclient.NewLocal(tmNode), app, ctx.Logger.With("module", "grpc-server"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// trap signal (run forever)
-	tmNode.RunForever()
+	cmn.TrapSignal(func() {
+		// cleanup
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+		if tmNode.IsRunning() {
+			_ = tmNode.Stop()
+		}
+	})
 	return tmNode, nil
 }
+
+// txIndexCatchUp is implemented by applications, such as those based on
+// BaseApp, whose transaction index can be caught up with the committed
+// blocks.
+type txIndexCatchUp interface {
+	LastBlockHeight() int64
+	CatchUpTxIndex(height int64, loadBlock baseapp.BlockLoader) error
+}
+
+// loadBlock returns a loader of the blocks committed by a node, along with
+// their results.
+func loadBlock(node rpcclient.Client) baseapp.BlockLoader {
+	return func(height int64) (abci.Header, [][]byte, []abci.ResponseDeliverTx, error) {
+		block, err := node.Block(&height)
+		if err != nil {
+			return abci.Header{}, nil, nil, err
+		}
+		blockResults, err := node.BlockResults(&height)
+		if err != nil {
+			return abci.Header{}, nil, nil, err
+		}
+
+		txs := make([][]byte, len(block.Block.Txs))
+		for i, tx := range block.Block.Txs {
+			txs[i] = tx
+		}
+		results := make([]abci.ResponseDeliverTx, len(blockResults.Results.DeliverTx))
+		for i, res := range blockResults.Results.DeliverTx {
+			results[i] = *res
+		}
+		return tmtypes.TM2PB.Header(&block.Block.Header), txs, results, nil
+	}
+}
@@ -0,0 +1,175 @@
+package txindex
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryTxsParams are the params of the queries sent to the "/app/txs" path.
+// Zero filters are ignored; MaxHeight is inclusive. Transactions are
+// returned in ascending order of position unless Pagination.Descending is
+// set. Page keys are positions of transactions, as returned by the previous
+// page.
+type QueryTxsParams struct {
+	Sender     sdk.AccAddress
+	Recipient  sdk.AccAddress
+	MsgType    string
+	MinHeight  int64
+	MaxHeight  int64
+	Memo       string // substring of the memo of the transactions
+	Pagination sdk.PageRequest
+}
+
+// QueryTxsResponse is the response of the queries sent to the "/app/txs"
+// path.
+type QueryTxsResponse struct {
+	Txs        []TxRecord       `json:"txs"`
+	Pagination sdk.PageResponse `json:"pagination"`
+}
+
+// ValidateBasic checks the consistency of the params.
+func (params QueryTxsParams) ValidateBasic() error {
+	switch {
+	case params.MinHeight < 0 || params.MaxHeight < 0:
+		return errors.New("heights cannot be negative")
+	case params.MaxHeight > 0 && params.MinHeight > params.MaxHeight:
+		return errors.New("minimum height cannot be greater than maximum height")
+	case len(params.Pagination.Key) > 0 && len(params.Pagination.Key) != positionLen:
+		return errors.New("invalid page key")
+	}
+	return params.Pagination.ValidateBasic()
+}
+
+// QueryTxs implements baseapp.TxIndexer. Params and results are amino JSON
+// encoded.
+func (idx *Indexer) QueryTxs(data []byte) ([]byte, sdk.Error) {
+	var params QueryTxsParams
+	if len(data) > 0 {
+		if err := idx.cdc.UnmarshalJSON(data, &params); err != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", err.Error()))
+		}
+	}
+
+	txs, page, err := idx.Search(params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	res, err := idx.cdc.MarshalJSON(QueryTxsResponse{Txs: txs, Pagination: page})
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err.Error()))
+	}
+	return res, nil
+}
+
+// Search returns the page of the transactions matching the params.
+//
+// The transactions are iterated over through the most selective index
+// given: by sender, recipient, message type, or else all of them, within the
+// height range. The other filters are checked against each transaction.
+func (idx *Indexer) Search(params QueryTxsParams) (txs []TxRecord, page sdk.PageResponse, err error) {
+	if err := params.ValidateBasic(); err != nil {
+		return nil, page, err
+	}
+	limit := params.Pagination.Limit
+	if limit == 0 {
+		limit = sdk.DefaultPageLimit
+	}
+
+	var prefix []byte
+	switch {
+	case len(params.Sender) > 0:
+		prefix = lengthPrefixed(SenderKeyPrefix, params.Sender)
+	case len(params.Recipient) > 0:
+		prefix = lengthPrefixed(RecipientKeyPrefix, params.Recipient)
+	case params.MsgType != "":
+		prefix = lengthPrefixed(MsgTypeKeyPrefix, []byte(params.MsgType))
+	default:
+		prefix = TxKeyPrefix
+	}
+
+	start := append(append([]byte{}, prefix...), position(params.MinHeight, 0)...)
+	end := sdk.PrefixEndBytes(prefix)
+	if params.MaxHeight > 0 {
+		end = append(append([]byte{}, prefix...), position(params.MaxHeight+1, 0)...)
+	}
+	if key := params.Pagination.Key; len(key) > 0 {
+		keyed := append(append([]byte{}, prefix...), key...)
+		if !params.Pagination.Descending && bytes.Compare(keyed, start) > 0 {
+			start = keyed
+		}
+		// positions have a fixed length, the key is the last one before its
+		// successor
+		if keyed = append(keyed, 0x00); params.Pagination.Descending && bytes.Compare(keyed, end) < 0 {
+			end = keyed
+		}
+	}
+
+	iterator := idx.db.Iterator(start, end)
+	if params.Pagination.Descending {
+		iterator = idx.db.ReverseIterator(start, end)
+	}
+	defer iterator.Close()
+
+	var count int64
+	for ; iterator.Valid(); iterator.Next() {
+		pos := iterator.Key()[len(prefix):]
+
+		record, ok, err := idx.match(params, pos)
+		if err != nil {
+			return nil, page, err
+		}
+		if !ok {
+			continue
+		}
+
+		count++
+		switch {
+		case count <= params.Pagination.Offset:
+			continue
+		case count <= params.Pagination.Offset+limit:
+			txs = append(txs, record)
+			continue
+		case page.NextKey == nil:
+			page.NextKey = append([]byte{}, pos...)
+		}
+		if !params.Pagination.CountTotal {
+			break
+		}
+	}
+
+	if params.Pagination.CountTotal {
+		page.Total = count
+	}
+	return txs, page, nil
+}
+
+// match loads the transaction at a position and checks it against the
+// filters of the params.
+func (idx *Indexer) match(params QueryTxsParams, pos []byte) (record TxRecord, ok bool, err error) {
+	if len(params.Sender) > 0 && !idx.db.Has(SenderKey(params.Sender, pos)) {
+		return record, false, nil
+	}
+	if len(params.Recipient) > 0 && !idx.db.Has(RecipientKey(params.Recipient, pos)) {
+		return record, false, nil
+	}
+	if params.MsgType != "" && !idx.db.Has(MsgTypeKey(params.MsgType, pos)) {
+		return record, false, nil
+	}
+
+	bz := idx.db.Get(TxKey(pos))
+	if bz == nil {
+		return record, false, fmt.Errorf("missing indexed transaction at %X", pos)
+	}
+	if err := idx.cdc.UnmarshalBinaryBare(bz, &record); err != nil {
+		return record, false, err
+	}
+	if params.Memo != "" && !strings.Contains(record.Memo, params.Memo) {
+		return record, false, nil
+	}
+	return record, true, nil
+}
@@ -0,0 +1,192 @@
+// Package txindex implements an index of the transactions of committed
+// blocks, which is fed by BaseApp and supports queries by sender, recipient,
+// message type, height range and memo, see baseapp.TxIndexer.
+package txindex
+
+import (
+	"encoding/binary"
+	"path/filepath"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TagRecipient is the tag whose values are indexed as the recipients of a
+// transaction, see x/bank.
+const TagRecipient = "recipient"
+
+// Keys of the index. Transactions are stored under their position, the
+// height of their block followed by their index in the block, which is also
+// appended to the keys of the secondary indexes.
+var (
+	TxKeyPrefix        = []byte{0x01} // prefix for the transactions
+	SenderKeyPrefix    = []byte{0x02} // prefix for the index by sender
+	RecipientKeyPrefix = []byte{0x03} // prefix for the index by recipient
+	MsgTypeKeyPrefix   = []byte{0x04} // prefix for the index by message type
+	LastHeightKey      = []byte{0x05} // key for the height of the last indexed block
+)
+
+// length of the position of a transaction in keys
+const positionLen = 12
+
+var _ baseapp.TxIndexer = (*Indexer)(nil)
+
+// Indexer stores the transactions of committed blocks in a database.
+type Indexer struct {
+	mtx sync.Mutex // serializes the writes of blocks
+	db  dbm.DB
+	cdc *codec.Codec
+}
+
+// NewIndexer returns an indexer storing transactions in db.
+func NewIndexer(db dbm.DB) *Indexer {
+	return &Indexer{db: db, cdc: codec.New()}
+}
+
+// Open opens the index stored in the data directory of a node.
+func Open(rootDir string) (*Indexer, error) {
+	db, err := dbm.NewGoLevelDB("txindex", filepath.Join(rootDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+	return NewIndexer(db), nil
+}
+
+// TxRecord is an indexed transaction.
+type TxRecord struct {
+	Hash   cmn.HexBytes           `json:"hash"`
+	Height int64                  `json:"height"`
+	Index  uint32                 `json:"index"`
+	Time   time.Time              `json:"time"`
+	Tx     []byte                 `json:"tx"`
+	Memo   string                 `json:"memo"`
+	Result abci.ResponseDeliverTx `json:"result"`
+}
+
+// IndexBlock implements baseapp.TxIndexer. The senders of a transaction are
+// the signers of its messages, its recipients are the values of the
+// "recipient" tags of its result and its message types are the names of its
+// messages. Transactions that could not be decoded are stored, but are not
+// indexed by sender, recipient or message type. Indexing a block again
+// overwrites its transactions.
+func (idx *Indexer) IndexBlock(header abci.Header, txs []baseapp.DeliveredTx) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	batch := idx.db.NewBatch()
+	for i, dtx := range txs {
+		pos := position(header.Height, uint32(i))
+		record := TxRecord{
+			Hash:   tmtypes.Tx(dtx.Bytes).Hash(),
+			Height: header.Height,
+			Index:  uint32(i),
+			Time:   header.Time,
+			Tx:     dtx.Bytes,
+			Result: abci.ResponseDeliverTx{
+				Code:      uint32(dtx.Result.Code),
+				Data:      dtx.Result.Data,
+				Log:       dtx.Result.Log,
+				GasWanted: dtx.Result.GasWanted,
+				GasUsed:   dtx.Result.GasUsed,
+				Tags:      dtx.Result.Tags,
+			},
+		}
+
+		if dtx.Tx != nil {
+			if tx, ok := dtx.Tx.(interface{ GetMemo() string }); ok {
+				record.Memo = tx.GetMemo()
+			}
+			for _, msg := range dtx.Tx.GetMsgs() {
+				for _, signer := range msg.GetSigners() {
+					batch.Set(SenderKey(signer, pos), []byte{})
+				}
+				batch.Set(MsgTypeKey(msg.Name(), pos), []byte{})
+			}
+			for _, tag := range dtx.Result.Tags {
+				if string(tag.Key) != TagRecipient {
+					continue
+				}
+				recipient, err := sdk.AccAddressFromBech32(string(tag.Value))
+				if err != nil {
+					continue
+				}
+				batch.Set(RecipientKey(recipient, pos), []byte{})
+			}
+		}
+
+		bz, err := idx.cdc.MarshalBinaryBare(record)
+		if err != nil {
+			return err
+		}
+		batch.Set(TxKey(pos), bz)
+	}
+
+	// blocks may be indexed out of order while catching up
+	if header.Height > idx.LastHeight() {
+		height := make([]byte, 8)
+		binary.BigEndian.PutUint64(height, uint64(header.Height))
+		batch.Set(LastHeightKey, height)
+	}
+	batch.WriteSync()
+	return nil
+}
+
+// LastHeight implements baseapp.TxIndexer.
+func (idx *Indexer) LastHeight() int64 {
+	bz := idx.db.Get(LastHeightKey)
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// position encodes the position of a transaction.
+func position(height int64, index uint32) []byte {
+	pos := make([]byte, positionLen)
+	binary.BigEndian.PutUint64(pos, uint64(height))
+	binary.BigEndian.PutUint32(pos[8:], index)
+	return pos
+}
+
+// TxKey returns the key of the transaction at a position.
+func TxKey(pos []byte) []byte {
+	return append(append([]byte{}, TxKeyPrefix...), pos...)
+}
+
+// SenderKey returns the key indexing a transaction by one of its senders.
+func SenderKey(addr sdk.AccAddress, pos []byte) []byte {
+	return append(lengthPrefixed(SenderKeyPrefix, addr), pos...)
+}
+
+// RecipientKey returns the key indexing a transaction by one of its
+// recipients.
+func RecipientKey(addr sdk.AccAddress, pos []byte) []byte {
+	return append(lengthPrefixed(RecipientKeyPrefix, addr), pos...)
+}
+
+// MsgTypeKey returns the key indexing a transaction by the type of one of
+// its messages.
+func MsgTypeKey(msgType string, pos []byte) []byte {
+	return append(lengthPrefixed(MsgTypeKeyPrefix, []byte(msgType)), pos...)
+}
+
+// lengthPrefixed returns the prefix of the index keys for a value, which is
+// prefixed with its uvarint encoded length so that no value is the prefix of
+// another.
+func lengthPrefixed(prefix []byte, value []byte) []byte {
+	length := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(length, uint64(len(value)))
+
+	key := make([]byte, 0, len(prefix)+n+len(value)+positionLen)
+	key = append(key, prefix...)
+	key = append(key, length[:n]...)
+	return append(key, value...)
+}
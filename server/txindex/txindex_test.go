@@ -0,0 +1,213 @@
+package txindex
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	addr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr3 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+type testMsg struct {
+	name   string
+	signer sdk.AccAddress
+}
+
+func (msg testMsg) Type() string                 { return "test" }
+func (msg testMsg) Name() string                 { return msg.name }
+func (msg testMsg) ValidateBasic() sdk.Error     { return nil }
+func (msg testMsg) GetSignBytes() []byte         { return nil }
+func (msg testMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.signer} }
+
+type testTx struct {
+	msgs []sdk.Msg
+	memo string
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+func (tx testTx) GetMemo() string    { return tx.memo }
+
+// deliveredSend returns a delivered transaction sending from one address to
+// another.
+func deliveredSend(from, to sdk.AccAddress, memo string) baseapp.DeliveredTx {
+	return baseapp.DeliveredTx{
+		Bytes: []byte(fmt.Sprintf("send %s %s %s", from, to, memo)),
+		Tx:    testTx{msgs: []sdk.Msg{testMsg{"send", from}}, memo: memo},
+		Result: sdk.Result{
+			Tags: sdk.NewTags("sender", []byte(from.String()), TagRecipient, []byte(to.String())),
+		},
+	}
+}
+
+func setupIndexer(t *testing.T) *Indexer {
+	idx := NewIndexer(dbm.NewMemDB())
+
+	blocks := [][]baseapp.DeliveredTx{
+		{
+			deliveredSend(addr1, addr2, "rent"),
+			deliveredSend(addr2, addr3, ""),
+		},
+		{
+			{
+				Bytes: []byte("delegate"),
+				Tx:    testTx{msgs: []sdk.Msg{testMsg{"delegate", addr1}}},
+			},
+			{Bytes: []byte("garbage"), Result: sdk.ErrTxDecode("").Result()},
+		},
+		{
+			deliveredSend(addr1, addr3, "rent"),
+		},
+	}
+	for i, txs := range blocks {
+		header := abci.Header{Height: int64(i + 1), Time: time.Unix(int64(i), 0).UTC()}
+		require.NoError(t, idx.IndexBlock(header, txs))
+	}
+	return idx
+}
+
+func positions(txs []TxRecord) (pos [][2]int64) {
+	for _, tx := range txs {
+		pos = append(pos, [2]int64{tx.Height, int64(tx.Index)})
+	}
+	return pos
+}
+
+func TestSearchFilters(t *testing.T) {
+	idx := setupIndexer(t)
+
+	cases := []struct {
+		params   QueryTxsParams
+		expected [][2]int64
+	}{
+		{QueryTxsParams{}, [][2]int64{{1, 0}, {1, 1}, {2, 0}, {2, 1}, {3, 0}}},
+		{QueryTxsParams{Pagination: sdk.PageRequest{Descending: true}}, [][2]int64{{3, 0}, {2, 1}, {2, 0}, {1, 1}, {1, 0}}},
+		{QueryTxsParams{Sender: addr1}, [][2]int64{{1, 0}, {2, 0}, {3, 0}}},
+		{QueryTxsParams{Recipient: addr3}, [][2]int64{{1, 1}, {3, 0}}},
+		{QueryTxsParams{Sender: addr1, Recipient: addr3}, [][2]int64{{3, 0}}},
+		{QueryTxsParams{Sender: addr1, MsgType: "send"}, [][2]int64{{1, 0}, {3, 0}}},
+		{QueryTxsParams{MsgType: "delegate"}, [][2]int64{{2, 0}}},
+		{QueryTxsParams{Memo: "ren"}, [][2]int64{{1, 0}, {3, 0}}},
+		{QueryTxsParams{MinHeight: 2}, [][2]int64{{2, 0}, {2, 1}, {3, 0}}},
+		{QueryTxsParams{MinHeight: 2, MaxHeight: 2}, [][2]int64{{2, 0}, {2, 1}}},
+		{QueryTxsParams{Sender: addr1, MaxHeight: 2, Pagination: sdk.PageRequest{Descending: true}}, [][2]int64{{2, 0}, {1, 0}}},
+		{QueryTxsParams{Sender: addr3}, nil},
+	}
+	for i, tc := range cases {
+		txs, page, err := idx.Search(tc.params)
+		require.NoError(t, err, "case %d", i)
+		require.Equal(t, tc.expected, positions(txs), "case %d", i)
+		require.Nil(t, page.NextKey, "case %d", i)
+	}
+
+	txs, _, err := idx.Search(QueryTxsParams{Memo: "rent", MaxHeight: 1})
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, "rent", txs[0].Memo)
+	require.Equal(t, time.Unix(0, 0).UTC(), txs[0].Time)
+	require.Equal(t, deliveredSend(addr1, addr2, "rent").Bytes, txs[0].Tx)
+
+	// undecodable transactions are stored along with their result
+	txs, _, err = idx.Search(QueryTxsParams{MinHeight: 2, MaxHeight: 2, Pagination: sdk.PageRequest{Descending: true}})
+	require.NoError(t, err)
+	require.Equal(t, []byte("garbage"), txs[0].Tx)
+	require.False(t, txs[0].Result.IsOK())
+}
+
+func TestSearchPagination(t *testing.T) {
+	idx := setupIndexer(t)
+
+	for _, descending := range []bool{false, true} {
+		all, _, err := idx.Search(QueryTxsParams{Pagination: sdk.PageRequest{Descending: descending}})
+		require.NoError(t, err)
+
+		// key based pagination walks through all the transactions
+		var walked []TxRecord
+		req := sdk.PageRequest{Limit: 2, Descending: descending}
+		for {
+			txs, page, err := idx.Search(QueryTxsParams{Pagination: req})
+			require.NoError(t, err)
+			walked = append(walked, txs...)
+			if page.NextKey == nil {
+				break
+			}
+			req.Key = page.NextKey
+		}
+		require.Equal(t, positions(all), positions(walked))
+
+		// offset based pagination with a total count
+		txs, page, err := idx.Search(QueryTxsParams{
+			Pagination: sdk.PageRequest{Offset: 1, Limit: 2, CountTotal: true, Descending: descending},
+		})
+		require.NoError(t, err)
+		require.Equal(t, positions(all[1:3]), positions(txs))
+		require.Equal(t, int64(5), page.Total)
+		require.Equal(t, []byte(position(all[3].Height, all[3].Index)), []byte(page.NextKey))
+	}
+
+	_, _, err := idx.Search(QueryTxsParams{Pagination: sdk.PageRequest{Key: []byte{0x01}}})
+	require.Error(t, err)
+	_, _, err = idx.Search(QueryTxsParams{MinHeight: 3, MaxHeight: 2})
+	require.Error(t, err)
+}
+
+func TestQueryTxs(t *testing.T) {
+	idx := setupIndexer(t)
+
+	bz, err := idx.cdc.MarshalJSON(QueryTxsParams{Recipient: addr2})
+	require.NoError(t, err)
+	res, sdkErr := idx.QueryTxs(bz)
+	require.Nil(t, sdkErr)
+
+	var resp QueryTxsResponse
+	require.NoError(t, idx.cdc.UnmarshalJSON(res, &resp))
+	require.Equal(t, [][2]int64{{1, 0}}, positions(resp.Txs))
+
+	_, sdkErr = idx.QueryTxs([]byte("invalid"))
+	require.NotNil(t, sdkErr)
+}
+
+func TestLastHeight(t *testing.T) {
+	idx := NewIndexer(dbm.NewMemDB())
+	require.Zero(t, idx.LastHeight())
+
+	idx = setupIndexer(t)
+	require.Equal(t, int64(3), idx.LastHeight())
+
+	// reindexing an earlier block keeps the last height
+	require.NoError(t, idx.IndexBlock(abci.Header{Height: 2}, nil))
+	require.Equal(t, int64(3), idx.LastHeight())
+}
+
+func TestLongMsgType(t *testing.T) {
+	idx := NewIndexer(dbm.NewMemDB())
+
+	// values longer than 255 bytes must not collide with shorter ones
+	long := strings.Repeat("a", 300)
+	short := strings.Repeat("a", 300-256)
+	txs := []baseapp.DeliveredTx{
+		{Bytes: []byte("long"), Tx: testTx{msgs: []sdk.Msg{testMsg{long, addr1}}}},
+		{Bytes: []byte("short"), Tx: testTx{msgs: []sdk.Msg{testMsg{short, addr1}}}},
+	}
+	require.NoError(t, idx.IndexBlock(abci.Header{Height: 1}, txs))
+
+	res, _, err := idx.Search(QueryTxsParams{MsgType: long})
+	require.NoError(t, err)
+	require.Equal(t, [][2]int64{{1, 0}}, positions(res))
+
+	res, _, err = idx.Search(QueryTxsParams{MsgType: short})
+	require.NoError(t, err)
+	require.Equal(t, [][2]int64{{1, 1}}, positions(res))
+}
@@ -143,7 +143,7 @@ func AddCommands(
 	)
 
 	rootCmd.AddCommand(
-		StartCmd(ctx, appCreator),
+		StartCmd(ctx, cdc, appCreator),
 		UnsafeResetAllCmd(ctx),
 		client.LineBreak,
 		tendermintCmd,
@@ -43,11 +43,12 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 	c = c.WithBlockHeight(header.Height)
 	c = c.WithChainID(header.ChainID)
 	c = c.WithIsCheckTx(isCheckTx)
+	c = c.WithIsReCheckTx(false)
 	c = c.WithTxBytes(nil)
 	c = c.WithLogger(logger)
 	c = c.WithVoteInfos(nil)
 	c = c.WithGasMeter(NewInfiniteGasMeter())
-	c = c.WithMinimumFees(Coins{})
+	c = c.WithMinGasPrices(DecCoins{})
 	return c
 }
 
@@ -136,11 +137,12 @@ const (
 	contextKeyConsensusParams
 	contextKeyChainID
 	contextKeyIsCheckTx
+	contextKeyIsReCheckTx
 	contextKeyTxBytes
 	contextKeyLogger
 	contextKeyVoteInfos
 	contextKeyGasMeter
-	contextKeyMinimumFees
+	contextKeyMinGasPrices
 )
 
 // NOTE: Do not expose MultiStore.
@@ -172,7 +174,9 @@ func (c Context) GasMeter() GasMeter { return c.Value(contextKeyGasMeter).(GasMe
 
 func (c Context) IsCheckTx() bool { return c.Value(contextKeyIsCheckTx).(bool) }
 
-func (c Context) MinimumFees() Coins { return c.Value(contextKeyMinimumFees).(Coins) }
+func (c Context) IsReCheckTx() bool { return c.Value(contextKeyIsReCheckTx).(bool) }
+
+func (c Context) MinGasPrices() DecCoins { return c.Value(contextKeyMinGasPrices).(DecCoins) }
 
 func (c Context) WithMultiStore(ms MultiStore) Context { return c.withValue(contextKeyMultiStore, ms) }
 
@@ -223,8 +227,17 @@ func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	return c.withValue(contextKeyIsCheckTx, isCheckTx)
 }
 
-func (c Context) WithMinimumFees(minFees Coins) Context {
-	return c.withValue(contextKeyMinimumFees, minFees)
+// WithIsReCheckTx marks the context as re-checking a transaction that is
+// already in the mempool. A re-check context is always a check context.
+func (c Context) WithIsReCheckTx(isReCheckTx bool) Context {
+	if isReCheckTx {
+		c = c.WithIsCheckTx(true)
+	}
+	return c.withValue(contextKeyIsReCheckTx, isReCheckTx)
+}
+
+func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
+	return c.withValue(contextKeyMinGasPrices, gasPrices)
 }
 
 // Cache the multistore and return a new cached context. The cached context is
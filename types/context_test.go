@@ -162,7 +162,7 @@ func TestContextWithCustom(t *testing.T) {
 	logger := NewMockLogger()
 	voteinfos := []abci.VoteInfo{{}}
 	meter := types.NewGasMeter(10000)
-	minFees := types.Coins{types.NewInt64Coin("feeCoin", 1)}
+	minGasPrices := types.DecCoins{types.NewDecCoin("feeCoin", 1)}
 
 	ctx = types.NewContext(nil, header, ischeck, logger)
 	require.Equal(t, header, ctx.BlockHeader())
@@ -173,7 +173,7 @@ func TestContextWithCustom(t *testing.T) {
 		WithTxBytes(txbytes).
 		WithVoteInfos(voteinfos).
 		WithGasMeter(meter).
-		WithMinimumFees(minFees)
+		WithMinGasPrices(minGasPrices)
 	require.Equal(t, height, ctx.BlockHeight())
 	require.Equal(t, chainid, ctx.ChainID())
 	require.Equal(t, ischeck, ctx.IsCheckTx())
@@ -181,5 +181,11 @@ func TestContextWithCustom(t *testing.T) {
 	require.Equal(t, logger, ctx.Logger())
 	require.Equal(t, voteinfos, ctx.VoteInfos())
 	require.Equal(t, meter, ctx.GasMeter())
-	require.Equal(t, minFees, types.Coins{types.NewInt64Coin("feeCoin", 1)})
+	require.Equal(t, minGasPrices, ctx.MinGasPrices())
+
+	// a re-check context is always a check context
+	require.False(t, ctx.IsReCheckTx())
+	ctx = types.NewContext(nil, header, false, logger).WithIsReCheckTx(true)
+	require.True(t, ctx.IsReCheckTx())
+	require.True(t, ctx.IsCheckTx())
 }
@@ -0,0 +1,304 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Coins which can have additional decimal points
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount Dec    `json:"amount"`
+}
+
+func NewDecCoin(denom string, amount int64) DecCoin {
+	return DecCoin{
+		Denom:  denom,
+		Amount: NewDec(amount),
+	}
+}
+
+func NewDecCoinFromCoin(coin Coin) DecCoin {
+	return DecCoin{
+		Denom:  coin.Denom,
+		Amount: NewDecFromInt(coin.Amount),
+	}
+}
+
+func (coin DecCoin) String() string {
+	return fmt.Sprintf("%v%v", coin.Amount, coin.Denom)
+}
+
+// IsNotNegative returns true if the coin amount is not negative.
+func (coin DecCoin) IsNotNegative() bool {
+	return !coin.Amount.IsNegative()
+}
+
+// Adds amounts of two coins with same denom
+func (coin DecCoin) Plus(coinB DecCoin) DecCoin {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	return DecCoin{coin.Denom, coin.Amount.Add(coinB.Amount)}
+}
+
+// Subtracts amounts of two coins with same denom
+func (coin DecCoin) Minus(coinB DecCoin) DecCoin {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	return DecCoin{coin.Denom, coin.Amount.Sub(coinB.Amount)}
+}
+
+// return the decimal coins with trunctated decimals
+func (coin DecCoin) TruncateDecimal() Coin {
+	return NewCoin(coin.Denom, coin.Amount.TruncateInt())
+}
+
+//_______________________________________________________________________
+
+// coins with decimal
+type DecCoins []DecCoin
+
+func NewDecCoins(coins Coins) DecCoins {
+	dcs := make(DecCoins, len(coins))
+	for i, coin := range coins {
+		dcs[i] = NewDecCoinFromCoin(coin)
+	}
+	return dcs
+}
+
+// return the coins with trunctated decimals
+func (coins DecCoins) TruncateDecimal() Coins {
+	out := make(Coins, len(coins))
+	for i, coin := range coins {
+		out[i] = coin.TruncateDecimal()
+	}
+	return out
+}
+
+// Plus combines two sets of coins
+// CONTRACT: Plus will never return Coins where one Coin has a 0 amount.
+func (coins DecCoins) Plus(coinsB DecCoins) DecCoins {
+	sum := ([]DecCoin)(nil)
+	indexA, indexB := 0, 0
+	lenA, lenB := len(coins), len(coinsB)
+	for {
+		if indexA == lenA {
+			if indexB == lenB {
+				return sum
+			}
+			return append(sum, coinsB[indexB:]...)
+		} else if indexB == lenB {
+			return append(sum, coins[indexA:]...)
+		}
+		coinA, coinB := coins[indexA], coinsB[indexB]
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1:
+			sum = append(sum, coinA)
+			indexA++
+		case 0:
+			if coinA.Amount.Add(coinB.Amount).IsZero() {
+				// ignore 0 sum coin type
+			} else {
+				sum = append(sum, coinA.Plus(coinB))
+			}
+			indexA++
+			indexB++
+		case 1:
+			sum = append(sum, coinB)
+			indexB++
+		}
+	}
+}
+
+// Negative returns a set of coins with all amount negative
+func (coins DecCoins) Negative() DecCoins {
+	res := make([]DecCoin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.Neg(),
+		})
+	}
+	return res
+}
+
+// Minus subtracts a set of coins from another (adds the inverse)
+func (coins DecCoins) Minus(coinsB DecCoins) DecCoins {
+	return coins.Plus(coinsB.Negative())
+}
+
+// multiply all the coins by a decimal
+func (coins DecCoins) MulDec(d Dec) DecCoins {
+	res := make([]DecCoin, len(coins))
+	for i, coin := range coins {
+		product := DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.Mul(d),
+		}
+		res[i] = product
+	}
+	return res
+}
+
+// multiply all the coins by a decimal, truncating the decimals beyond the
+// precision
+func (coins DecCoins) MulDecTruncate(d Dec) DecCoins {
+	res := make([]DecCoin, len(coins))
+	for i, coin := range coins {
+		product := DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.MulTruncate(d),
+		}
+		res[i] = product
+	}
+	return res
+}
+
+// divide all the coins by a multiple
+func (coins DecCoins) QuoDec(d Dec) DecCoins {
+	res := make([]DecCoin, len(coins))
+	for i, coin := range coins {
+		quotient := DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.Quo(d),
+		}
+		res[i] = quotient
+	}
+	return res
+}
+
+// divide all the coins by a multiple, truncating the decimals beyond the
+// precision
+func (coins DecCoins) QuoDecTruncate(d Dec) DecCoins {
+	res := make([]DecCoin, len(coins))
+	for i, coin := range coins {
+		quotient := DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.QuoTruncate(d),
+		}
+		res[i] = quotient
+	}
+	return res
+}
+
+// String provides a human readable representation of the coins, e.g.
+// "0.025000000000000000atom,1.000000000000000000steak".
+func (coins DecCoins) String() string {
+	if len(coins) == 0 {
+		return ""
+	}
+
+	out := make([]string, len(coins))
+	for i, coin := range coins {
+		out[i] = coin.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// IsZero returns whether all coins are zero
+func (coins DecCoins) IsZero() bool {
+	for _, coin := range coins {
+		if !coin.Amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValid asserts the DecCoins are sorted by denom, have no duplicate
+// denominations and no negative amounts.
+func (coins DecCoins) IsValid() bool {
+	for i, coin := range coins {
+		if !coin.IsNotNegative() {
+			return false
+		}
+		if i > 0 && coins[i-1].Denom >= coin.Denom {
+			return false
+		}
+	}
+	return true
+}
+
+// AmountOf returns the amount of a denom from the coins
+func (coins DecCoins) AmountOf(denom string) Dec {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+	}
+	return ZeroDec()
+}
+
+// nolint
+func (coins DecCoins) Len() int           { return len(coins) }
+func (coins DecCoins) Less(i, j int) bool { return coins[i].Denom < coins[j].Denom }
+func (coins DecCoins) Swap(i, j int)      { coins[i], coins[j] = coins[j], coins[i] }
+
+var _ sort.Interface = DecCoins{}
+
+// Sort is a helper function to sort the set of decimal coins inplace
+func (coins DecCoins) Sort() DecCoins {
+	sort.Sort(coins)
+	return coins
+}
+
+//_______________________________________________________________________
+// Parsing
+
+var (
+	reDecAmt  = `[[:digit:]]+(?:\.[[:digit:]]+)?`
+	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, reDnm))
+)
+
+// ParseDecCoin parses a decimal coin from a string, e.g. "0.025atom",
+// returning an error if invalid.
+func ParseDecCoin(coinStr string) (coin DecCoin, err error) {
+	coinStr = strings.TrimSpace(coinStr)
+
+	matches := reDecCoin.FindStringSubmatch(coinStr)
+	if matches == nil {
+		err = fmt.Errorf("invalid decimal coin expression: %s", coinStr)
+		return
+	}
+	denomStr, amountStr := matches[2], matches[1]
+
+	amount, sdkErr := NewDecFromStr(amountStr)
+	if sdkErr != nil {
+		return coin, fmt.Errorf("invalid decimal coin amount %s: %s", amountStr, sdkErr.Error())
+	}
+
+	return DecCoin{denomStr, amount}, nil
+}
+
+// ParseDecCoins will parse out a list of decimal coins separated by commas.
+// If nothing is provided, it returns nil DecCoins.
+// Returned coins are sorted.
+func ParseDecCoins(coinsStr string) (coins DecCoins, err error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	coinStrs := strings.Split(coinsStr, ",")
+	for _, coinStr := range coinStrs {
+		coin, err := ParseDecCoin(coinStr)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+
+	// Sort coins for determinism.
+	coins.Sort()
+
+	// Validate coins before returning.
+	if !coins.IsValid() {
+		return nil, fmt.Errorf("parseDecCoins invalid: %#v", coins)
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlusDecCoin(t *testing.T) {
+	decCoinA1 := DecCoin{"A", NewDecWithPrec(11, 1)}
+	decCoinA2 := DecCoin{"A", NewDecWithPrec(22, 1)}
+	decCoinB1 := DecCoin{"B", NewDecWithPrec(11, 1)}
+
+	// regular add
+	res := decCoinA1.Plus(decCoinA1)
+	require.Equal(t, decCoinA2, res, "sum of coins is incorrect")
+
+	// bad denom add
+	assert.Panics(t, func() {
+		decCoinA1.Plus(decCoinB1)
+	}, "expected panic on sum of different denoms")
+
+}
+
+func TestPlusDecCoins(t *testing.T) {
+	one := NewDec(1)
+	zero := NewDec(0)
+	negone := NewDec(-1)
+	two := NewDec(2)
+
+	cases := []struct {
+		inputOne DecCoins
+		inputTwo DecCoins
+		expected DecCoins
+	}{
+		{DecCoins{{"A", one}, {"B", one}}, DecCoins{{"A", one}, {"B", one}}, DecCoins{{"A", two}, {"B", two}}},
+		{DecCoins{{"A", zero}, {"B", one}}, DecCoins{{"A", zero}, {"B", zero}}, DecCoins{{"B", one}}},
+		{DecCoins{{"A", zero}, {"B", zero}}, DecCoins{{"A", zero}, {"B", zero}}, DecCoins(nil)},
+		{DecCoins{{"A", one}, {"B", zero}}, DecCoins{{"A", negone}, {"B", zero}}, DecCoins(nil)},
+		{DecCoins{{"A", negone}, {"B", zero}}, DecCoins{{"A", zero}, {"B", zero}}, DecCoins{{"A", negone}}},
+	}
+
+	for tcIndex, tc := range cases {
+		res := tc.inputOne.Plus(tc.inputTwo)
+		require.Equal(t, tc.expected, res, "sum of coins is incorrect, tc #%d", tcIndex)
+	}
+}
+
+func TestParseDecCoins(t *testing.T) {
+	cases := []struct {
+		input    string
+		valid    bool
+		expected DecCoins
+	}{
+		{"", true, nil},
+		{"0.025atom", true, DecCoins{{"atom", NewDecWithPrec(25, 3)}}},
+		{"1steak,0.5atom", true, DecCoins{{"atom", NewDecWithPrec(5, 1)}, {"steak", NewDec(1)}}},
+		{" 10 photon ", true, DecCoins{{"photon", NewDec(10)}}},
+		{".5atom", false, nil},
+		{"-1atom", false, nil},
+		{"1atom,2atom", false, nil},
+		{"1.5", false, nil},
+	}
+
+	for tcIndex, tc := range cases {
+		res, err := ParseDecCoins(tc.input)
+		if !tc.valid {
+			require.NotNil(t, err, "%s: %#v. tc #%d", tc.input, res, tcIndex)
+			continue
+		}
+		require.Nil(t, err, "%s: %+v", tc.input, err)
+		require.Equal(t, tc.expected, res, "coin parsing was incorrect, tc #%d", tcIndex)
+	}
+}
+
+func TestDecCoinsAmountOf(t *testing.T) {
+	coins := DecCoins{{"atom", NewDecWithPrec(5, 1)}, {"steak", NewDec(2)}}
+	require.Equal(t, NewDecWithPrec(5, 1), coins.AmountOf("atom"))
+	require.Equal(t, NewDec(2), coins.AmountOf("steak"))
+	require.True(t, coins.AmountOf("photon").IsZero())
+
+	require.False(t, coins.IsZero())
+	require.True(t, DecCoins{}.IsZero())
+	require.Equal(t, "0.500000000000000000atom,2.000000000000000000steak", coins.String())
+}
+
+func TestDecCoinsTruncatedArithmetic(t *testing.T) {
+	coins := DecCoins{{"atom", NewDec(2)}, {"steak", NewDec(1)}}
+
+	res := coins.QuoDecTruncate(NewDec(3))
+	require.Equal(t, NewDecWithPrec(666666666666666666, 18), res.AmountOf("atom"))
+	require.Equal(t, NewDecWithPrec(333333333333333333, 18), res.AmountOf("steak"))
+
+	// multiplying back never exceeds the original coins
+	res = res.MulDecTruncate(NewDec(3))
+	require.Equal(t, NewDecWithPrec(1999999999999999998, 18), res.AmountOf("atom"))
+	require.Equal(t, NewDecWithPrec(999999999999999999, 18), res.AmountOf("steak"))
+	require.True(t, coins.Minus(res).IsValid())
+}
@@ -176,6 +176,7 @@ func NewDecFromStr(str string) (d Dec, err Error) {
 //nolint
 func (d Dec) IsNil() bool       { return d.Int == nil }                 // is decimal nil
 func (d Dec) IsZero() bool      { return (d.Int).Sign() == 0 }          // is equal to zero
+func (d Dec) IsNegative() bool  { return (d.Int).Sign() == -1 }         // is negative
 func (d Dec) Equal(d2 Dec) bool { return (d.Int).Cmp(d2.Int) == 0 }     // equal decimals
 func (d Dec) GT(d2 Dec) bool    { return (d.Int).Cmp(d2.Int) > 0 }      // greater than
 func (d Dec) GTE(d2 Dec) bool   { return (d.Int).Cmp(d2.Int) >= 0 }     // greater than or equal
@@ -215,6 +216,17 @@ func (d Dec) Mul(d2 Dec) Dec {
 	return Dec{chopped}
 }
 
+// multiplication truncating the decimals beyond the precision
+func (d Dec) MulTruncate(d2 Dec) Dec {
+	mul := new(big.Int).Mul(d.Int, d2.Int)
+	chopped := chopPrecisionAndTruncate(mul)
+
+	if chopped.BitLen() > 255+DecimalPrecisionBits {
+		panic("Int overflow")
+	}
+	return Dec{chopped}
+}
+
 // multiplication
 func (d Dec) MulInt(i Int) Dec {
 	mul := new(big.Int).Mul(d.Int, i.i)
@@ -241,6 +253,20 @@ func (d Dec) Quo(d2 Dec) Dec {
 	return Dec{chopped}
 }
 
+// quotient truncating the decimals beyond the precision
+func (d Dec) QuoTruncate(d2 Dec) Dec {
+
+	// multiply precision once, the quotient is then truncated
+	mul := new(big.Int).Mul(d.Int, precisionReuse)
+
+	quo := new(big.Int).Quo(mul, d2.Int)
+
+	if quo.BitLen() > 255+DecimalPrecisionBits {
+		panic("Int overflow")
+	}
+	return Dec{quo}
+}
+
 // quotient
 func (d Dec) QuoInt(i Int) Dec {
 	mul := new(big.Int).Quo(d.Int, i.i)
@@ -365,6 +391,18 @@ func (d Dec) TruncateInt() Int {
 	return NewIntFromBigInt(chopPrecisionAndTruncateNonMutative(d.Int))
 }
 
+// Ceil returns the smallest integer value (as a decimal) that is greater than
+// or equal to the given decimal.
+func (d Dec) Ceil() Dec {
+	quo, rem := new(big.Int).QuoRem(d.Int, precisionReuse, new(big.Int))
+
+	// truncation towards zero already rounds up non-positive remainders
+	if rem.Sign() <= 0 {
+		return NewDecFromBigInt(quo)
+	}
+	return NewDecFromBigInt(quo.Add(quo, oneInt))
+}
+
 //___________________________________________________________________________________
 
 // reuse nil values
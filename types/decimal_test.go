@@ -228,6 +228,27 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestCeil(t *testing.T) {
+	tests := []struct {
+		d1  Dec
+		exp Dec
+	}{
+		{mustNewDecFromStr(t, "0"), mustNewDecFromStr(t, "0")},
+		{mustNewDecFromStr(t, "0.001"), mustNewDecFromStr(t, "1")},
+		{mustNewDecFromStr(t, "1"), mustNewDecFromStr(t, "1")},
+		{mustNewDecFromStr(t, "1.5"), mustNewDecFromStr(t, "2")},
+		{mustNewDecFromStr(t, "7.999999999999999999"), mustNewDecFromStr(t, "8")},
+		{mustNewDecFromStr(t, "-0.5"), mustNewDecFromStr(t, "0")},
+		{mustNewDecFromStr(t, "-1.5"), mustNewDecFromStr(t, "-1")},
+		{mustNewDecFromStr(t, "-2"), mustNewDecFromStr(t, "-2")},
+	}
+
+	for tcIndex, tc := range tests {
+		res := tc.d1.Ceil()
+		require.True(t, tc.exp.Equal(res), "tc %d: expected %v, got %v", tcIndex, tc.exp, res)
+	}
+}
+
 func TestToLeftPadded(t *testing.T) {
 	tests := []struct {
 		dec    Dec
@@ -342,3 +363,28 @@ func TestDecMulInt(t *testing.T) {
 		require.Equal(t, tc.want, got, "Incorrect result on test case %d", i)
 	}
 }
+
+func TestTruncatedArithmetic(t *testing.T) {
+	tests := []struct {
+		d1, d2         Dec
+		expMul, expDiv Dec
+	}{
+		// d1          d2            MUL           DIV
+		{NewDec(0), NewDec(1), NewDec(0), NewDec(0)},
+		{NewDec(3), NewDec(7), NewDec(21), NewDecWithPrec(428571428571428571, 18)},
+		{NewDec(-3), NewDec(7), NewDec(-21), NewDecWithPrec(-428571428571428571, 18)},
+		{NewDec(2), NewDec(3), NewDec(6), NewDecWithPrec(666666666666666666, 18)},
+		{NewDecWithPrec(5, 18), NewDecWithPrec(5, 1), NewDecWithPrec(2, 18), NewDecWithPrec(10, 18)},
+	}
+
+	for tcIndex, tc := range tests {
+		resMul := tc.d1.MulTruncate(tc.d2)
+		resDiv := tc.d1.QuoTruncate(tc.d2)
+		require.True(t, tc.expMul.Equal(resMul), "exp %v, res %v, tc %d", tc.expMul, resMul, tcIndex)
+		require.True(t, tc.expDiv.Equal(resDiv), "exp %v, res %v, tc %d", tc.expDiv, resDiv, tcIndex)
+
+		// truncation never rounds away from zero
+		require.True(t, resMul.Abs().LTE(tc.d1.Mul(tc.d2).Abs()), "tc %d", tcIndex)
+		require.True(t, resDiv.Abs().LTE(tc.d1.Quo(tc.d2).Abs()), "tc %d", tcIndex)
+	}
+}
@@ -56,6 +56,7 @@ const (
 	CodeOutOfGas          CodeType = 12
 	CodeMemoTooLarge      CodeType = 13
 	CodeInsufficientFee   CodeType = 14
+	CodeTxTimeoutHeight   CodeType = 15
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -102,6 +103,8 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "memo too large"
 	case CodeInsufficientFee:
 		return "insufficient fee"
+	case CodeTxTimeoutHeight:
+		return "tx timeout height"
 	default:
 		return unknownCodeMsg(code)
 	}
@@ -154,6 +157,9 @@ func ErrMemoTooLarge(msg string) Error {
 func ErrInsufficientFee(msg string) Error {
 	return newErrorWithRootCodespace(CodeInsufficientFee, msg)
 }
+func ErrTxTimeoutHeight(msg string) Error {
+	return newErrorWithRootCodespace(CodeTxTimeoutHeight, msg)
+}
 
 //----------------------------------------
 // Error & sdkError
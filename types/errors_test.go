@@ -20,6 +20,8 @@ var codeTypes = []CodeType{
 	CodeInvalidCoins,
 	CodeOutOfGas,
 	CodeMemoTooLarge,
+	CodeInsufficientFee,
+	CodeTxTimeoutHeight,
 }
 
 type errFn func(msg string) Error
@@ -38,6 +40,8 @@ var errFns = []errFn{
 	ErrInvalidCoins,
 	ErrOutOfGas,
 	ErrMemoTooLarge,
+	ErrInsufficientFee,
+	ErrTxTimeoutHeight,
 }
 
 func TestCodeType(t *testing.T) {
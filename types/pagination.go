@@ -0,0 +1,112 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Pagination limits
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+// PageRequest selects a page of the entries of a list query. Pages are
+// selected either by Key, the NextKey returned with the previous page, or by
+// Offset, the number of entries to skip; key based pagination is cheaper as
+// skipped entries need not be iterated over. A zero Limit means
+// DefaultPageLimit. If CountTotal is set, the response holds the total number
+// of entries, which costs a full iteration and is only supported with offset
+// based pagination. Entries are listed in ascending order unless Descending is
+// set.
+type PageRequest struct {
+	Key        cmn.HexBytes `json:"key"`
+	Offset     int64        `json:"offset"`
+	Limit      int64        `json:"limit"`
+	CountTotal bool         `json:"count_total"`
+	Descending bool         `json:"descending"`
+}
+
+// PageResponse describes the page returned by a list query. NextKey is nil on
+// the last page. Total is only set if it was requested.
+type PageResponse struct {
+	NextKey cmn.HexBytes `json:"next_key"`
+	Total   int64        `json:"total"`
+}
+
+// ValidateBasic checks the consistency of a page request.
+func (req PageRequest) ValidateBasic() error {
+	switch {
+	case req.Offset < 0:
+		return errors.New("page offset cannot be negative")
+	case req.Limit < 0:
+		return errors.New("page limit cannot be negative")
+	case req.Limit > MaxPageLimit:
+		return fmt.Errorf("page limit cannot exceed %d", MaxPageLimit)
+	case len(req.Key) > 0 && req.Offset > 0:
+		return errors.New("page key and offset cannot be both set")
+	case len(req.Key) > 0 && req.CountTotal:
+		return errors.New("total count is only supported with offset based pagination")
+	}
+	return nil
+}
+
+// Paginate iterates over the page of the entries of the store under the
+// given prefix selected by req, in ascending key order or in descending key
+// order if req.Descending is set, and calls onResult with the key of each
+// entry, including the prefix, and its value. Page keys are relative to the
+// prefix.
+func Paginate(store KVStore, prefix []byte, req PageRequest, onResult func(key, value []byte) error) (PageResponse, error) {
+	var res PageResponse
+	if err := req.ValidateBasic(); err != nil {
+		return res, err
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+
+	var iterator Iterator
+	if req.Descending {
+		// the page key is the first entry of the page, hence the end of the
+		// range is right after it
+		end := PrefixEndBytes(prefix)
+		if len(req.Key) > 0 {
+			end = append(append(append([]byte{}, prefix...), req.Key...), 0x00)
+		}
+		iterator = store.ReverseIterator(prefix, end)
+	} else {
+		start := prefix
+		if len(req.Key) > 0 {
+			start = append(append([]byte{}, prefix...), req.Key...)
+		}
+		iterator = store.Iterator(start, PrefixEndBytes(prefix))
+	}
+	defer iterator.Close()
+
+	var count int64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		switch {
+		case count <= req.Offset:
+			continue
+		case count <= req.Offset+limit:
+			if err := onResult(iterator.Key(), iterator.Value()); err != nil {
+				return res, err
+			}
+			continue
+		case res.NextKey == nil:
+			res.NextKey = append([]byte{}, iterator.Key()[len(prefix):]...)
+		}
+		if !req.CountTotal {
+			break
+		}
+	}
+
+	if req.CountTotal {
+		res.Total = count
+	}
+	return res, nil
+}
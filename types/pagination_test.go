@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPaginate(t *testing.T) {
+	key := types.NewKVStoreKey(t.Name())
+	store := defaultContext(key).KVStore(key)
+	prefix := []byte{0x01}
+	for i := 0; i < 10; i++ {
+		store.Set(append(prefix, byte(i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	// entries out of the prefix are never returned
+	store.Set([]byte{0x00}, []byte("before"))
+	store.Set([]byte{0x02}, []byte("after"))
+
+	paginate := func(req types.PageRequest) ([]string, types.PageResponse, error) {
+		var values []string
+		res, err := types.Paginate(store, prefix, req, func(key, value []byte) error {
+			values = append(values, string(value))
+			return nil
+		})
+		return values, res, err
+	}
+
+	values, res, err := paginate(types.PageRequest{Limit: 4, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"value0", "value1", "value2", "value3"}, values)
+	require.Equal(t, []byte{4}, []byte(res.NextKey))
+	require.Equal(t, int64(10), res.Total)
+
+	// the next key resumes where the previous page stopped
+	values, res, err = paginate(types.PageRequest{Key: res.NextKey, Limit: 4})
+	require.NoError(t, err)
+	require.Equal(t, []string{"value4", "value5", "value6", "value7"}, values)
+	require.Equal(t, []byte{8}, []byte(res.NextKey))
+	require.Equal(t, int64(0), res.Total)
+
+	values, res, err = paginate(types.PageRequest{Offset: 8, Limit: 4})
+	require.NoError(t, err)
+	require.Equal(t, []string{"value8", "value9"}, values)
+	require.Nil(t, res.NextKey)
+
+	// descending pages start from the last entry
+	values, res, err = paginate(types.PageRequest{Limit: 4, CountTotal: true, Descending: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"value9", "value8", "value7", "value6"}, values)
+	require.Equal(t, []byte{5}, []byte(res.NextKey))
+	require.Equal(t, int64(10), res.Total)
+
+	values, res, err = paginate(types.PageRequest{Key: res.NextKey, Limit: 4, Descending: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"value5", "value4", "value3", "value2"}, values)
+	require.Equal(t, []byte{1}, []byte(res.NextKey))
+
+	values, res, err = paginate(types.PageRequest{Offset: 8, Limit: 4, Descending: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"value1", "value0"}, values)
+	require.Nil(t, res.NextKey)
+
+	// the default limit applies
+	values, _, err = paginate(types.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, values, 10)
+
+	for _, req := range []types.PageRequest{
+		{Offset: -1},
+		{Limit: types.MaxPageLimit + 1},
+		{Key: []byte{1}, Offset: 1},
+		{Key: []byte{1}, CountTotal: true},
+	} {
+		_, _, err = paginate(req)
+		require.Error(t, err)
+	}
+}
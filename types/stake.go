@@ -47,6 +47,8 @@ type Validator interface {
 	GetCommission() Dec           // validator commission rate
 	GetDelegatorShares() Dec      // Total out standing delegator shares
 	GetBondHeight() int64         // height in which the validator became active
+	GetDelegatorShareExRate() Dec // tokens per delegator share
+	GetMinSelfDelegation() Int    // minimum tokens the operator must keep self-delegated
 }
 
 // validator which fulfills abci validator interface for use in Tendermint
@@ -71,6 +73,10 @@ type ValidatorSet interface {
 	ValidatorByConsAddr(Context, ConsAddress) Validator // get a particular validator by consensus address
 	TotalPower(Context) Dec                             // total power of the validator set
 
+	// bonded tokens of a validator at the end of a block, which can be above
+	// the power reported to Tendermint, if still known
+	ValidatorPowerAt(Context, ConsAddress, int64) (int64, bool)
+
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(Context, ConsAddress, int64, int64, Dec)
 	Jail(Context, ConsAddress)   // jail a validator
@@ -118,7 +124,15 @@ type StakingHooks interface {
 	OnValidatorBonded(ctx Context, address ConsAddress)         // Must be called when a validator is bonded
 	OnValidatorBeginUnbonding(ctx Context, address ConsAddress) // Must be called when a validator begins unbonding
 
-	OnDelegationCreated(ctx Context, delAddr AccAddress, valAddr ValAddress)        // Must be called when a delegation is created
-	OnDelegationSharesModified(ctx Context, delAddr AccAddress, valAddr ValAddress) // Must be called when a delegation's shares are modified
+	// Must be called when the consensus pubkey of a validator is rotated
+	OnValidatorConsPubKeyRotated(ctx Context, address ValAddress, oldConsAddr, newConsAddr ConsAddress)
+
+	// Must be called before the tokens of a validator are slashed, with the
+	// fraction of its tokens being slashed
+	OnValidatorSlashed(ctx Context, address ValAddress, fraction Dec)
+
+	OnDelegationCreated(ctx Context, delAddr AccAddress, valAddr ValAddress)        // Must be called before a delegation is created
+	OnDelegationSharesModified(ctx Context, delAddr AccAddress, valAddr ValAddress) // Must be called before a delegation's shares are modified
+	OnDelegationModified(ctx Context, delAddr AccAddress, valAddr ValAddress)       // Must be called after a delegation is created or its shares are modified
 	OnDelegationRemoved(ctx Context, delAddr AccAddress, valAddr ValAddress)        // Must be called when a delegation is removed
 }
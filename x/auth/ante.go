@@ -16,8 +16,6 @@ const (
 	ed25519VerifyCost           = 59
 	secp256k1VerifyCost         = 100
 	maxMemoCharacters           = 100
-	// how much gas = 1 atom
-	gasPerUnitCost = 1000
 )
 
 // NewAnteHandler returns an AnteHandler that checks
@@ -36,11 +34,17 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 
 		// Ensure that the provided fees meet a minimum threshold for the validator, if this is a CheckTx.
 		// This is only for local mempool purposes, and thus is only ran on check tx.
+		var tags sdk.Tags
 		if ctx.IsCheckTx() && !simulate {
 			res := ensureSufficientMempoolFees(ctx, stdTx)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
+
+			// report the gas price paid so that the mempool can prioritise txs
+			if gasPrices := txGasPrices(stdTx.Fee); !gasPrices.IsZero() {
+				tags = tags.AppendTag(sdk.TagGasPrice, []byte(gasPrices.String()))
+			}
 		}
 
 		newCtx = setGasMeter(simulate, ctx, stdTx)
@@ -68,6 +72,11 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		if err != nil {
 			return newCtx, err.Result(), true
 		}
+
+		err = validateTimeoutHeight(newCtx, stdTx)
+		if err != nil {
+			return newCtx, err.Result(), true
+		}
 		// charge gas for the memo
 		newCtx.GasMeter().ConsumeGas(memoCostPerByte*sdk.Gas(len(stdTx.GetMemo())), "memo")
 
@@ -110,8 +119,7 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		// cache the signer accounts in the context
 		newCtx = WithSigners(newCtx, signerAccs)
 
-		// TODO: tx tags (?)
-		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false // continue...
+		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas, Tags: tags}, false // continue...
 	}
 }
 
@@ -138,6 +146,29 @@ func validateBasic(tx StdTx) (err sdk.Error) {
 	return nil
 }
 
+// validateTimeoutHeight rejects a transaction whose timeout height has already
+// passed. During CheckTx the context holds the last committed block, so the
+// transaction must still be includable in the next block.
+func validateTimeoutHeight(ctx sdk.Context, tx StdTx) sdk.Error {
+	timeoutHeight := tx.GetTimeoutHeight()
+	if timeoutHeight < 0 {
+		return sdk.ErrTxTimeoutHeight(fmt.Sprintf("invalid timeout height %d", timeoutHeight))
+	}
+	if timeoutHeight == 0 {
+		return nil
+	}
+
+	height := ctx.BlockHeight()
+	if ctx.IsCheckTx() {
+		height++
+	}
+	if height > timeoutHeight {
+		return sdk.ErrTxTimeoutHeight(
+			fmt.Sprintf("tx timeout height %d has passed, current height %d", timeoutHeight, height))
+	}
+	return nil
+}
+
 func getSignerAccs(ctx sdk.Context, am AccountMapper, addrs []sdk.AccAddress) (accs []Account, res sdk.Result) {
 	accs = make([]Account, len(addrs))
 	for i := 0; i < len(accs); i++ {
@@ -181,8 +212,10 @@ func processSig(ctx sdk.Context,
 		return nil, sdk.ErrInternal("setting PubKey on signer's account").Result()
 	}
 
+	// Signatures of re-checked txs were already verified when they entered the
+	// mempool, however the verification gas is still charged.
 	consumeSignatureVerificationGas(ctx.GasMeter(), pubKey)
-	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
+	if !simulate && !ctx.IsReCheckTx() && !pubKey.VerifyBytes(signBytes, sig.Signature) {
 		return nil, sdk.ErrUnauthorized("signature verification failed").Result()
 	}
 
@@ -209,9 +242,14 @@ func processPubKey(acc Account, sig StdSignature, simulate bool) (crypto.PubKey,
 	pubKey := acc.GetPubKey()
 	if simulate {
 		// In simulate mode the transaction comes with no signatures, thus
-		// if the account's pubkey is nil, both signature verification
-		// and gasKVStore.Set() shall consume the largest amount, i.e.
+		// if the account's pubkey is nil, the pubkey supplied along with the
+		// placeholder signature is used to charge the right verification gas.
+		// If neither is known, both signature verification and
+		// gasKVStore.Set() shall consume the largest amount, i.e.
 		// it takes more gas to verifiy secp256k1 keys than ed25519 ones.
+		if pubKey == nil {
+			pubKey = sig.PubKey
+		}
 		if pubKey == nil {
 			return dummySecp256k1Pubkey, sdk.Result{}
 		}
@@ -241,14 +279,14 @@ func consumeSignatureVerificationGas(meter sdk.GasMeter, pubkey crypto.PubKey) {
 	}
 }
 
-func adjustFeesByGas(fees sdk.Coins, gas int64) sdk.Coins {
-	gasCost := gas / gasPerUnitCost
-	gasFees := make(sdk.Coins, len(fees))
-	// TODO: Make this not price all coins in the same way
-	for i := 0; i < len(fees); i++ {
-		gasFees[i] = sdk.NewInt64Coin(fees[i].Denom, gasCost)
+// txGasPrices returns the gas price paid by the fee in each of its
+// denominations, i.e. fee / gasWanted.
+func txGasPrices(fee StdFee) sdk.DecCoins {
+	if fee.Gas <= 0 {
+		return sdk.DecCoins{}
 	}
-	return fees.Plus(gasFees)
+
+	return sdk.NewDecCoins(fee.Amount).QuoDec(sdk.NewDec(fee.Gas))
 }
 
 // Deduct the fee from the account.
@@ -271,18 +309,32 @@ func deductFees(acc Account, fee StdFee) (Account, sdk.Result) {
 	return acc, sdk.Result{}
 }
 
+// ensureSufficientMempoolFees verifies that the fee of the transaction covers
+// the validator's minimum gas prices, i.e. that fee >= gasPrice * gasWanted
+// holds for at least one of the accepted denominations.
 func ensureSufficientMempoolFees(ctx sdk.Context, stdTx StdTx) sdk.Result {
-	// currently we use a very primitive gas pricing model with a constant gasPrice.
-	// adjustFeesByGas handles calculating the amount of fees required based on the provided gas.
-	// TODO: Make the gasPrice not a constant, and account for tx size.
-	requiredFees := adjustFeesByGas(ctx.MinimumFees(), stdTx.Fee.Gas)
-
-	if !ctx.MinimumFees().IsZero() && stdTx.Fee.Amount.IsLT(requiredFees) {
-		// validators reject any tx from the mempool with less than the minimum fee per gas * gas factor
-		return sdk.ErrInsufficientFee(fmt.Sprintf(
-			"insufficient fee, got: %q required: %q", stdTx.Fee.Amount, requiredFees)).Result()
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return sdk.Result{}
 	}
-	return sdk.Result{}
+
+	gasWanted := sdk.NewDec(stdTx.Fee.Gas)
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	for i, gp := range minGasPrices {
+		// round up so that the fee never falls short of the minimum gas price
+		fee := gp.Amount.Mul(gasWanted).Ceil()
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.RoundInt())
+	}
+
+	for _, requiredFee := range requiredFees {
+		if !stdTx.Fee.Amount.AmountOf(requiredFee.Denom).LT(requiredFee.Amount) {
+			return sdk.Result{}
+		}
+	}
+
+	// validators reject any tx from the mempool with less than the minimum gas price * gas wanted
+	return sdk.ErrInsufficientFee(fmt.Sprintf(
+		"insufficient fee, got: %q required one of: %q", stdTx.Fee.Amount, requiredFees)).Result()
 }
 
 func setGasMeter(simulate bool, ctx sdk.Context, stdTx StdTx) sdk.Context {
@@ -298,7 +350,7 @@ func getSignBytesList(chainID string, stdTx StdTx, stdSigs []StdSignature) (sign
 	for i := 0; i < len(stdSigs); i++ {
 		signatureBytesList[i] = StdSignBytes(chainID,
 			stdSigs[i].AccountNumber, stdSigs[i].Sequence,
-			stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+			stdTx.Fee, stdTx.Msgs, stdTx.Memo, stdTx.TimeoutHeight)
 	}
 	return
 }
@@ -67,7 +67,7 @@ func checkInvalidTx(t *testing.T, anteHandler sdk.AnteHandler, ctx sdk.Context,
 func newTestTx(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, fee StdFee) sdk.Tx {
 	sigs := make([]StdSignature, len(privs))
 	for i, priv := range privs {
-		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "")
+		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "", 0)
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
 			panic(err)
@@ -81,7 +81,7 @@ func newTestTx(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums
 func newTestTxWithMemo(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, fee StdFee, memo string) sdk.Tx {
 	sigs := make([]StdSignature, len(privs))
 	for i, priv := range privs {
-		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, memo)
+		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, memo, 0)
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
 			panic(err)
@@ -92,6 +92,20 @@ func newTestTxWithMemo(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey,
 	return tx
 }
 
+func newTestTxWithTimeoutHeight(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, fee StdFee, timeoutHeight int64) sdk.Tx {
+	sigs := make([]StdSignature, len(privs))
+	for i, priv := range privs {
+		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "", timeoutHeight)
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		sigs[i] = StdSignature{PubKey: priv.PubKey(), Signature: sig, AccountNumber: accNums[i], Sequence: seqs[i]}
+	}
+	tx := NewStdTx(msgs, fee, sigs, "").WithTimeoutHeight(timeoutHeight)
+	return tx
+}
+
 // All signers sign over the same StdSignDoc. Should always create invalid signatures
 func newTestTxWithSignBytes(msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, fee StdFee, signBytes []byte, memo string) sdk.Tx {
 	sigs := make([]StdSignature, len(privs))
@@ -382,6 +396,62 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+// Test logic around the transaction timeout height.
+func TestAnteHandlerTimeoutHeight(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := codec.New()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid", Height: 10}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	msgs := []sdk.Msg{msg}
+	fee := newStdFee()
+	privs, accnums := []crypto.PrivKey{priv1}, []int64{0}
+
+	// timeout height has passed
+	tx = newTestTxWithTimeoutHeight(ctx, msgs, privs, accnums, []int64{0}, fee, 9)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeTxTimeoutHeight)
+
+	// negative timeout height
+	tx = newTestTxWithTimeoutHeight(ctx, msgs, privs, accnums, []int64{0}, fee, -1)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeTxTimeoutHeight)
+
+	// timeout height equal to the current block height
+	tx = newTestTxWithTimeoutHeight(ctx, msgs, privs, accnums, []int64{0}, fee, 10)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// no timeout height
+	tx = newTestTxWithTimeoutHeight(ctx, msgs, privs, accnums, []int64{1}, fee, 0)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// timeout height is covered by the signature
+	tx = newTestTxWithTimeoutHeight(ctx, msgs, privs, accnums, []int64{2}, fee, 0)
+	tx = tx.(StdTx).WithTimeoutHeight(20)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// in CheckTx the tx must still be includable in the next block
+	checkCtx := ctx.WithIsCheckTx(true)
+	tx = newTestTxWithTimeoutHeight(checkCtx, msgs, privs, accnums, []int64{2}, fee, 10)
+	checkInvalidTx(t, anteHandler, checkCtx, tx, false, sdk.CodeTxTimeoutHeight)
+
+	tx = newTestTxWithTimeoutHeight(checkCtx, msgs, privs, accnums, []int64{2}, fee, 11)
+	checkValidTx(t, anteHandler, checkCtx, tx, false)
+}
+
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	ms, capKey, capKey2 := setupMultiStore()
@@ -433,6 +503,44 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+// Test that signature verification is skipped when re-checking a tx.
+func TestAnteHandlerReCheck(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := codec.New()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, true, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	msgs := []sdk.Msg{msg}
+	fee := newStdFee()
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []int64{0}, []int64{0}
+
+	// signature over the wrong chain ID is rejected in CheckTx
+	signBytes := StdSignBytes(ctx.ChainID()+"somemorestuff", 0, 0, fee, msgs, "", 0)
+	tx = newTestTxWithSignBytes(msgs, privs, accnums, seqs, fee, signBytes, "")
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// but not verified again in ReCheckTx
+	recheckCtx := ctx.WithIsReCheckTx(true)
+	checkValidTx(t, anteHandler, recheckCtx, tx, false)
+
+	// the sequence is still checked in ReCheckTx
+	checkInvalidTx(t, anteHandler, recheckCtx, tx, false, sdk.CodeInvalidSequence)
+}
+
 func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	ms, capKey, capKey2 := setupMultiStore()
@@ -494,7 +602,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 		tx := newTestTxWithSignBytes(
 
 			msgs, privs, accnums, seqs, fee,
-			StdSignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, ""),
+			StdSignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, "", 0),
 			"",
 		)
 		checkInvalidTx(t, anteHandler, ctx, tx, false, cs.code)
@@ -599,6 +707,12 @@ func TestProcessPubKey(t *testing.T) {
 			require.Equal(t, tt.wantErr, !err.IsOK())
 		})
 	}
+
+	// simulations charge gas for the pubkey supplied with the placeholder signature
+	edPubKey := ed25519.GenPrivKey().PubKey()
+	pubKey, res := processPubKey(acc1, StdSignature{PubKey: edPubKey}, true)
+	require.True(t, res.IsOK())
+	require.Equal(t, edPubKey, pubKey)
 }
 
 func TestConsumeSignatureVerificationGas(t *testing.T) {
@@ -628,23 +742,40 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}
 }
 
-func TestAdjustFeesByGas(t *testing.T) {
-	type args struct {
-		fee sdk.Coins
-		gas int64
-	}
-	tests := []struct {
-		name string
-		args args
-		want sdk.Coins
+func TestEnsureSufficientMempoolFees(t *testing.T) {
+	// setup
+	ms, _, _ := setupMultiStore()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, true, log.NewNopLogger())
+	ctx = ctx.WithMinGasPrices(
+		sdk.DecCoins{
+			sdk.DecCoin{Denom: "photino", Amount: sdk.NewDecWithPrec(5, 5)}, // 0.00005photino
+			sdk.DecCoin{Denom: "stake", Amount: sdk.NewDecWithPrec(1, 5)},   // 0.00001stake
+		},
+	)
+
+	testCases := []struct {
+		input      StdFee
+		expectedOK bool
 	}{
-		{"nil coins", args{sdk.Coins{}, 10000}, sdk.Coins{}},
-		{"nil coins", args{sdk.Coins{sdk.NewInt64Coin("A", 10), sdk.NewInt64Coin("B", 0)}, 10000}, sdk.Coins{sdk.NewInt64Coin("A", 20), sdk.NewInt64Coin("B", 10)}},
-		{"negative coins", args{sdk.Coins{sdk.NewInt64Coin("A", -10), sdk.NewInt64Coin("B", 10)}, 10000}, sdk.Coins{sdk.NewInt64Coin("B", 20)}},
+		{NewStdFee(200000, sdk.NewInt64Coin("photino", 5)), false},
+		{NewStdFee(200000, sdk.NewInt64Coin("stake", 1)), false},
+		{NewStdFee(200000, sdk.NewInt64Coin("stake", 2)), true},
+		{NewStdFee(200000, sdk.NewInt64Coin("photino", 10)), true},
+		{NewStdFee(200000, sdk.NewInt64Coin("photino", 10), sdk.NewInt64Coin("stake", 2)), true},
+		{NewStdFee(200000, sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("photino", 10)), true},
+		{NewStdFee(100001, sdk.NewInt64Coin("photino", 5)), false}, // fee is rounded up
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			require.True(t, tt.want.IsEqual(adjustFeesByGas(tt.args.fee, tt.args.gas)))
-		})
+
+	for i, tc := range testCases {
+		stdTx := StdTx{Fee: tc.input}
+		res := ensureSufficientMempoolFees(ctx, stdTx)
+		require.Equal(t, tc.expectedOK, res.IsOK(), "unexpected result for test case #%d, input: %v", i, tc.input)
 	}
 }
+
+func TestTxGasPrices(t *testing.T) {
+	require.True(t, txGasPrices(NewStdFee(0, sdk.NewInt64Coin("stake", 10))).IsZero())
+
+	gasPrices := txGasPrices(NewStdFee(200000, sdk.NewInt64Coin("stake", 10)))
+	require.True(t, sdk.NewDecWithPrec(5, 5).Equal(gasPrices.AmountOf("stake")))
+}
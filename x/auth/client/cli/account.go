@@ -4,13 +4,17 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
+const flagExport = "export"
+
 // GetAccountCmdDefault invokes the GetAccountCmd for the auth.BaseAccount type.
 func GetAccountCmdDefault(storeName string, cdc *codec.Codec) *cobra.Command {
 	return GetAccountCmd(storeName, cdc, GetAccountDecoder(cdc))
@@ -32,10 +36,13 @@ func GetAccountDecoder(cdc *codec.Codec) auth.AccountDecoder {
 // account at a given address.
 // nolint: unparam
 func GetAccountCmd(storeName string, cdc *codec.Codec, decoder auth.AccountDecoder) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "account [address]",
 		Short: "Query account balance",
-		Args:  cobra.ExactArgs(1),
+		Long: `Query account balance.
+With --export, also write the account number and sequence to the given file,
+so that transactions can be signed offline with 'tx sign --account-file'.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// find the key to look up the account
 			addr := args[0]
@@ -58,6 +65,12 @@ func GetAccountCmd(storeName string, cdc *codec.Codec, decoder auth.AccountDecod
 				return err
 			}
 
+			if filename := viper.GetString(flagExport); filename != "" {
+				if err := utils.WriteAccountInfo(cdc, filename, utils.NewAccountInfo(acc)); err != nil {
+					return err
+				}
+			}
+
 			var output []byte
 			if cliCtx.Indent {
 				output, err = cdc.MarshalJSONIndent(acc, "", "  ")
@@ -72,4 +85,6 @@ func GetAccountCmd(storeName string, cdc *codec.Codec, decoder auth.AccountDecod
 			return nil
 		},
 	}
+	cmd.Flags().String(flagExport, "", "Write the account info needed to sign offline to the given file")
+	return cmd
 }
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+)
+
+// GetBatchCommand returns the batch command
+func GetBatchCommand(codec *amino.Codec, decoder auth.AccountDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch <file>...",
+		Short: "Merge messages from several files into a single transaction",
+		Long: `Build a single transaction out of the messages read from the given files, then
+sign and broadcast it, or print it with --generate-only. Messages are executed
+atomically, in the order they are read: if one of them fails, none is applied.
+
+Each file contains either a transaction created with the --generate-only flag
+or a list of messages of any registered type. Files with a .yaml or .yml
+extension are read as YAML, others as JSON. The fees, memos and signatures of
+the input transactions are discarded; the batch pays the single fee and
+carries the memo given via --fee and --memo.`,
+		RunE: makeBatchCmd(codec, decoder),
+		Args: cobra.MinimumNArgs(1),
+	}
+	return cmd
+}
+
+func makeBatchCmd(cdc *amino.Codec, decoder auth.AccountDecoder) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		msgs, err := utils.ReadBatchMsgs(cdc, args)
+		if err != nil {
+			return err
+		}
+
+		txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+		cliCtx := context.NewCLIContext().
+			WithCodec(cdc).
+			WithAccountDecoder(decoder)
+
+		if cliCtx.GenerateOnly {
+			return utils.PrintUnsignedStdTx(txBldr, cliCtx, msgs, false)
+		}
+
+		// only transactions whose sole signer is the --from key can be
+		// signed and broadcast at once
+		from, err := cliCtx.GetFromAddress()
+		if err != nil {
+			return err
+		}
+		signers := auth.NewStdTx(msgs, auth.StdFee{}, nil, "").GetSigners()
+		if len(signers) != 1 {
+			return fmt.Errorf("the batch must be signed by %d signers; "+
+				"use --generate-only and sign it with 'tx sign' instead", len(signers))
+		}
+		if !bytes.Equal(signers[0], from) {
+			return fmt.Errorf("the batch must be signed by %s, not by the --from key", signers[0])
+		}
+
+		return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, msgs)
+	}
+}
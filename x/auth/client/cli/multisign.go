@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// GetMultiSignCommand returns the multisign command
+func GetMultiSignCommand(codec *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign <file> <signed-file>...",
+		Short: "Merge the signatures of a transaction signed on several machines",
+		Long: `Read the copies of the same transaction signed by different signers, e.g. on
+separate offline machines, and print the transaction carrying all of their
+signatures, sorted in the order expected by the chain. The copies must only
+differ in their signatures.`,
+		RunE: makeMultiSignCmd(codec),
+		Args: cobra.MinimumNArgs(2),
+	}
+	return cmd
+}
+
+func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		txs := make([]auth.StdTx, len(args))
+		for i, filename := range args {
+			stdTx, err := readAndUnmarshalStdTx(cdc, filename)
+			if err != nil {
+				return err
+			}
+			txs[i] = stdTx
+		}
+
+		newTx, err := utils.MergeStdTxSignatures(txs)
+		if err != nil {
+			return err
+		}
+
+		var json []byte
+		if context.NewCLIContext().Indent {
+			json, err = cdc.MarshalJSONIndent(newTx, "", "  ")
+		} else {
+			json, err = cdc.MarshalJSON(newTx)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", json)
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -17,8 +19,10 @@ import (
 )
 
 const (
-	flagAppend    = "append"
-	flagPrintSigs = "print-sigs"
+	flagAppend      = "append"
+	flagPrintSigs   = "print-sigs"
+	flagOffline     = "offline"
+	flagAccountFile = "account-file"
 )
 
 // GetSignCommand returns the sign command
@@ -27,13 +31,20 @@ func GetSignCommand(codec *amino.Codec, decoder auth.AccountDecoder) *cobra.Comm
 		Use:   "sign <file>",
 		Short: "Sign transactions generated offline",
 		Long: `Sign transactions created with the --generate-only flag.
-Read a transaction from <file>, sign it, and print its JSON encoding.`,
+Read a transaction from <file>, sign it, and print its JSON encoding.
+
+With --offline, no full node is queried: the account number and sequence
+are read from --account-file, as written by 'query account --export', or
+from the --account-number and --sequence flags. The chain ID must be given
+via --chain-id.`,
 		RunE: makeSignCmd(codec, decoder),
 		Args: cobra.ExactArgs(1),
 	}
 	cmd.Flags().String(client.FlagName, "", "Name of private key with which to sign")
 	cmd.Flags().Bool(flagAppend, true, "Append the signature to the existing ones. If disabled, old signatures would be overwritten")
 	cmd.Flags().Bool(flagPrintSigs, false, "Print the addresses that must sign the transaction and those who have already signed it, then exit")
+	cmd.Flags().Bool(flagOffline, false, "Sign without querying a full node for the account number and sequence")
+	cmd.Flags().String(flagAccountFile, "", "Read the account number and sequence from the given account info file; implies --offline")
 	return cmd
 }
 
@@ -53,7 +64,18 @@ func makeSignCmd(cdc *amino.Codec, decoder auth.AccountDecoder) func(cmd *cobra.
 		cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(decoder)
 		txBldr := authtxb.NewTxBuilderFromCLI()
 
-		newTx, err := utils.SignStdTx(txBldr, cliCtx, name, stdTx, viper.GetBool(flagAppend))
+		offline := viper.GetBool(flagOffline)
+		if filename := viper.GetString(flagAccountFile); filename != "" {
+			if txBldr, err = applyAccountFile(cdc, txBldr, name, filename); err != nil {
+				return err
+			}
+			offline = true
+		}
+		if offline && txBldr.ChainID == "" {
+			return fmt.Errorf("--chain-id is required to sign offline")
+		}
+
+		newTx, err := utils.SignStdTx(txBldr, cliCtx, name, stdTx, viper.GetBool(flagAppend), offline)
 		if err != nil {
 			return err
 		}
@@ -71,6 +93,32 @@ func makeSignCmd(cdc *amino.Codec, decoder auth.AccountDecoder) func(cmd *cobra.
 	}
 }
 
+// applyAccountFile sets the account number and sequence read from an account
+// info file, provided that it describes the account of the signing key.
+func applyAccountFile(cdc *amino.Codec, txBldr authtxb.TxBuilder, name, filename string) (authtxb.TxBuilder, error) {
+	accInfo, err := utils.ReadAccountInfo(cdc, filename)
+	if err != nil {
+		return txBldr, err
+	}
+
+	keybase, err := keys.GetKeyBase()
+	if err != nil {
+		return txBldr, err
+	}
+	info, err := keybase.Get(name)
+	if err != nil {
+		return txBldr, err
+	}
+	if !bytes.Equal(info.GetPubKey().Address(), accInfo.Address) {
+		return txBldr, fmt.Errorf("account file %s describes %s, not the address of key '%s'",
+			filename, accInfo.Address, name)
+	}
+
+	return txBldr.
+		WithAccountNumber(accInfo.AccountNumber).
+		WithSequence(accInfo.Sequence), nil
+}
+
 func printSignatures(stdTx auth.StdTx) {
 	fmt.Println("Signers:")
 	for i, signer := range stdTx.GetSigners() {
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+const flagPubKeys = "pubkeys"
+
+// GetSimulateCommand returns the simulate command
+func GetSimulateCommand(codec *amino.Codec, decoder auth.AccountDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate <file>",
+		Short: "Simulate transactions generated offline",
+		Long: `Simulate the execution of a transaction created with the --generate-only flag.
+Read a transaction from <file>, run it against the latest state of the connected
+node and print the gas it consumed along with the resulting log and tags.
+The transaction need not be signed: signature checks are skipped, but the gas
+required to verify the signatures is charged. Public keys of signers whose
+accounts do not have one yet can be supplied in signer order via --pubkeys.`,
+		RunE: makeSimulateCmd(codec, decoder),
+		Args: cobra.ExactArgs(1),
+	}
+	cmd.Flags().StringSlice(flagPubKeys, nil, "Comma separated Bech32 account public keys of the transaction's signers")
+	return cmd
+}
+
+func makeSimulateCmd(cdc *amino.Codec, decoder auth.AccountDecoder) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		stdTx, err := readAndUnmarshalStdTx(cdc, args[0])
+		if err != nil {
+			return
+		}
+
+		pubkeys := make([]crypto.PubKey, len(viper.GetStringSlice(flagPubKeys)))
+		for i, bech32PubKey := range viper.GetStringSlice(flagPubKeys) {
+			pubkeys[i], err = sdk.GetAccPubKeyBech32(bech32PubKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(decoder)
+		stdTx, err = utils.PrepareSimulationTx(cliCtx, stdTx, pubkeys)
+		if err != nil {
+			return err
+		}
+
+		txBytes, err := cdc.MarshalBinary(stdTx)
+		if err != nil {
+			return err
+		}
+
+		res, err := utils.SimulateTx(cliCtx.Query, cdc, txBytes)
+		if err != nil {
+			return err
+		}
+
+		simRes := utils.NewSimulationResponse(res, viper.GetFloat64(client.FlagGasAdjustment))
+		var json []byte
+		if cliCtx.Indent {
+			json, err = cdc.MarshalJSONIndent(simRes, "", "  ")
+		} else {
+			json, err = cdc.MarshalJSON(simRes)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", json)
+		return
+	}
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+)
+
+// GetValidateSignaturesCommand returns the validate-signatures command
+func GetValidateSignaturesCommand(codec *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-signatures <file>",
+		Short: "Validate the signatures of a transaction offline",
+		Long: `Check that every signer of the transaction read from <file> has signed it for
+the chain given via --chain-id, and that the signatures are in the order
+expected by the chain. No full node is queried: account numbers and
+sequences are taken from the signatures themselves.`,
+		RunE: makeValidateSignaturesCmd(codec),
+		Args: cobra.ExactArgs(1),
+	}
+	return cmd
+}
+
+func makeValidateSignaturesCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		stdTx, err := readAndUnmarshalStdTx(cdc, args[0])
+		if err != nil {
+			return err
+		}
+		chainID := viper.GetString(client.FlagChainID)
+		if chainID == "" {
+			return fmt.Errorf("--chain-id is required to validate signatures")
+		}
+
+		valid := len(stdTx.GetSignatures()) == len(stdTx.GetSigners())
+		fmt.Println("Signers:")
+		for i, status := range utils.VerifyStdTxSignatures(chainID, stdTx) {
+			var result string
+			switch {
+			case status.Index < 0:
+				result = "MISSING"
+			case !status.Valid:
+				result = "INVALID"
+			case status.Index != i:
+				result = fmt.Sprintf("OUT OF ORDER (signature #%d)", status.Index)
+			default:
+				result = "OK"
+			}
+			valid = valid && status.Ok(i)
+			fmt.Printf(" %v: %v %s\n", i, status.Signer, result)
+		}
+
+		if !valid {
+			return fmt.Errorf("signature verification failed")
+		}
+		return nil
+	}
+}
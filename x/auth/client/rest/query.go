@@ -28,6 +28,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec,
 		"/tx/sign",
 		SignTxRequestHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/tx/simulate",
+		SimulateTxRequestHandlerFn(cdc, cliCtx),
+	).Methods("POST")
 }
 
 // query accountREST Handler
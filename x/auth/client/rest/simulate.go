@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// SimulateBody defines the properties of a simulate request's body.
+type SimulateBody struct {
+	Tx            auth.StdTx `json:"tx"`
+	PubKeys       []string   `json:"pubkeys"`
+	GasAdjustment string     `json:"gas_adjustment"`
+}
+
+// nolint: unparam
+// simulate tx REST handler
+func SimulateTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m SimulateBody
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		err = cdc.UnmarshalJSON(body, &m)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		adjustment, ok := utils.ParseFloat64OrReturnBadRequest(w, m.GasAdjustment, client.DefaultGasAdjustment)
+		if !ok {
+			return
+		}
+
+		pubkeys := make([]crypto.PubKey, len(m.PubKeys))
+		for i, bech32PubKey := range m.PubKeys {
+			pubkeys[i], err = sdk.GetAccPubKeyBech32(bech32PubKey)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		cliCtx = cliCtx.WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+		stdTx, err := utils.PrepareSimulationTx(cliCtx, m.Tx, pubkeys)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txBytes, err := cdc.MarshalBinary(stdTx)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := utils.SimulateTx(cliCtx.Query, cdc, txBytes)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, utils.NewSimulationResponse(res, adjustment), cliCtx.Indent)
+	}
+}
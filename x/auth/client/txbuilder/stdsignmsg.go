@@ -15,9 +15,10 @@ type StdSignMsg struct {
 	Fee           auth.StdFee `json:"fee"`
 	Msgs          []sdk.Msg   `json:"msgs"`
 	Memo          string      `json:"memo"`
+	TimeoutHeight int64       `json:"timeout_height"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return auth.StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return auth.StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo, msg.TimeoutHeight)
 }
@@ -22,6 +22,7 @@ type TxBuilder struct {
 	ChainID       string
 	Memo          string
 	Fee           string
+	TimeoutHeight int64
 }
 
 // NewTxBuilderFromCLI returns a new initialized TxBuilder with parameters from
@@ -45,6 +46,7 @@ func NewTxBuilderFromCLI() TxBuilder {
 		SimulateGas:   client.GasFlagVar.Simulate,
 		Fee:           viper.GetString(client.FlagFee),
 		Memo:          viper.GetString(client.FlagMemo),
+		TimeoutHeight: viper.GetInt64(client.FlagTimeoutHeight),
 	}
 }
 
@@ -84,6 +86,12 @@ func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 	return bldr
 }
 
+// WithTimeoutHeight returns a copy of the context with an updated timeout height.
+func (bldr TxBuilder) WithTimeoutHeight(height int64) TxBuilder {
+	bldr.TimeoutHeight = height
+	return bldr
+}
+
 // WithAccountNumber returns a copy of the context with an account number.
 func (bldr TxBuilder) WithAccountNumber(accnum int64) TxBuilder {
 	bldr.AccountNumber = accnum
@@ -98,6 +106,10 @@ func (bldr TxBuilder) Build(msgs []sdk.Msg) (StdSignMsg, error) {
 		return StdSignMsg{}, errors.Errorf("chain ID required but not specified")
 	}
 
+	if bldr.TimeoutHeight < 0 {
+		return StdSignMsg{}, errors.Errorf("invalid timeout height %d", bldr.TimeoutHeight)
+	}
+
 	fee := sdk.Coin{}
 	if bldr.Fee != "" {
 		parsedFee, err := sdk.ParseCoin(bldr.Fee)
@@ -115,6 +127,7 @@ func (bldr TxBuilder) Build(msgs []sdk.Msg) (StdSignMsg, error) {
 		Memo:          bldr.Memo,
 		Msgs:          msgs,
 		Fee:           auth.NewStdFee(bldr.Gas, fee),
+		TimeoutHeight: bldr.TimeoutHeight,
 	}, nil
 }
 
@@ -125,7 +138,8 @@ func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, err
 	if err != nil {
 		return nil, err
 	}
-	return bldr.Codec.MarshalBinary(auth.NewStdTx(msg.Msgs, msg.Fee, []auth.StdSignature{sig}, msg.Memo))
+	stdTx := auth.NewStdTx(msg.Msgs, msg.Fee, []auth.StdSignature{sig}, msg.Memo).WithTimeoutHeight(msg.TimeoutHeight)
+	return bldr.Codec.MarshalBinary(stdTx)
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -166,7 +180,8 @@ func (bldr TxBuilder) BuildWithPubKey(name string, msgs []sdk.Msg) ([]byte, erro
 		PubKey:        info.GetPubKey(),
 	}}
 
-	return bldr.Codec.MarshalBinary(auth.NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo))
+	stdTx := auth.NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo).WithTimeoutHeight(msg.TimeoutHeight)
+	return bldr.Codec.MarshalBinary(stdTx)
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of a it. If append
@@ -179,6 +194,7 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx auth.StdTx, appen
 		Fee:           stdTx.Fee,
 		Msgs:          stdTx.GetMsgs(),
 		Memo:          stdTx.GetMemo(),
+		TimeoutHeight: stdTx.GetTimeoutHeight(),
 	})
 	if err != nil {
 		return
@@ -190,7 +206,8 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx auth.StdTx, appen
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx = auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo()).
+		WithTimeoutHeight(stdTx.GetTimeoutHeight())
 	return
 }
 
@@ -12,11 +12,15 @@ var _ sdk.Tx = (*StdTx)(nil)
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the fee payer (Signatures must not be nil).
+//
+// An optional, non-zero TimeoutHeight is the last block height at which the
+// transaction may be included in a block.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg"`
-	Fee        StdFee         `json:"fee"`
-	Signatures []StdSignature `json:"signatures"`
-	Memo       string         `json:"memo"`
+	Msgs          []sdk.Msg      `json:"msg"`
+	Fee           StdFee         `json:"fee"`
+	Signatures    []StdSignature `json:"signatures"`
+	Memo          string         `json:"memo"`
+	TimeoutHeight int64          `json:"timeout_height,omitempty"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -53,6 +57,17 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 //nolint
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
+// GetTimeoutHeight returns the last block height at which the transaction may
+// be included. A zero value means the transaction never times out.
+func (tx StdTx) GetTimeoutHeight() int64 { return tx.TimeoutHeight }
+
+// WithTimeoutHeight returns a copy of the transaction with the given timeout
+// height.
+func (tx StdTx) WithTimeoutHeight(height int64) StdTx {
+	tx.TimeoutHeight = height
+	return tx
+}
+
 // Signatures returns the signature of signers who signed the Msg.
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
@@ -103,6 +118,8 @@ func (fee StdFee) Bytes() []byte {
 // as well as the ChainID (prevent cross chain replay)
 // and the Sequence numbers for each signature (prevent
 // inchain replay and enforce tx ordering per account).
+// The TimeoutHeight is omitted when zero so that sign
+// bytes of transactions without a timeout are unchanged.
 type StdSignDoc struct {
 	AccountNumber int64             `json:"account_number"`
 	ChainID       string            `json:"chain_id"`
@@ -110,10 +127,11 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo"`
 	Msgs          []json.RawMessage `json:"msgs"`
 	Sequence      int64             `json:"sequence"`
+	TimeoutHeight int64             `json:"timeout_height,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
-func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs []sdk.Msg, memo string, timeoutHeight int64) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -125,6 +143,7 @@ func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		panic(err)
@@ -13,7 +13,14 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	if ctx.BlockHeight() > 1 {
 		previousPercentPrecommitVotes := getPreviousPercentPrecommitVotes(req)
 		previousProposer := k.GetPreviousProposerConsAddr(ctx)
-		k.AllocateFees(ctx, previousPercentPrecommitVotes, previousProposer)
+		k.AllocateFees(ctx, previousPercentPrecommitVotes, previousProposer, req.LastCommitInfo.GetVotes())
+	}
+
+	// compound the rewards of the delegators which enabled auto-compounding,
+	// a batch per block until the round is over
+	interval := k.GetAutoCompoundInterval(ctx)
+	if k.IsAutoCompoundInProgress(ctx) || (interval > 0 && ctx.BlockHeight()%interval == 0) {
+		k.CompoundDelegationRewards(ctx)
 	}
 
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
@@ -11,39 +11,60 @@ type (
 	Keeper = keeper.Keeper
 	Hooks  = keeper.Hooks
 
-	DelegatorWithdrawInfo = types.DelegatorWithdrawInfo
-	DelegationDistInfo    = types.DelegationDistInfo
-	ValidatorDistInfo     = types.ValidatorDistInfo
-	TotalAccum            = types.TotalAccum
-	FeePool               = types.FeePool
+	DelegatorWithdrawInfo            = types.DelegatorWithdrawInfo
+	DelegationDistInfo               = types.DelegationDistInfo
+	ValidatorDistInfo                = types.ValidatorDistInfo
+	ValidatorHistoricalRewards       = types.ValidatorHistoricalRewards
+	ValidatorHistoricalRewardsRecord = types.ValidatorHistoricalRewardsRecord
+	ValidatorSlashEvent              = types.ValidatorSlashEvent
+	ValidatorSlashEventRecord        = types.ValidatorSlashEventRecord
+	FeePool                          = types.FeePool
+	Params                           = keeper.Params
+
+	QueryValidatorParams         = keeper.QueryValidatorParams
+	QueryDelegationRewardsParams = keeper.QueryDelegationRewardsParams
+	QueryDelegatorParams         = keeper.QueryDelegatorParams
 
 	MsgSetWithdrawAddress          = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorRewardsAll = types.MsgWithdrawDelegatorRewardsAll
 	MsgWithdrawDelegatorReward     = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorRewardsAll = types.MsgWithdrawValidatorRewardsAll
+	MsgSetAutoCompound             = types.MsgSetAutoCompound
 
 	GenesisState = types.GenesisState
 )
 
 var (
-	NewKeeper = keeper.NewKeeper
-
-	GetValidatorDistInfoKey     = keeper.GetValidatorDistInfoKey
-	GetDelegationDistInfoKey    = keeper.GetDelegationDistInfoKey
-	GetDelegationDistInfosKey   = keeper.GetDelegationDistInfosKey
-	GetDelegatorWithdrawAddrKey = keeper.GetDelegatorWithdrawAddrKey
-	FeePoolKey                  = keeper.FeePoolKey
-	ValidatorDistInfoKey        = keeper.ValidatorDistInfoKey
-	DelegationDistInfoKey       = keeper.DelegationDistInfoKey
-	DelegatorWithdrawInfoKey    = keeper.DelegatorWithdrawInfoKey
-	ProposerKey                 = keeper.ProposerKey
-	DefaultParamspace           = keeper.DefaultParamspace
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	GetValidatorDistInfoKey             = keeper.GetValidatorDistInfoKey
+	GetDelegationDistInfoKey            = keeper.GetDelegationDistInfoKey
+	GetDelegationDistInfosKey           = keeper.GetDelegationDistInfosKey
+	GetDelegatorWithdrawAddrKey         = keeper.GetDelegatorWithdrawAddrKey
+	GetDelegatorAutoCompoundKey         = keeper.GetDelegatorAutoCompoundKey
+	GetValidatorHistoricalRewardsKey    = keeper.GetValidatorHistoricalRewardsKey
+	GetValidatorHistoricalRewardsPrefix = keeper.GetValidatorHistoricalRewardsPrefix
+	GetValidatorSlashEventKey           = keeper.GetValidatorSlashEventKey
+	GetValidatorSlashEventsPrefix       = keeper.GetValidatorSlashEventsPrefix
+	FeePoolKey                          = keeper.FeePoolKey
+	ValidatorDistInfoKey                = keeper.ValidatorDistInfoKey
+	DelegationDistInfoKey               = keeper.DelegationDistInfoKey
+	DelegatorWithdrawInfoKey            = keeper.DelegatorWithdrawInfoKey
+	ProposerKey                         = keeper.ProposerKey
+	ValidatorHistoricalRewardsKey       = keeper.ValidatorHistoricalRewardsKey
+	ValidatorSlashEventKey              = keeper.ValidatorSlashEventKey
+	DelegatorAutoCompoundKey            = keeper.DelegatorAutoCompoundKey
+	AutoCompoundCursorKey               = keeper.AutoCompoundCursorKey
+	DefaultParamspace                   = keeper.DefaultParamspace
+
+	StakeOvershootTolerance = keeper.StakeOvershootTolerance
+	StakeOvershootExceeded  = keeper.StakeOvershootExceeded
 
 	InitialFeePool = types.InitialFeePool
 
-	NewGenesisState              = types.NewGenesisState
-	DefaultGenesisState          = types.DefaultGenesisState
-	DefaultGenesisWithValidators = types.DefaultGenesisWithValidators
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
 
 	RegisterCodec = types.RegisterCodec
 
@@ -51,17 +72,38 @@ var (
 	NewMsgWithdrawDelegatorRewardsAll = types.NewMsgWithdrawDelegatorRewardsAll
 	NewMsgWithdrawDelegationReward    = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorRewardsAll = types.NewMsgWithdrawValidatorRewardsAll
+	NewMsgSetAutoCompound             = types.NewMsgSetAutoCompound
+)
+
+const (
+	QueryParams                      = keeper.QueryParams
+	QueryFeePool                     = keeper.QueryFeePool
+	QueryCommunityPool               = keeper.QueryCommunityPool
+	QueryValidatorCommission         = keeper.QueryValidatorCommission
+	QueryValidatorOutstandingRewards = keeper.QueryValidatorOutstandingRewards
+	QueryDelegationRewards           = keeper.QueryDelegationRewards
+	QueryDelegatorTotalRewards       = keeper.QueryDelegatorTotalRewards
+	QueryWithdrawAddr                = keeper.QueryWithdrawAddr
+	QueryAutoCompound                = keeper.QueryAutoCompound
 )
 
 const (
 	DefaultCodespace = types.DefaultCodespace
 	CodeInvalidInput = types.CodeInvalidInput
+	CodeNoDistInfo   = types.CodeNoDistInfo
+	CodeNoDelegation = types.CodeNoDelegation
+
+	AutoCompoundBatchSize = keeper.AutoCompoundBatchSize
 )
 
 var (
 	ErrNilDelegatorAddr = types.ErrNilDelegatorAddr
 	ErrNilWithdrawAddr  = types.ErrNilWithdrawAddr
 	ErrNilValidatorAddr = types.ErrNilValidatorAddr
+
+	ErrNoValidatorDistInfo  = types.ErrNoValidatorDistInfo
+	ErrNoDelegationDistInfo = types.ErrNoDelegationDistInfo
+	ErrNoDelegation         = types.ErrNoDelegation
 )
 
 var (
@@ -69,6 +111,7 @@ var (
 	ActionWithdrawDelegatorRewardsAll = tags.ActionWithdrawDelegatorRewardsAll
 	ActionWithdrawDelegatorReward     = tags.ActionWithdrawDelegatorReward
 	ActionWithdrawValidatorRewardsAll = tags.ActionWithdrawValidatorRewardsAll
+	ActionSetAutoCompound             = tags.ActionSetAutoCompound
 
 	TagAction    = tags.Action
 	TagValidator = tags.Validator
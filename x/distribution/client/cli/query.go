@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+)
+
+// GetCmdQueryParams implements the query distribution params command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "distr-params",
+		Short: "Query the current distribution parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryAndPrint(cdc, queryRoute, distr.QueryParams, nil)
+		},
+	}
+}
+
+// GetCmdQueryFeePool implements the query fee pool command.
+func GetCmdQueryFeePool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-pool",
+		Short: "Query the global fee pool, including the rewards not yet claimed by validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryAndPrint(cdc, queryRoute, distr.QueryFeePool, nil)
+		},
+	}
+}
+
+// GetCmdQueryCommunityPool implements the query community pool command.
+func GetCmdQueryCommunityPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "community-pool",
+		Short: "Query the amount of coins in the community pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryAndPrint(cdc, queryRoute, distr.QueryCommunityPool, nil)
+		},
+	}
+}
+
+// GetCmdQueryValidatorCommission implements the query validator commission
+// command.
+func GetCmdQueryValidatorCommission(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-commission [validator-addr]",
+		Short: "Query the commission a validator can withdraw",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := distr.QueryValidatorParams{ValidatorAddr: valAddr}
+			return queryAndPrint(cdc, queryRoute, distr.QueryValidatorCommission, params)
+		},
+	}
+}
+
+// GetCmdQueryValidatorOutstandingRewards implements the query validator
+// outstanding rewards command.
+func GetCmdQueryValidatorOutstandingRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-outstanding-rewards [validator-addr]",
+		Short: "Query the rewards of a validator not yet withdrawn by its delegators",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := distr.QueryValidatorParams{ValidatorAddr: valAddr}
+			return queryAndPrint(cdc, queryRoute, distr.QueryValidatorOutstandingRewards, params)
+		},
+	}
+}
+
+// GetCmdQueryRewards implements the query delegator rewards command.
+func GetCmdQueryRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rewards [delegator-addr] [<validator-addr>]",
+		Short: "Query the pending rewards of a delegator, for all its delegations or for a single validator",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				params := distr.QueryDelegatorParams{DelegatorAddr: delAddr}
+				return queryAndPrint(cdc, queryRoute, distr.QueryDelegatorTotalRewards, params)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := distr.QueryDelegationRewardsParams{DelegatorAddr: delAddr, ValidatorAddr: valAddr}
+			return queryAndPrint(cdc, queryRoute, distr.QueryDelegationRewards, params)
+		},
+	}
+}
+
+// GetCmdQueryWithdrawAddr implements the query withdraw address command.
+func GetCmdQueryWithdrawAddr(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-addr [delegator-addr]",
+		Short: "Query the address the rewards of a delegator are withdrawn to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := distr.QueryDelegatorParams{DelegatorAddr: delAddr}
+			return queryAndPrint(cdc, queryRoute, distr.QueryWithdrawAddr, params)
+		},
+	}
+}
+
+// GetCmdQueryAutoCompound implements the query auto-compound command.
+func GetCmdQueryAutoCompound(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Short: "Query whether the rewards of a delegator in the bond denom are delegated again automatically",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := distr.QueryDelegatorParams{DelegatorAddr: delAddr}
+			return queryAndPrint(cdc, queryRoute, distr.QueryAutoCompound, params)
+		},
+	}
+}
+
+// queryAndPrint runs a distribution query and prints its JSON result.
+func queryAndPrint(cdc *codec.Codec, queryRoute, endpoint string, params interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	var bz []byte
+	if params != nil {
+		var err error
+		bz, err = cdc.MarshalJSON(params)
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, endpoint), bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
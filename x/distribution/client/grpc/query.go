@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+
+	gogrpc "google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sgrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// RegisterQueryService registers the gRPC query service of the distribution
+// module, which runs the queries of the distribution querier.
+func RegisterQueryService(srv *gogrpc.Server, cdc *codec.Codec, querier sgrpc.Querier) {
+	RegisterQueryServer(srv, queryServer{cdc: cdc, querier: querier})
+}
+
+type queryServer struct {
+	cdc     *codec.Codec
+	querier sgrpc.Querier
+}
+
+var _ QueryServer = queryServer{}
+
+func (s queryServer) query(ctx context.Context, endpoint string, params, res interface{}) error {
+	return sgrpc.Query(ctx, s.cdc, s.querier, "custom/distr/"+endpoint, params, res)
+}
+
+// Params implements QueryServer.
+func (s queryServer) Params(ctx context.Context, req *sgrpc.Empty) (*ParamsResponse, error) {
+	var params keeper.Params
+	if err := s.query(ctx, keeper.QueryParams, nil, &params); err != nil {
+		return nil, err
+	}
+	return &ParamsResponse{Params: &Params{
+		CommunityTax:         params.CommunityTax.String(),
+		BaseProposerReward:   params.BaseProposerReward.String(),
+		BonusProposerReward:  params.BonusProposerReward.String(),
+		AutoCompoundInterval: params.AutoCompoundInterval,
+	}}, nil
+}
+
+// FeePool implements QueryServer.
+func (s queryServer) FeePool(ctx context.Context, req *sgrpc.Empty) (*FeePoolResponse, error) {
+	var feePool types.FeePool
+	if err := s.query(ctx, keeper.QueryFeePool, nil, &feePool); err != nil {
+		return nil, err
+	}
+	return &FeePoolResponse{CommunityPool: sgrpc.NewDecCoins(feePool.CommunityPool)}, nil
+}
+
+// CommunityPool implements QueryServer.
+func (s queryServer) CommunityPool(ctx context.Context, req *sgrpc.Empty) (*CommunityPoolResponse, error) {
+	var pool sdk.DecCoins
+	if err := s.query(ctx, keeper.QueryCommunityPool, nil, &pool); err != nil {
+		return nil, err
+	}
+	return &CommunityPoolResponse{Pool: sgrpc.NewDecCoins(pool)}, nil
+}
+
+// ValidatorCommission implements QueryServer.
+func (s queryServer) ValidatorCommission(ctx context.Context, req *ValidatorRequest) (*ValidatorCommissionResponse, error) {
+	params, err := validatorParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var commission sdk.DecCoins
+	if err := s.query(ctx, keeper.QueryValidatorCommission, params, &commission); err != nil {
+		return nil, err
+	}
+	return &ValidatorCommissionResponse{Commission: sgrpc.NewDecCoins(commission)}, nil
+}
+
+// ValidatorOutstandingRewards implements QueryServer.
+func (s queryServer) ValidatorOutstandingRewards(ctx context.Context, req *ValidatorRequest) (*RewardsResponse, error) {
+	params, err := validatorParams(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.rewards(ctx, keeper.QueryValidatorOutstandingRewards, params)
+}
+
+// DelegationRewards implements QueryServer.
+func (s queryServer) DelegationRewards(ctx context.Context, req *DelegationRewardsRequest) (*RewardsResponse, error) {
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, sgrpc.InvalidArgument(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, sgrpc.InvalidArgument(err)
+	}
+
+	params := keeper.QueryDelegationRewardsParams{DelegatorAddr: delAddr, ValidatorAddr: valAddr}
+	return s.rewards(ctx, keeper.QueryDelegationRewards, params)
+}
+
+// DelegatorTotalRewards implements QueryServer.
+func (s queryServer) DelegatorTotalRewards(ctx context.Context, req *DelegatorRequest) (*RewardsResponse, error) {
+	params, err := delegatorParams(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.rewards(ctx, keeper.QueryDelegatorTotalRewards, params)
+}
+
+// WithdrawAddr implements QueryServer.
+func (s queryServer) WithdrawAddr(ctx context.Context, req *DelegatorRequest) (*WithdrawAddrResponse, error) {
+	params, err := delegatorParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var withdrawAddr sdk.AccAddress
+	if err := s.query(ctx, keeper.QueryWithdrawAddr, params, &withdrawAddr); err != nil {
+		return nil, err
+	}
+	return &WithdrawAddrResponse{WithdrawAddr: withdrawAddr.String()}, nil
+}
+
+// AutoCompound implements QueryServer.
+func (s queryServer) AutoCompound(ctx context.Context, req *DelegatorRequest) (*AutoCompoundResponse, error) {
+	params, err := delegatorParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var enabled bool
+	if err := s.query(ctx, keeper.QueryAutoCompound, params, &enabled); err != nil {
+		return nil, err
+	}
+	return &AutoCompoundResponse{Enabled: enabled}, nil
+}
+
+// rewards runs a query returning rewards.
+func (s queryServer) rewards(ctx context.Context, endpoint string, params interface{}) (*RewardsResponse, error) {
+	var rewards sdk.DecCoins
+	if err := s.query(ctx, endpoint, params, &rewards); err != nil {
+		return nil, err
+	}
+	return &RewardsResponse{Rewards: sgrpc.NewDecCoins(rewards)}, nil
+}
+
+func validatorParams(req *ValidatorRequest) (keeper.QueryValidatorParams, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return keeper.QueryValidatorParams{}, sgrpc.InvalidArgument(err)
+	}
+	return keeper.QueryValidatorParams{ValidatorAddr: valAddr}, nil
+}
+
+func delegatorParams(req *DelegatorRequest) (keeper.QueryDelegatorParams, error) {
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return keeper.QueryDelegatorParams{}, sgrpc.InvalidArgument(err)
+	}
+	return keeper.QueryDelegatorParams{DelegatorAddr: delAddr}, nil
+}
@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+)
+
+func TestDelegationRewardsQuery(t *testing.T) {
+	cdc := codec.New()
+	delAddr := sdk.AccAddress([]byte("delegator___________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+	rewards := sdk.DecCoins{{Denom: "steak", Amount: sdk.NewDecWithPrec(15, 1)}}
+
+	s := queryServer{cdc: cdc, querier: func(path string, data []byte, height int64) ([]byte, int64, error) {
+		require.Equal(t, "custom/distr/"+keeper.QueryDelegationRewards, path)
+		var params keeper.QueryDelegationRewardsParams
+		require.NoError(t, cdc.UnmarshalJSON(data, &params))
+		require.Equal(t, delAddr, params.DelegatorAddr)
+		require.Equal(t, valAddr, params.ValidatorAddr)
+		return cdc.MustMarshalJSON(rewards), 1, nil
+	}}
+
+	res, err := s.DelegationRewards(context.Background(), &DelegationRewardsRequest{
+		DelegatorAddr: delAddr.String(),
+		ValidatorAddr: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Rewards, 1)
+	require.Equal(t, "steak", res.Rewards[0].Denom)
+	require.Equal(t, "1.5000000000", res.Rewards[0].Amount)
+
+	// malformed addresses are rejected before querying
+	_, err = s.DelegationRewards(context.Background(), &DelegationRewardsRequest{
+		DelegatorAddr: delAddr.String(),
+		ValidatorAddr: delAddr.String(),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
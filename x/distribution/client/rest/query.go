@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/gorilla/mux"
+)
+
+const queryRoute = "distr"
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+
+	// Get the total pending rewards of a delegator
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards",
+		delegatorRewardsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the pending rewards of a delegation
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}",
+		delegationRewardsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the address the rewards of a delegator are withdrawn to
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/withdraw_address",
+		delegatorHandlerFn(cliCtx, cdc, distr.QueryWithdrawAddr),
+	).Methods("GET")
+
+	// Get whether the rewards of a delegator are compounded automatically
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		delegatorHandlerFn(cliCtx, cdc, distr.QueryAutoCompound),
+	).Methods("GET")
+
+	// Get the commission a validator can withdraw
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/commission",
+		validatorHandlerFn(cliCtx, cdc, distr.QueryValidatorCommission),
+	).Methods("GET")
+
+	// Get the rewards of a validator not yet withdrawn by its delegators
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/outstanding_rewards",
+		validatorHandlerFn(cliCtx, cdc, distr.QueryValidatorOutstandingRewards),
+	).Methods("GET")
+
+	// Get the amount held in the community pool
+	r.HandleFunc(
+		"/distribution/community_pool",
+		queryHandlerFn(cliCtx, distr.QueryCommunityPool),
+	).Methods("GET")
+
+	// Get the global fee pool
+	r.HandleFunc(
+		"/distribution/fee_pool",
+		queryHandlerFn(cliCtx, distr.QueryFeePool),
+	).Methods("GET")
+
+	// Get the current distribution parameter values
+	r.HandleFunc(
+		"/distribution/parameters",
+		queryHandlerFn(cliCtx, distr.QueryParams),
+	).Methods("GET")
+}
+
+// HTTP request handler to query the total rewards of a delegator
+func delegatorRewardsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distr.QueryDelegatorParams{DelegatorAddr: delegatorAddr}
+		queryWithParams(w, cliCtx, cdc, distr.QueryDelegatorTotalRewards, params)
+	}
+}
+
+// HTTP request handler to query the rewards of a delegation
+func delegationRewardsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(vars["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distr.QueryDelegationRewardsParams{
+			DelegatorAddr: delegatorAddr,
+			ValidatorAddr: validatorAddr,
+		}
+		queryWithParams(w, cliCtx, cdc, distr.QueryDelegationRewards, params)
+	}
+}
+
+// HTTP request handler to query the withdraw settings of a delegator
+func delegatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distr.QueryDelegatorParams{DelegatorAddr: delegatorAddr}
+		queryWithParams(w, cliCtx, cdc, endpoint, params)
+	}
+}
+
+// HTTP request handler to query the distribution info of a validator
+func validatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distr.QueryValidatorParams{ValidatorAddr: validatorAddr}
+		queryWithParams(w, cliCtx, cdc, endpoint, params)
+	}
+}
+
+// HTTP request handler for distribution queries without parameters
+func queryHandlerFn(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, endpoint), nil)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(res)
+	}
+}
+
+func queryWithParams(w http.ResponseWriter, cliCtx context.CLIContext, cdc *codec.Codec, endpoint string, params interface{}) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, endpoint), bz)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
@@ -0,0 +1,200 @@
+package rest
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/gorilla/mux"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keys.Keybase) {
+
+	// Withdraw the rewards of all the delegations of a delegator
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards",
+		withdrawDelegatorRewardsHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+
+	// Withdraw the rewards of a delegation
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}",
+		withdrawDelegationRewardsHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+
+	// Change the address the rewards of a delegator are withdrawn to
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/withdraw_address",
+		setWithdrawAddressHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+
+	// Enable or disable the auto-compounding of the rewards of a delegator
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		setAutoCompoundHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+
+	// Withdraw the commission and self-delegation rewards of a validator
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/rewards",
+		withdrawValidatorRewardsHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+}
+
+type (
+	// WithdrawRewardsReq is the body of the withdraw requests
+	WithdrawRewardsReq struct {
+		BaseReq utils.BaseReq `json:"base_req"`
+	}
+
+	// SetWithdrawAddressReq is the body of the set withdraw address request
+	SetWithdrawAddressReq struct {
+		BaseReq      utils.BaseReq `json:"base_req"`
+		WithdrawAddr string        `json:"withdraw_address"` // in bech32
+	}
+
+	// SetAutoCompoundReq is the body of the set auto-compound request
+	SetAutoCompoundReq struct {
+		BaseReq      utils.BaseReq `json:"base_req"`
+		AutoCompound bool          `json:"auto_compound"`
+	}
+)
+
+func withdrawDelegatorRewardsHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req WithdrawRewardsReq
+		baseReq, delAddr, ok := readDelegatorReq(w, r, cdc, kb, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := distr.NewMsgWithdrawDelegatorRewardsAll(delAddr)
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+func withdrawDelegationRewardsHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req WithdrawRewardsReq
+		baseReq, delAddr, ok := readDelegatorReq(w, r, cdc, kb, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := distr.NewMsgWithdrawDelegationReward(delAddr, valAddr)
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+func setWithdrawAddressHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetWithdrawAddressReq
+		baseReq, delAddr, ok := readDelegatorReq(w, r, cdc, kb, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		withdrawAddr, err := sdk.AccAddressFromBech32(req.WithdrawAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := distr.NewMsgSetWithdrawAddress(delAddr, withdrawAddr)
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+func setAutoCompoundHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetAutoCompoundReq
+		baseReq, delAddr, ok := readDelegatorReq(w, r, cdc, kb, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := distr.NewMsgSetAutoCompound(delAddr, req.AutoCompound)
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+func withdrawValidatorRewardsHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req WithdrawRewardsReq
+		if err := utils.ReadRESTReq(w, r, cdc, &req); err != nil {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		info, err := kb.Get(baseReq.Name)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		if !bytes.Equal(info.GetPubKey().Address(), valAddr) {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, "must use own validator address")
+			return
+		}
+
+		msg := distr.NewMsgWithdrawValidatorRewardsAll(valAddr)
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+// readDelegatorReq reads the body of a request made on behalf of the delegator
+// in the path, and checks that the given key belongs to the delegator. It
+// writes the error response and returns false on failure.
+func readDelegatorReq(w http.ResponseWriter, r *http.Request, cdc *codec.Codec, kb keys.Keybase,
+	req interface{}, baseReq *utils.BaseReq) (utils.BaseReq, sdk.AccAddress, bool) {
+
+	if err := utils.ReadRESTReq(w, r, cdc, req); err != nil {
+		return utils.BaseReq{}, nil, false
+	}
+
+	sanitized := baseReq.Sanitize()
+	if !sanitized.ValidateBasic(w) {
+		return sanitized, nil, false
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return sanitized, nil, false
+	}
+
+	info, err := kb.Get(sanitized.Name)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+		return sanitized, nil, false
+	}
+
+	if !bytes.Equal(info.GetPubKey().Address(), delAddr) {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+		return sanitized, nil, false
+	}
+
+	return sanitized, delAddr, true
+}
@@ -1,6 +1,8 @@
 package distribution
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -11,28 +13,52 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetCommunityTax(ctx, data.CommunityTax)
 	keeper.SetBaseProposerReward(ctx, data.BaseProposerReward)
 	keeper.SetBonusProposerReward(ctx, data.BonusProposerReward)
+	keeper.SetAutoCompoundInterval(ctx, data.AutoCompoundInterval)
 
 	for _, vdi := range data.ValidatorDistInfos {
 		keeper.SetValidatorDistInfo(ctx, vdi)
 	}
+	for _, vhr := range data.ValidatorHistoricalRewards {
+		keeper.SetValidatorHistoricalRewards(ctx, vhr.ValidatorAddr, vhr.Period, vhr.Rewards)
+	}
+	for _, vse := range data.ValidatorSlashEvents {
+		keeper.SetValidatorSlashEvent(ctx, vse.ValidatorAddr, vse.Height, vse.Event)
+	}
 	for _, ddi := range data.DelegationDistInfos {
 		keeper.SetDelegationDistInfo(ctx, ddi)
 	}
 	for _, dw := range data.DelegatorWithdrawInfos {
-		keeper.SetDelegatorWithdrawAddr(ctx, dw.DelegatorAddr, dw.WithdrawAddr)
+		if !dw.WithdrawAddr.Equals(dw.DelegatorAddr) {
+			keeper.SetDelegatorWithdrawAddr(ctx, dw.DelegatorAddr, dw.WithdrawAddr)
+		}
+		keeper.SetDelegatorAutoCompound(ctx, dw.DelegatorAddr, dw.AutoCompound)
+	}
+}
+
+// ValidateGenesis validates the distribution genesis state, i.e. that the
+// auto-compound interval is not negative
+func ValidateGenesis(data types.GenesisState) error {
+	if data.AutoCompoundInterval < 0 {
+		return fmt.Errorf("distribution auto-compound interval must not be negative, is %d",
+			data.AutoCompoundInterval)
 	}
+	return nil
 }
 
 // WriteGenesis returns a GenesisState for a given context and keeper. The
-// GenesisState will contain the pool, and validator/delegator distribution info's
+// GenesisState will contain the pool, the validator/delegator distribution info's,
+// and the historical rewards and slash events of the validators
 func WriteGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	feePool := keeper.GetFeePool(ctx)
 	communityTax := keeper.GetCommunityTax(ctx)
 	baseProposerRewards := keeper.GetBaseProposerReward(ctx)
 	bonusProposerRewards := keeper.GetBonusProposerReward(ctx)
+	autoCompoundInterval := keeper.GetAutoCompoundInterval(ctx)
 	vdis := keeper.GetAllValidatorDistInfos(ctx)
+	vhrs := keeper.GetAllValidatorHistoricalRewards(ctx)
+	vses := keeper.GetAllValidatorSlashEvents(ctx)
 	ddis := keeper.GetAllDelegationDistInfos(ctx)
 	dwis := keeper.GetAllDelegatorWithdrawInfos(ctx)
 	return NewGenesisState(feePool, communityTax, baseProposerRewards,
-		bonusProposerRewards, vdis, ddis, dwis)
+		bonusProposerRewards, autoCompoundInterval, vdis, vhrs, vses, ddis, dwis)
 }
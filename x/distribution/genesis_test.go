@@ -0,0 +1,15 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	genesis := DefaultGenesisState()
+	genesis.AutoCompoundInterval = -1
+	require.Error(t, ValidateGenesis(genesis))
+}
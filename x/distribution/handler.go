@@ -19,6 +19,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 		case types.MsgWithdrawValidatorRewardsAll:
 			return handleMsgWithdrawValidatorRewardsAll(ctx, msg, k)
+		case types.MsgSetAutoCompound:
+			return handleMsgSetAutoCompound(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in distribution module").Result()
 		}
@@ -58,7 +60,10 @@ func handleMsgWithdrawDelegatorRewardsAll(ctx sdk.Context, msg types.MsgWithdraw
 
 func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg types.MsgWithdrawDelegatorReward, k keeper.Keeper) sdk.Result {
 
-	k.WithdrawDelegationReward(ctx, msg.DelegatorAddr, msg.ValidatorAddr)
+	err := k.WithdrawDelegationReward(ctx, msg.DelegatorAddr, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
 
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionWithdrawDelegatorReward,
@@ -72,7 +77,10 @@ func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg types.MsgWithdrawDele
 
 func handleMsgWithdrawValidatorRewardsAll(ctx sdk.Context, msg types.MsgWithdrawValidatorRewardsAll, k keeper.Keeper) sdk.Result {
 
-	k.WithdrawValidatorRewardsAll(ctx, msg.ValidatorAddr)
+	err := k.WithdrawValidatorRewardsAll(ctx, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
 
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionWithdrawValidatorRewardsAll,
@@ -82,3 +90,19 @@ func handleMsgWithdrawValidatorRewardsAll(ctx sdk.Context, msg types.MsgWithdraw
 		Tags: tags,
 	}
 }
+
+func handleMsgSetAutoCompound(ctx sdk.Context, msg types.MsgSetAutoCompound, k keeper.Keeper) sdk.Result {
+
+	err := k.UpdateDelegatorAutoCompound(ctx, msg.DelegatorAddr, msg.AutoCompound)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionSetAutoCompound,
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
@@ -1,50 +1,79 @@
 package keeper
 
 import (
-	"fmt"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-// Allocate fees handles distribution of the collected fees
-func (k Keeper) AllocateFees(ctx sdk.Context, percentVotes sdk.Dec, proposer sdk.ConsAddress) {
-	ctx.Logger().With("module", "x/distribution").Error(fmt.Sprintf("allocation height: %v", ctx.BlockHeight()))
-
-	// get the proposer of this block
-	proposerValidator := k.stakeKeeper.ValidatorByConsAddr(ctx, proposer)
-	proposerDist := k.GetValidatorDistInfo(ctx, proposerValidator.GetOperator())
+// Allocate fees handles distribution of the collected fees. The proposer of
+// the previous block receives its proposer reward, the community pool its
+// tax, and the remaining fees are allocated to the validators which voted in
+// the previous block, in proportion to their bonded tokens. The power of the
+// votes is not used as it is capped by MaxVotingPowerFraction.
+func (k Keeper) AllocateFees(ctx sdk.Context, percentVotes sdk.Dec, proposer sdk.ConsAddress,
+	votes []abci.VoteInfo) {
 
 	// get the fees which have been getting collected through all the
 	// transactions in the block
 	feesCollected := k.feeCollectionKeeper.GetCollectedFees(ctx)
-	feesCollectedDec := types.NewDecCoins(feesCollected)
+	feesCollectedDec := sdk.NewDecCoins(feesCollected)
+	remaining := feesCollectedDec
 
 	// allocated rewards to proposer
 	baseProposerReward := k.GetBaseProposerReward(ctx)
 	bonusProposerReward := k.GetBonusProposerReward(ctx)
 	proposerMultiplier := baseProposerReward.Add(bonusProposerReward.Mul(percentVotes))
-	proposerReward := feesCollectedDec.MulDec(proposerMultiplier)
-
-	// apply commission
-	commission := proposerReward.MulDec(proposerValidator.GetCommission())
-	remaining := proposerReward.Minus(commission)
-	proposerDist.PoolCommission = proposerDist.PoolCommission.Plus(commission)
-	proposerDist.Pool = proposerDist.Pool.Plus(remaining)
+	proposerValidator := k.stakeKeeper.ValidatorByConsAddr(ctx, proposer)
+	if proposerValidator != nil && k.HasValidatorDistInfo(ctx, proposerValidator.GetOperator()) {
+		proposerReward := feesCollectedDec.MulDecTruncate(proposerMultiplier)
+		k.allocateValidatorRewards(ctx, proposerValidator, proposerReward)
+		remaining = remaining.Minus(proposerReward)
+	}
 
-	// allocate community funding
+	// allocate the rewards of the voting validators
 	communityTax := k.GetCommunityTax(ctx)
-	communityFunding := feesCollectedDec.MulDec(communityTax)
-	feePool := k.GetFeePool(ctx)
-	feePool.CommunityPool = feePool.CommunityPool.Plus(communityFunding)
+	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(communityTax)
+	validators := make([]sdk.Validator, len(votes))
+	powers := make([]sdk.Dec, len(votes))
+	totalPower := sdk.ZeroDec()
+	for i, vote := range votes {
+		validators[i] = k.stakeKeeper.ValidatorByConsAddr(ctx, sdk.ConsAddress(vote.Validator.Address))
 
-	// set the global pool within the distribution module
-	poolReceived := feesCollectedDec.Minus(proposerReward).Minus(communityFunding)
-	feePool.Pool = feePool.Pool.Plus(poolReceived)
+		// the share of a validator which no longer exists goes to the
+		// community pool
+		if validators[i] == nil {
+			powers[i] = sdk.NewDec(vote.Validator.Power)
+		} else {
+			powers[i] = validators[i].GetPower()
+		}
+		totalPower = totalPower.Add(powers[i])
+	}
+	if totalPower.GT(sdk.ZeroDec()) {
+		for i, validator := range validators {
+			if validator == nil || !k.HasValidatorDistInfo(ctx, validator.GetOperator()) {
+				continue
+			}
+			powerFraction := powers[i].QuoTruncate(totalPower)
+			reward := feesCollectedDec.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
+			k.allocateValidatorRewards(ctx, validator, reward)
+			remaining = remaining.Minus(reward)
+		}
+	}
 
-	k.SetValidatorDistInfo(ctx, proposerDist)
+	// allocate community funding, including the rewards which could not be
+	// allocated to any validator
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Plus(remaining)
 	k.SetFeePool(ctx, feePool)
 
 	// clear the now distributed fees
 	k.feeCollectionKeeper.ClearCollectedFees(ctx)
 }
+
+// allocate rewards to a validator, charging its commission
+func (k Keeper) allocateValidatorRewards(ctx sdk.Context, validator sdk.Validator, rewards sdk.DecCoins) {
+	valInfo := k.GetValidatorDistInfo(ctx, validator.GetOperator())
+	valInfo = valInfo.AllocateRewards(rewards, validator.GetCommission())
+	k.SetValidatorDistInfo(ctx, valInfo)
+}
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func TestAllocateFeesBasic(t *testing.T) {
@@ -33,23 +35,24 @@ func TestAllocateFeesBasic(t *testing.T) {
 	bondedTokens := sk.TotalPower(ctx)
 	assert.True(sdk.DecEq(t, totalPowerDec, bondedTokens))
 
-	// initial fee pool should be empty
-	feePool := keeper.GetFeePool(ctx)
-	require.Nil(t, feePool.Pool)
+	// initial validator pool should be empty
+	valInfo := keeper.GetValidatorDistInfo(ctx, valOpAddr1)
+	require.Equal(t, uint64(1), valInfo.Period)
+	require.True(t, valInfo.Pool.IsZero())
 
 	// allocate 100 denom of fees
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
-
-	// verify that these fees have been received by the feePool
-	percentProposer := sdk.NewDecWithPrec(5, 2)
-	percentRemaining := sdk.OneDec().Sub(percentProposer)
-	feePool = keeper.GetFeePool(ctx)
-	expRes := sdk.NewDecFromInt(feeInputs).Mul(percentRemaining)
-	require.Equal(t, 1, len(feePool.Pool))
-	require.True(sdk.DecEq(t, expRes, feePool.Pool[0].Amount))
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+	require.True(t, fck.GetCollectedFees(ctx).IsZero())
+
+	// the only validator receives both the proposer and the voting rewards
+	valInfo = keeper.GetValidatorDistInfo(ctx, valOpAddr1)
+	require.True(sdk.DecEq(t, sdk.NewDecFromInt(feeInputs), valInfo.Pool.AmountOf(denom)))
+	require.True(sdk.DecEq(t, sdk.NewDecFromInt(feeInputs), valInfo.CurrentRewards.AmountOf(denom)))
+	require.True(t, valInfo.PoolCommission.IsZero())
+	require.True(t, keeper.GetFeePool(ctx).CommunityPool.IsZero())
 }
 
 func TestAllocateFeesWithCommunityTax(t *testing.T) {
@@ -58,9 +61,10 @@ func TestAllocateFeesWithCommunityTax(t *testing.T) {
 	stakeHandler := stake.NewHandler(sk)
 	denom := sk.GetParams(ctx).BondDenom
 
-	//first make a validator
+	//first make a validator with 10% commission
 	totalPower := int64(10)
-	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, totalPower)
+	msgCreateValidator := stake.NewTestMsgCreateValidatorWithCommission(
+		valOpAddr1, valConsPk1, totalPower, sdk.NewDecWithPrec(1, 1))
 	got := stakeHandler(ctx, msgCreateValidator)
 	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
 	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -68,16 +72,15 @@ func TestAllocateFeesWithCommunityTax(t *testing.T) {
 	// allocate 100 denom of fees
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
-	// verify that these fees have been received by the feePool
+	// verify that these fees have been received by the community pool and the validator
+	// 1% community tax, 10% commission on the remaining fees
 	feePool := keeper.GetFeePool(ctx)
-	// 5% goes to proposer, 1% community tax
-	percentProposer := sdk.NewDecWithPrec(5, 2)
-	percentRemaining := sdk.OneDec().Sub(communityTax.Add(percentProposer))
-	expRes := sdk.NewDecFromInt(feeInputs).Mul(percentRemaining)
-	require.Equal(t, 1, len(feePool.Pool))
-	require.True(sdk.DecEq(t, expRes, feePool.Pool[0].Amount))
+	require.True(sdk.DecEq(t, sdk.NewDec(1), feePool.CommunityPool.AmountOf(denom)))
+	valInfo := keeper.GetValidatorDistInfo(ctx, valOpAddr1)
+	require.True(sdk.DecEq(t, sdk.NewDecWithPrec(99, 1), valInfo.PoolCommission.AmountOf(denom)))
+	require.True(sdk.DecEq(t, sdk.NewDecWithPrec(891, 1), valInfo.Pool.AmountOf(denom)))
 }
 
 func TestAllocateFeesWithPartialPrecommitPower(t *testing.T) {
@@ -86,25 +89,98 @@ func TestAllocateFeesWithPartialPrecommitPower(t *testing.T) {
 	stakeHandler := stake.NewHandler(sk)
 	denom := sk.GetParams(ctx).BondDenom
 
-	//first make a validator
-	totalPower := int64(100)
-	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, totalPower)
+	// make two validators of equal power
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 50)
 	got := stakeHandler(ctx, msgCreateValidator)
 	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	msgCreateValidator = stake.NewTestMsgCreateValidator(valOpAddr2, valConsPk2, 50)
+	got = stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
 	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
 
-	// allocate 100 denom of fees
+	// allocate 100 denom of fees, only the proposer voted
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
-	percentPrecommitVotes := sdk.NewDecWithPrec(25, 2)
-	keeper.AllocateFees(ctx, percentPrecommitVotes, valConsAddr1)
+	percentPrecommitVotes := sdk.NewDecWithPrec(5, 1)
+	keeper.AllocateFees(ctx, percentPrecommitVotes, valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
-	// verify that these fees have been received by the feePool
-	feePool := keeper.GetFeePool(ctx)
-	// 1% + 4%*0.25 to proposer + 1% community tax = 97%
+	// 1% + 4%*0.5 to proposer + 1% community tax, the remaining 96% to the voters
 	percentProposer := sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(4, 2).Mul(percentPrecommitVotes))
-	percentRemaining := sdk.OneDec().Sub(communityTax.Add(percentProposer))
-	expRes := sdk.NewDecFromInt(feeInputs).Mul(percentRemaining)
-	require.Equal(t, 1, len(feePool.Pool))
-	require.True(sdk.DecEq(t, expRes, feePool.Pool[0].Amount))
+	percentVoters := sdk.OneDec().Sub(communityTax.Add(percentProposer))
+	expRes := sdk.NewDecFromInt(feeInputs).Mul(percentProposer.Add(percentVoters))
+	valInfo := keeper.GetValidatorDistInfo(ctx, valOpAddr1)
+	require.True(sdk.DecEq(t, expRes, valInfo.Pool.AmountOf(denom)))
+	require.True(t, keeper.GetValidatorDistInfo(ctx, valOpAddr2).Pool.IsZero())
+	require.True(sdk.DecEq(t, sdk.NewDec(1), keeper.GetFeePool(ctx).CommunityPool.AmountOf(denom)))
+}
+
+func TestAllocateFeesByVotingPower(t *testing.T) {
+	ctx, _, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	// make two validators, the second one has three times the power of the first
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 25)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	msgCreateValidator = stake.NewTestMsgCreateValidator(valOpAddr2, valConsPk2, 75)
+	got = stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// allocate 100 denom of fees, the first validator proposed
+	feeInputs := sdk.NewInt(100)
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1, valOpAddr2))
+
+	// 5% to the proposer, the remaining 95% split by voting power
+	valInfo1 := keeper.GetValidatorDistInfo(ctx, valOpAddr1)
+	valInfo2 := keeper.GetValidatorDistInfo(ctx, valOpAddr2)
+	require.True(sdk.DecEq(t, sdk.NewDecWithPrec(2875, 2), valInfo1.Pool.AmountOf(denom))) // 5 + 95/4
+	require.True(sdk.DecEq(t, sdk.NewDecWithPrec(7125, 2), valInfo2.Pool.AmountOf(denom))) // 95*3/4
+	require.True(t, keeper.GetFeePool(ctx).CommunityPool.IsZero())
+}
+
+func TestAllocateFeesCappedVotingPower(t *testing.T) {
+	ctx, _, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	// cap the voting power of a validator to half of the total
+	params := sk.GetParams(ctx)
+	params.MaxVotingPowerFraction = sdk.NewDecWithPrec(5, 1)
+	sk.SetParams(ctx, params)
+
+	// make two validators, the second one has three times the power of the first
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 25)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	msgCreateValidator = stake.NewTestMsgCreateValidator(valOpAddr2, valConsPk2, 75)
+	got = stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	updates := sk.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+
+	// the votes carry the capped power reported to Tendermint
+	votes := signedVotes(ctx, sk, valOpAddr1, valOpAddr2)
+	for i := range votes {
+		for _, update := range updates {
+			pk, err := tmtypes.PB2TM.PubKey(update.PubKey)
+			require.Nil(t, err)
+			if bytes.Equal(votes[i].Validator.Address, pk.Address()) {
+				votes[i].Validator.Power = update.Power
+			}
+		}
+	}
+	require.True(t, votes[1].Validator.Power < 75)
+
+	// the rewards are still split by bonded tokens
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(100))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, votes)
+
+	valInfo1 := keeper.GetValidatorDistInfo(ctx, valOpAddr1)
+	valInfo2 := keeper.GetValidatorDistInfo(ctx, valOpAddr2)
+	require.True(sdk.DecEq(t, sdk.NewDecWithPrec(2875, 2), valInfo1.Pool.AmountOf(denom))) // 5 + 95/4
+	require.True(sdk.DecEq(t, sdk.NewDecWithPrec(7125, 2), valInfo2.Pool.AmountOf(denom))) // 95*3/4
+	require.True(t, keeper.GetFeePool(ctx).CommunityPool.IsZero())
 }
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -27,6 +30,14 @@ func (k Keeper) SetDelegationDistInfo(ctx sdk.Context, ddi types.DelegationDistI
 	store.Set(GetDelegationDistInfoKey(ddi.DelegatorAddr, ddi.ValOperatorAddr), b)
 }
 
+// check if a delegator distribution info exists
+func (k Keeper) HasDelegationDistInfo(ctx sdk.Context, delAddr sdk.AccAddress,
+	valOperatorAddr sdk.ValAddress) bool {
+
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDelegationDistInfoKey(delAddr, valOperatorAddr))
+}
+
 // remove a delegator distribution info
 func (k Keeper) RemoveDelegationDistInfo(ctx sdk.Context, delAddr sdk.AccAddress,
 	valOperatorAddr sdk.ValAddress) {
@@ -60,71 +71,370 @@ func (k Keeper) RemoveDelegatorWithdrawAddr(ctx sdk.Context, delAddr, withdrawAd
 	store.Delete(GetDelegatorWithdrawAddrKey(delAddr))
 }
 
+// check if a delegator has enabled the auto-compounding of its rewards
+func (k Keeper) GetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDelegatorAutoCompoundKey(delAddr))
+}
+
+// enable or disable the auto-compounding of the rewards of a delegator
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, autoCompound bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !autoCompound {
+		store.Delete(GetDelegatorAutoCompoundKey(delAddr))
+		return
+	}
+	store.Set(GetDelegatorAutoCompoundKey(delAddr), []byte{0x01})
+}
+
+// enable or disable the auto-compounding of the rewards of a delegator on its
+// request, it can only be enabled by a delegator with delegations
+func (k Keeper) UpdateDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, autoCompound bool) sdk.Error {
+	if autoCompound && !k.hasDelegations(ctx, delAddr) {
+		return types.ErrNoDelegation(k.codespace)
+	}
+	k.SetDelegatorAutoCompound(ctx, delAddr, autoCompound)
+	return nil
+}
+
+// iterate over the delegators which have enabled auto-compounding
+func (k Keeper) IterateDelegatorsAutoCompound(ctx sdk.Context, fn func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DelegatorAutoCompoundKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr := sdk.AccAddress(iterator.Key()[len(DelegatorAutoCompoundKey):])
+		if fn(delAddr) {
+			break
+		}
+	}
+}
+
 //___________________________________________________________________________________________
 
-// withdraw all the rewards for a single delegation
-func (k Keeper) WithdrawDelegationReward(ctx sdk.Context, delegatorAddr sdk.AccAddress,
-	validatorAddr sdk.ValAddress) {
+// check if a round of auto-compounding started in a previous block is not over
+func (k Keeper) IsAutoCompoundInProgress(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(AutoCompoundCursorKey)
+}
 
-	height := ctx.BlockHeight()
-	bondedTokens := k.stakeKeeper.TotalPower(ctx)
-	feePool := k.GetFeePool(ctx)
-	delInfo := k.GetDelegationDistInfo(ctx, delegatorAddr, validatorAddr)
-	valInfo := k.GetValidatorDistInfo(ctx, validatorAddr)
-	validator := k.stakeKeeper.Validator(ctx, validatorAddr)
-	delegation := k.stakeKeeper.Delegation(ctx, delegatorAddr, validatorAddr)
+//___________________________________________________________________________________________
+
+// check if a delegator has any delegation
+func (k Keeper) hasDelegations(ctx sdk.Context, delAddr sdk.AccAddress) (found bool) {
+	k.stakeKeeper.IterateDelegations(ctx, delAddr, func(_ int64, _ sdk.Delegation) (stop bool) {
+		found = true
+		return true
+	})
+	return found
+}
+
+// initialize the distribution info of a delegation which was just created or
+// modified, starting from the last period ended by its validator
+func (k Keeper) initializeDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	startingPeriod := k.GetValidatorDistInfo(ctx, valAddr).Period - 1
+	k.incrementReferenceCount(ctx, valAddr, startingPeriod)
+
+	stake := k.delegationStake(ctx, delAddr, valAddr)
+	k.SetDelegationDistInfo(ctx, types.NewDelegationDistInfo(delAddr, valAddr,
+		startingPeriod, ctx.BlockHeight(), stake))
+}
+
+// the validator tokens currently held by a delegation
+func (k Keeper) delegationStake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Dec {
+	validator := k.stakeKeeper.Validator(ctx, valAddr)
+	delegation := k.stakeKeeper.Delegation(ctx, delAddr, valAddr)
+	if validator.GetDelegatorShares().IsZero() {
+		return sdk.ZeroDec()
+	}
+	return delegation.GetShares().MulTruncate(validator.GetTokens()).QuoTruncate(validator.GetDelegatorShares())
+}
+
+// StakeOvershootTolerance is the fraction of its current stake by which the
+// stake of a delegation computed from the slashes of its validator may exceed
+// its current stake. The slash fractions are rounded up, but the current
+// stake is truncated and the tokens issued and removed for the shares of the
+// other delegations of the validator are rounded, which drifts the tokens per
+// share a little with every delegation change.
+var StakeOvershootTolerance = sdk.NewDecWithPrec(1, 6)
+
+// StakeOvershootExceeded returns whether the stake of a delegation computed
+// from the slashes of its validator exceeds its current stake by more than
+// the rounding can explain, which points to a slash that was not recorded
+func StakeOvershootExceeded(stake, currentStake sdk.Dec) bool {
+	margin := currentStake.Mul(StakeOvershootTolerance).Add(sdk.NewDecWithPrec(3, sdk.Precision))
+	return stake.GT(currentStake.Add(margin))
+}
+
+// RecomputeDelegationStake returns the stake of a delegation computed from
+// its stake when it started and the slashes of its validator since then,
+// along with the current stake of the delegation
+func (k Keeper) RecomputeDelegationStake(ctx sdk.Context,
+	delInfo types.DelegationDistInfo) (stake, currentStake sdk.Dec) {
+
+	stake = delInfo.Stake
+	k.IterateValidatorSlashEventsSince(ctx, delInfo.ValOperatorAddr, delInfo.StartingHeight,
+		func(_ int64, event types.ValidatorSlashEvent) (stop bool) {
+			if event.ValidatorPeriod > delInfo.StartingPeriod {
+				stake = stake.MulTruncate(sdk.OneDec().Sub(event.Fraction))
+			}
+			return false
+		})
+	return stake, k.delegationStake(ctx, delInfo.DelegatorAddr, delInfo.ValOperatorAddr)
+}
+
+// calculate the rewards earned by a delegation until the end of a period of
+// its validator. The stake of the delegation is reduced by each slash of the
+// validator since the delegation started, so the rewards are accumulated
+// between the periods ended by these slashes.
+func (k Keeper) calculateDelegationRewards(ctx sdk.Context, delInfo types.DelegationDistInfo,
+	endingPeriod uint64) sdk.DecCoins {
+
+	valAddr := delInfo.ValOperatorAddr
+	startingPeriod := delInfo.StartingPeriod
+	stake := delInfo.Stake
+	rewards := sdk.DecCoins{}
+
+	k.IterateValidatorSlashEventsSince(ctx, valAddr, delInfo.StartingHeight,
+		func(_ int64, event types.ValidatorSlashEvent) (stop bool) {
+			if event.ValidatorPeriod > startingPeriod {
+				rewards = rewards.Plus(k.rewardsBetween(ctx, valAddr, startingPeriod, event.ValidatorPeriod, stake))
+				stake = stake.MulTruncate(sdk.OneDec().Sub(event.Fraction))
+				startingPeriod = event.ValidatorPeriod
+			}
+			return false
+		})
+
+	// the stake computed from the slashes can exceed the current stake of the
+	// delegation by rounding, the rewards are never paid on more than the
+	// current stake
+	currentStake := k.delegationStake(ctx, delInfo.DelegatorAddr, valAddr)
+	if stake.GT(currentStake) {
+		if StakeOvershootExceeded(stake, currentStake) {
+			ctx.Logger().With("module", "x/distribution").Error(fmt.Sprintf(
+				"calculated stake %v of delegation %s to %s is greater than its current stake %v",
+				stake, delInfo.DelegatorAddr, valAddr, currentStake))
+		}
+		stake = currentStake
+	}
+
+	return rewards.Plus(k.rewardsBetween(ctx, valAddr, startingPeriod, endingPeriod, stake))
+}
+
+// the rewards earned by a stake between the ends of two periods of a validator
+func (k Keeper) rewardsBetween(ctx sdk.Context, valAddr sdk.ValAddress,
+	startingPeriod, endingPeriod uint64, stake sdk.Dec) sdk.DecCoins {
+
+	starting := k.GetValidatorHistoricalRewards(ctx, valAddr, startingPeriod)
+	ending := k.GetValidatorHistoricalRewards(ctx, valAddr, endingPeriod)
+	return types.RewardsBetween(starting.CumulativeRewardRatio, ending.CumulativeRewardRatio, stake)
+}
+
+// update the distribution state for the withdrawal of the rewards of a single
+// delegation and return the withdrawn rewards, without paying them out. The
+// distribution info of the delegation is removed, it must be initialized again
+// if the delegation remains.
+func (k Keeper) takeDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress) sdk.DecCoins {
+
+	delInfo := k.GetDelegationDistInfo(ctx, delAddr, valAddr)
+	k.RemoveDelegationDistInfo(ctx, delAddr, valAddr)
+
+	// the rewards of the delegations of a removed validator were moved to the
+	// community pool
+	if !k.HasValidatorDistInfo(ctx, valAddr) {
+		return sdk.DecCoins{}
+	}
 
-	delInfo, valInfo, feePool, withdraw := delInfo.WithdrawRewards(feePool, valInfo, height, bondedTokens,
-		validator.GetTokens(), validator.GetDelegatorShares(), delegation.GetShares(), validator.GetCommission())
+	endingPeriod := k.incrementValidatorPeriod(ctx, valAddr)
+	rewards := k.calculateDelegationRewards(ctx, delInfo, endingPeriod)
+	k.decrementReferenceCount(ctx, valAddr, delInfo.StartingPeriod)
 
-	k.SetFeePool(ctx, feePool)
+	valInfo := k.GetValidatorDistInfo(ctx, valAddr)
+	valInfo.Pool = valInfo.Pool.Minus(rewards)
 	k.SetValidatorDistInfo(ctx, valInfo)
-	k.SetDelegationDistInfo(ctx, delInfo)
-	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delegatorAddr)
-	_, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, withdraw.TruncateDecimal())
+	return rewards
+}
+
+// update the distribution state for the withdrawal of the rewards of a single
+// delegation and return the withdrawn rewards, without paying them out
+func (k Keeper) withdrawDelegationReward(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress) sdk.DecCoins {
+
+	rewards := k.takeDelegationRewards(ctx, delAddr, valAddr)
+	if k.HasValidatorDistInfo(ctx, valAddr) {
+		k.initializeDelegation(ctx, delAddr, valAddr)
+	}
+	return rewards
+}
+
+// withdraw the rewards of a single delegation like withdrawDelegationReward,
+// but if the delegator enabled auto-compounding the rewards in the bond denom
+// are delegated again to the validator instead of being returned
+func (k Keeper) compoundDelegationReward(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress) sdk.DecCoins {
+
+	if !k.GetDelegatorAutoCompound(ctx, delAddr) {
+		return k.withdrawDelegationReward(ctx, delAddr, valAddr)
+	}
+
+	rewards := k.takeDelegationRewards(ctx, delAddr, valAddr)
+	if !k.HasValidatorDistInfo(ctx, valAddr) {
+		return rewards
+	}
+
+	validator, found := k.stakeKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		panic("validator with distribution info should exist")
+	}
+	bondDenom := k.stakeKeeper.BondDenom(ctx)
+	amount := rewards.AmountOf(bondDenom).TruncateInt()
+
+	// only the operator can delegate to a jailed validator, and a validator
+	// slashed down to zero tokens cannot issue shares
+	canDelegate := !validator.Jailed || bytes.Equal(validator.OperatorAddr, delAddr)
+	if !amount.GT(sdk.ZeroInt()) || !canDelegate || !validator.Tokens.GT(sdk.ZeroDec()) {
+		k.initializeDelegation(ctx, delAddr, valAddr)
+		return rewards
+	}
+
+	// the distribution info of the delegation is initialized again by the
+	// staking hooks. The delegated tokens are taken from the rewards instead
+	// of the delegator account.
+	bondAmt := sdk.NewCoin(bondDenom, amount)
+	_, err := k.stakeKeeper.Delegate(ctx, delAddr, bondAmt, validator, false)
 	if err != nil {
 		panic(err)
 	}
+	return rewards.Minus(sdk.NewDecCoins(sdk.Coins{bondAmt}))
 }
 
-//___________________________________________________________________________________________
+// pay out rewards to the withdraw address of a delegator, the decimals of the
+// rewards which cannot be paid out are added to the community pool
+func (k Keeper) payout(ctx sdk.Context, delAddr sdk.AccAddress, rewards sdk.DecCoins) {
+	coins := sdk.Coins{}
+	for _, coin := range rewards.TruncateDecimal() {
+		if !coin.IsZero() {
+			coins = append(coins, coin)
+		}
+	}
 
-// return all rewards for all delegations of a delegator
-func (k Keeper) WithdrawDelegationRewardsAll(ctx sdk.Context, delegatorAddr sdk.AccAddress) {
-	height := ctx.BlockHeight()
-	withdraw := k.getDelegatorRewardsAll(ctx, delegatorAddr, height)
-	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delegatorAddr)
-	_, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, withdraw.TruncateDecimal())
+	remainder := rewards.Minus(sdk.NewDecCoins(coins))
+	if !remainder.IsZero() {
+		feePool := k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Plus(remainder)
+		k.SetFeePool(ctx, feePool)
+	}
+
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	_, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coins)
 	if err != nil {
 		panic(err)
 	}
 }
 
+//___________________________________________________________________________________________
+
+// withdraw all the rewards for a single delegation
+func (k Keeper) WithdrawDelegationReward(ctx sdk.Context, delegatorAddr sdk.AccAddress,
+	validatorAddr sdk.ValAddress) sdk.Error {
+
+	if !k.HasDelegationDistInfo(ctx, delegatorAddr, validatorAddr) {
+		return types.ErrNoDelegationDistInfo(k.codespace)
+	}
+
+	withdraw := k.compoundDelegationReward(ctx, delegatorAddr, validatorAddr)
+	k.payout(ctx, delegatorAddr, withdraw)
+	return nil
+}
+
 // return all rewards for all delegations of a delegator
-func (k Keeper) getDelegatorRewardsAll(ctx sdk.Context, delAddr sdk.AccAddress, height int64) types.DecCoins {
+func (k Keeper) WithdrawDelegationRewardsAll(ctx sdk.Context, delegatorAddr sdk.AccAddress) {
+	withdraw := k.compoundDelegationRewardsAll(ctx, delegatorAddr)
+	k.payout(ctx, delegatorAddr, withdraw)
+}
+
+// withdraw the rewards of the next batch of delegators which enabled
+// auto-compounding, delegating again their rewards in the bond denom. A round
+// over all these delegators starts from the first one unless a previous round
+// is in progress, and continues in the next blocks until it is over. The
+// delegators which no longer have any delegation are opted out.
+func (k Keeper) CompoundDelegationRewards(ctx sdk.Context) {
+	k.compoundDelegationRewardsBatch(ctx, AutoCompoundBatchSize)
+}
+
+func (k Keeper) compoundDelegationRewardsBatch(ctx sdk.Context, batchSize int) {
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(AutoCompoundCursorKey)
+	if start == nil {
+		start = DelegatorAutoCompoundKey
+	}
+
+	// the delegators are collected first as compounding modifies the store
+	var delAddrs []sdk.AccAddress
+	var next []byte
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(DelegatorAutoCompoundKey))
+	for ; iterator.Valid(); iterator.Next() {
+		if len(delAddrs) == batchSize {
+			next = append([]byte{}, iterator.Key()...)
+			break
+		}
+		delAddrs = append(delAddrs, sdk.AccAddress(iterator.Key()[len(DelegatorAutoCompoundKey):]))
+	}
+	iterator.Close()
 
-	withdraw := types.DecCoins{}
-	bondedTokens := k.stakeKeeper.TotalPower(ctx)
-	feePool := k.GetFeePool(ctx)
+	if next == nil {
+		store.Delete(AutoCompoundCursorKey)
+	} else {
+		store.Set(AutoCompoundCursorKey, next)
+	}
+
+	for _, delAddr := range delAddrs {
+		if !k.hasDelegations(ctx, delAddr) {
+			k.SetDelegatorAutoCompound(ctx, delAddr, false)
+			continue
+		}
+		k.WithdrawDelegationRewardsAll(ctx, delAddr)
+	}
+}
+
+// update the distribution state for the withdrawal of the rewards of all the
+// delegations of a delegator like withdrawDelegationRewardsAll, but delegating
+// again the rewards in the bond denom if the delegator enabled
+// auto-compounding, and return the withdrawn rewards without paying them out
+func (k Keeper) compoundDelegationRewardsAll(ctx sdk.Context, delAddr sdk.AccAddress) sdk.DecCoins {
+	withdraw := sdk.DecCoins{}
+
+	// the delegations are collected first as compounding modifies them
+	var valAddrs []sdk.ValAddress
+	k.stakeKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del sdk.Delegation) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidator())
+		return false
+	})
+	for _, valAddr := range valAddrs {
+		if k.HasDelegationDistInfo(ctx, delAddr, valAddr) {
+			withdraw = withdraw.Plus(k.compoundDelegationReward(ctx, delAddr, valAddr))
+		}
+	}
+	return withdraw
+}
+
+// update the distribution state for the withdrawal of the rewards of all the
+// delegations of a delegator and return the withdrawn rewards, without paying
+// them out
+func (k Keeper) withdrawDelegationRewardsAll(ctx sdk.Context, delAddr sdk.AccAddress) sdk.DecCoins {
+	withdraw := sdk.DecCoins{}
 
 	// iterate over all the delegations
 	operationAtDelegation := func(_ int64, del sdk.Delegation) (stop bool) {
 		valAddr := del.GetValidator()
-		delInfo := k.GetDelegationDistInfo(ctx, delAddr, valAddr)
-		valInfo := k.GetValidatorDistInfo(ctx, valAddr)
-		validator := k.stakeKeeper.Validator(ctx, valAddr)
-		delegation := k.stakeKeeper.Delegation(ctx, delAddr, valAddr)
-
-		delInfo, valInfo, feePool, diWithdraw := delInfo.WithdrawRewards(feePool, valInfo, height, bondedTokens,
-			validator.GetTokens(), validator.GetDelegatorShares(), delegation.GetShares(), validator.GetCommission())
-		withdraw = withdraw.Plus(diWithdraw)
-		k.SetFeePool(ctx, feePool)
-		k.SetValidatorDistInfo(ctx, valInfo)
-		k.SetDelegationDistInfo(ctx, delInfo)
+		if k.HasDelegationDistInfo(ctx, delAddr, valAddr) {
+			withdraw = withdraw.Plus(k.withdrawDelegationReward(ctx, delAddr, valAddr))
+		}
 		return false
 	}
 	k.stakeKeeper.IterateDelegations(ctx, delAddr, operationAtDelegation)
 
-	k.SetFeePool(ctx, feePool)
 	return withdraw
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/stretchr/testify/require"
 )
@@ -30,7 +31,7 @@ func TestWithdrawDelegationRewardBasic(t *testing.T) {
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
 	// withdraw delegation
 	ctx = ctx.WithBlockHeight(1)
@@ -64,7 +65,7 @@ func TestWithdrawDelegationRewardWithCommission(t *testing.T) {
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
 	// withdraw delegation
 	ctx = ctx.WithBlockHeight(1)
@@ -104,7 +105,7 @@ func TestWithdrawDelegationRewardTwoDelegators(t *testing.T) {
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
 	// delegator 1 withdraw delegation
 	ctx = ctx.WithBlockHeight(1)
@@ -115,9 +116,9 @@ func TestWithdrawDelegationRewardTwoDelegators(t *testing.T) {
 	require.True(sdk.IntEq(t, expRes, amt))
 }
 
-// this test demonstrates how two delegators with the same power can end up
-// with different rewards in the end
-func TestWithdrawDelegationRewardTwoDelegatorsUneven(t *testing.T) {
+// this test demonstrates how two delegators with the same stake end up with
+// the same rewards, regardless of when they withdraw them
+func TestWithdrawDelegationRewardTwoDelegatorsEven(t *testing.T) {
 	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
 	stakeHandler := stake.NewHandler(sk)
 	denom := sk.GetParams(ctx).BondDenom
@@ -142,45 +143,165 @@ func TestWithdrawDelegationRewardTwoDelegatorsUneven(t *testing.T) {
 	amt = accMapper.GetAccount(ctx, delAddr2).GetCoins().AmountOf(denom)
 	require.Equal(t, int64(90), amt.Int64())
 
-	// allocate 100 denom of fees
+	// allocate 90 denom of fees
 	feeInputs := sdk.NewInt(90)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 	ctx = ctx.WithBlockHeight(1)
 
-	// delegator 1 withdraw delegation early, delegator 2 just keeps it's accum
+	// delegator 1 withdraw delegation early, delegator 2 keeps its rewards
 	keeper.WithdrawDelegationReward(ctx, delAddr1, valOpAddr1)
 	amt = accMapper.GetAccount(ctx, delAddr1).GetCoins().AmountOf(denom)
+	require.Equal(t, int64(90+30), amt.Int64()) // 90 + 90 * 10/30
 
-	expRes1 := sdk.NewDec(90).Add(sdk.NewDec(90).Quo(sdk.NewDec(3))).TruncateInt() // 90 + 100 * 10/30
-	require.True(sdk.IntEq(t, expRes1, amt))
-
-	// allocate 200 denom of fees
+	// allocate 180 denom of fees
 	feeInputs = sdk.NewInt(180)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 	ctx = ctx.WithBlockHeight(2)
 
 	// delegator 2 now withdraws everything it's entitled to
 	keeper.WithdrawDelegationReward(ctx, delAddr2, valOpAddr1)
 	amt = accMapper.GetAccount(ctx, delAddr2).GetCoins().AmountOf(denom)
-	// existingTokens + (100+200 * (10/(20+30))
-	withdrawnFromVal := sdk.NewDec(60 + 180).Mul(sdk.NewDec(2)).Quo(sdk.NewDec(5))
-	expRes2 := sdk.NewDec(90).Add(withdrawnFromVal).TruncateInt()
-	require.True(sdk.IntEq(t, expRes2, amt))
+	require.Equal(t, int64(90+30+60), amt.Int64()) // 90 + (90+180) * 10/30
 
 	// finally delegator 1 withdraws the remainder of its reward
 	keeper.WithdrawDelegationReward(ctx, delAddr1, valOpAddr1)
 	amt = accMapper.GetAccount(ctx, delAddr1).GetCoins().AmountOf(denom)
+	require.Equal(t, int64(90+30+60), amt.Int64()) // 120 + 180 * 10/30
+
+	// only the rewards of the validator's self-delegation remain
+	valInfo := keeper.GetValidatorDistInfo(ctx, valOpAddr1)
+	require.True(sdk.DecEq(t, sdk.NewDec(90), valInfo.Pool.AmountOf(denom)))
+}
+
+func TestWithdrawDelegationRewardAfterSlash(t *testing.T) {
+	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	//first make a validator with no commission
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// delegate
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+
+	// allocate 100 denom of fees
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(100))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+	// slash half of the validator's tokens, which ends its period
+	ctx = ctx.WithBlockHeight(1)
+	period := keeper.GetValidatorDistInfo(ctx, valOpAddr1).Period
+	sk.Slash(ctx, valConsAddr1, 1, 20, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, period+1, keeper.GetValidatorDistInfo(ctx, valOpAddr1).Period)
+	require.Equal(t, uint64(2), keeper.GetValidatorHistoricalRewards(ctx, valOpAddr1, period).ReferenceCount)
+
+	// allocate 100 denom of fees to the slashed validator
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(100))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+	// the delegation earned half of the rewards before and after the slash
+	ctx = ctx.WithBlockHeight(2)
+	keeper.WithdrawDelegationReward(ctx, delAddr1, valOpAddr1)
+	amt := accMapper.GetAccount(ctx, delAddr1).GetCoins().AmountOf(denom)
+	require.Equal(t, int64(90+50+50), amt.Int64())
+
+	// the delegation now starts with its slashed stake
+	delInfo := keeper.GetDelegationDistInfo(ctx, delAddr1, valOpAddr1)
+	require.True(sdk.DecEq(t, sdk.NewDec(5), delInfo.Stake))
+	require.Equal(t, int64(2), delInfo.StartingHeight)
+}
+
+func TestDelegationStakeOvershoot(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+
+	//first make a validator with no commission
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
 
-	remainingInVal := sdk.NewDec(60 + 180).Sub(withdrawnFromVal)
-	expRes3 := sdk.NewDecFromInt(expRes1).Add(remainingInVal.Mul(sdk.NewDec(1)).Quo(sdk.NewDec(3))).TruncateInt()
-	require.True(sdk.IntEq(t, expRes3, amt))
+	// delegate
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+
+	delInfo := keeper.GetDelegationDistInfo(ctx, delAddr1, valOpAddr1)
+	computedStake, currentStake := keeper.RecomputeDelegationStake(ctx, delInfo)
+	require.True(sdk.DecEq(t, sdk.NewDec(10), computedStake))
+	require.True(sdk.DecEq(t, sdk.NewDec(10), currentStake))
+	require.False(t, StakeOvershootExceeded(computedStake, currentStake))
+
+	// a stake above the current stake is clamped to it, even beyond rounding
+	endingPeriod := keeper.GetValidatorDistInfo(ctx, valOpAddr1).Period - 1
+	expRewards := keeper.calculateDelegationRewards(ctx, delInfo, endingPeriod)
+	delInfo.Stake = currentStake.Add(sdk.NewDec(1))
+	require.True(t, StakeOvershootExceeded(delInfo.Stake, currentStake))
+	require.NotPanics(t, func() {
+		require.Equal(t, expRewards, keeper.calculateDelegationRewards(ctx, delInfo, endingPeriod))
+	})
+}
 
-	// verify the final withdraw amounts are different
-	require.True(t, expRes2.GT(expRes3))
+func TestDelegationStakeManyDelegations(t *testing.T) {
+	ctx, _, keeper, sk, fck := CreateTestInputAdvanced(t, false, 1000, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	//first make a validator with no commission
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 7)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+
+	// slash a third of the validator tokens so that the tokens per share
+	// cannot be represented exactly
+	ctx = ctx.WithBlockHeight(1)
+	sk.Slash(ctx, valConsAddr1, 1, 17, sdk.OneDec().Quo(sdk.NewDec(3)))
+
+	// other delegators come and go with uneven amounts
+	for i := int64(0); i < 20; i++ {
+		ctx = ctx.WithBlockHeight(2 + i)
+		delAddr := delAddr2
+		if i%2 == 1 {
+			delAddr = delAddr3
+		}
+
+		msgDelegate := stake.NewTestMsgDelegate(delAddr, valOpAddr1, 3+i%7)
+		got = stakeHandler(ctx, msgDelegate)
+		require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+
+		fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(11))})
+		keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+		delegation, found := sk.GetDelegation(ctx, delAddr, valOpAddr1)
+		require.True(t, found)
+		msgUnbond := stake.NewMsgBeginUnbonding(delAddr, valOpAddr1, delegation.Shares.Quo(sdk.NewDec(3)))
+		got = stakeHandler(ctx, msgUnbond)
+		require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+
+		delInfo := keeper.GetDelegationDistInfo(ctx, delAddr1, valOpAddr1)
+		computedStake, currentStake := keeper.RecomputeDelegationStake(ctx, delInfo)
+		require.False(t, StakeOvershootExceeded(computedStake, currentStake),
+			"computed stake %v, current stake %v", computedStake, currentStake)
+	}
+
+	// the first delegator can still withdraw its rewards
+	require.NotPanics(t, func() {
+		require.Nil(t, keeper.WithdrawDelegationReward(ctx, delAddr1, valOpAddr1))
+	})
 }
 
 func TestWithdrawDelegationRewardsAll(t *testing.T) {
@@ -228,7 +349,7 @@ func TestWithdrawDelegationRewardsAll(t *testing.T) {
 	feeInputs := sdk.NewInt(1000)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1, valOpAddr2, valOpAddr3))
 
 	// withdraw delegation
 	ctx = ctx.WithBlockHeight(1)
@@ -248,3 +369,175 @@ func TestWithdrawDelegationRewardsAll(t *testing.T) {
 	expRes := sdk.NewDec(40).Add(feesInVal1).Add(feesInVal2).Add(feesInVal3).Add(feesInVal1Proposer).TruncateInt()
 	require.True(sdk.IntEq(t, expRes, amt))
 }
+
+func TestTransferDelegationWithdrawsReward(t *testing.T) {
+	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	//first make a validator
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// delegate
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+
+	// allocate 100 denom of fees
+	feeInputs := sdk.NewInt(100)
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+	// transfer the whole delegation, its rewards are withdrawn by the sender
+	ctx = ctx.WithBlockHeight(1)
+	msgTransfer := stake.NewMsgTransferDelegation(delAddr1, delAddr2, valOpAddr1, sdk.NewDec(10))
+	got = stakeHandler(ctx, msgTransfer)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+
+	amt := accMapper.GetAccount(ctx, delAddr1).GetCoins().AmountOf(denom)
+	expRes := sdk.NewDec(90).Add(sdk.NewDec(100).Quo(sdk.NewDec(2))).TruncateInt() // 90 + 100 tokens * 10/20
+	require.True(sdk.IntEq(t, expRes, amt))
+
+	// the distribution record moves to the recipient
+	require.False(t, keeper.HasDelegationDistInfo(ctx, delAddr1, valOpAddr1))
+	require.True(t, keeper.HasDelegationDistInfo(ctx, delAddr2, valOpAddr1))
+	require.True(sdk.DecEq(t, sdk.NewDec(10), keeper.GetDelegationDistInfo(ctx, delAddr2, valOpAddr1).Stake))
+
+	// the recipient has no rewards accumulated before the transfer
+	keeper.WithdrawDelegationReward(ctx, delAddr2, valOpAddr1)
+	amt = accMapper.GetAccount(ctx, delAddr2).GetCoins().AmountOf(denom)
+	require.Equal(t, int64(100), amt.Int64())
+}
+
+func TestWithdrawDelegationRewardAutoCompound(t *testing.T) {
+	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	//first make a validator
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// delegate, with auto-compounding and another withdraw address
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+	keeper.SetDelegatorAutoCompound(ctx, delAddr1, true)
+	keeper.SetDelegatorWithdrawAddr(ctx, delAddr1, delAddr3)
+
+	// allocate 100 denom and 10 photons of fees
+	fck.SetCollectedFees(sdk.Coins{sdk.NewInt64Coin("photon", 10), sdk.NewCoin(denom, sdk.NewInt(100))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+	// withdraw delegation, the rewards in the bond denom are delegated again
+	ctx = ctx.WithBlockHeight(1)
+	keeper.WithdrawDelegationReward(ctx, delAddr1, valOpAddr1)
+	delegation, found := sk.GetDelegation(ctx, delAddr1, valOpAddr1)
+	require.True(t, found)
+	require.True(sdk.DecEq(t, sdk.NewDec(10+50), delegation.Shares)) // 10 + 100 tokens * 10/20
+	require.True(sdk.DecEq(t, sdk.NewDec(10+50), keeper.GetDelegationDistInfo(ctx, delAddr1, valOpAddr1).Stake))
+
+	// the other rewards are paid out to the withdraw address
+	require.Equal(t, int64(90), accMapper.GetAccount(ctx, delAddr1).GetCoins().AmountOf(denom).Int64())
+	coins := accMapper.GetAccount(ctx, delAddr3).GetCoins()
+	require.Equal(t, int64(100), coins.AmountOf(denom).Int64())
+	require.Equal(t, int64(5), coins.AmountOf("photon").Int64()) // 10 photons * 10/20
+}
+
+func TestCompoundDelegationRewards(t *testing.T) {
+	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	//first make a validator
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// delegate from two delegators, only the first one auto-compounding
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+	msgDelegate = stake.NewTestMsgDelegate(delAddr2, valOpAddr1, 20)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+	keeper.SetDelegatorAutoCompound(ctx, delAddr1, true)
+
+	// allocate 100 denom of fees
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(100))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+	// only the rewards of the first delegator are compounded
+	ctx = ctx.WithBlockHeight(1)
+	keeper.CompoundDelegationRewards(ctx)
+	delegation, found := sk.GetDelegation(ctx, delAddr1, valOpAddr1)
+	require.True(t, found)
+	require.True(sdk.DecEq(t, sdk.NewDec(10+25), delegation.Shares)) // 10 + 100 tokens * 10/40
+	delegation, found = sk.GetDelegation(ctx, delAddr2, valOpAddr1)
+	require.True(t, found)
+	require.True(sdk.DecEq(t, sdk.NewDec(20), delegation.Shares))
+	require.Equal(t, int64(80), accMapper.GetAccount(ctx, delAddr2).GetCoins().AmountOf(denom).Int64())
+
+	// the second delegator still earned its rewards before the compounding
+	keeper.WithdrawDelegationReward(ctx, delAddr2, valOpAddr1)
+	amt := accMapper.GetAccount(ctx, delAddr2).GetCoins().AmountOf(denom)
+	require.Equal(t, int64(80+50), amt.Int64()) // 80 + 100 tokens * 20/40
+}
+
+func TestCompoundDelegationRewardsBatch(t *testing.T) {
+	ctx, _, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	//first make a validator
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// a delegator cannot enable auto-compounding without delegations
+	err := keeper.UpdateDelegatorAutoCompound(ctx, delAddr1, true)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoDelegation, err.Code())
+	require.Nil(t, keeper.UpdateDelegatorAutoCompound(ctx, delAddr1, false))
+
+	// delegate from two auto-compounding delegators
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+	msgDelegate = stake.NewTestMsgDelegate(delAddr2, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+	require.Nil(t, keeper.UpdateDelegatorAutoCompound(ctx, delAddr1, true))
+	require.Nil(t, keeper.UpdateDelegatorAutoCompound(ctx, delAddr2, true))
+
+	// a third delegator opted in but no longer has any delegation
+	keeper.SetDelegatorAutoCompound(ctx, delAddr3, true)
+
+	// allocate 90 denom of fees
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(90))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+	// a single delegator is processed per block until the round is over
+	ctx = ctx.WithBlockHeight(1)
+	keeper.compoundDelegationRewardsBatch(ctx, 1)
+	require.True(t, keeper.IsAutoCompoundInProgress(ctx))
+	keeper.compoundDelegationRewardsBatch(ctx, 1)
+	require.True(t, keeper.IsAutoCompoundInProgress(ctx))
+	keeper.compoundDelegationRewardsBatch(ctx, 1)
+	require.False(t, keeper.IsAutoCompoundInProgress(ctx))
+
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		delegation, found := sk.GetDelegation(ctx, delAddr, valOpAddr1)
+		require.True(t, found)
+		require.True(sdk.DecEq(t, sdk.NewDec(10+30), delegation.Shares)) // 10 + 90 tokens * 10/30
+		require.True(t, keeper.GetDelegatorAutoCompound(ctx, delAddr))
+	}
+	require.False(t, keeper.GetDelegatorAutoCompound(ctx, delAddr3))
+}
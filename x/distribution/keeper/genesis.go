@@ -19,6 +19,34 @@ func (k Keeper) GetAllValidatorDistInfos(ctx sdk.Context) (vdis []types.Validato
 	return vdis
 }
 
+// Get the historical rewards of all validators with no limits, used during genesis dump
+func (k Keeper) GetAllValidatorHistoricalRewards(ctx sdk.Context) (vhrs []types.ValidatorHistoricalRewardsRecord) {
+	k.IterateValidatorHistoricalRewards(ctx, sdk.ValAddress{},
+		func(valAddr sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool) {
+			vhrs = append(vhrs, types.ValidatorHistoricalRewardsRecord{
+				ValidatorAddr: valAddr,
+				Period:        period,
+				Rewards:       rewards,
+			})
+			return false
+		})
+	return vhrs
+}
+
+// Get the slash events of all validators with no limits, used during genesis dump
+func (k Keeper) GetAllValidatorSlashEvents(ctx sdk.Context) (vses []types.ValidatorSlashEventRecord) {
+	k.IterateValidatorSlashEvents(ctx, sdk.ValAddress{},
+		func(valAddr sdk.ValAddress, height int64, event types.ValidatorSlashEvent) (stop bool) {
+			vses = append(vses, types.ValidatorSlashEventRecord{
+				ValidatorAddr: valAddr,
+				Height:        height,
+				Event:         event,
+			})
+			return false
+		})
+	return vses
+}
+
 // Get the set of all delegator-distribution-info's with no limits, used during genesis dump
 func (k Keeper) GetAllDelegationDistInfos(ctx sdk.Context) (ddis []types.DelegationDistInfo) {
 	store := ctx.KVStore(k.storeKey)
@@ -36,15 +64,29 @@ func (k Keeper) GetAllDelegationDistInfos(ctx sdk.Context) (ddis []types.Delegat
 // Get the set of all delegator-withdraw addresses with no limits, used during genesis dump
 func (k Keeper) GetAllDelegatorWithdrawInfos(ctx sdk.Context) (dwis []types.DelegatorWithdrawInfo) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, DelegationDistInfoKey)
+	iterator := sdk.KVStorePrefixIterator(store, DelegatorWithdrawInfoKey)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
+		delAddr := sdk.AccAddress(iterator.Key()[1:])
 		dw := types.DelegatorWithdrawInfo{
-			DelegatorAddr: sdk.AccAddress(iterator.Key()),
+			DelegatorAddr: delAddr,
 			WithdrawAddr:  sdk.AccAddress(iterator.Value()),
+			AutoCompound:  k.GetDelegatorAutoCompound(ctx, delAddr),
 		}
 		dwis = append(dwis, dw)
 	}
+
+	// delegators with auto-compounding enabled but no withdraw address set
+	k.IterateDelegatorsAutoCompound(ctx, func(delAddr sdk.AccAddress) (stop bool) {
+		if !store.Has(GetDelegatorWithdrawAddrKey(delAddr)) {
+			dwis = append(dwis, types.DelegatorWithdrawInfo{
+				DelegatorAddr: delAddr,
+				WithdrawAddr:  delAddr,
+				AutoCompound:  true,
+			})
+		}
+		return false
+	})
 	return dwis
 }
@@ -1,63 +1,60 @@
 package keeper
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // Create a new validator distribution record
 func (k Keeper) onValidatorCreated(ctx sdk.Context, addr sdk.ValAddress) {
-
-	height := ctx.BlockHeight()
-	vdi := types.ValidatorDistInfo{
-		OperatorAddr:            addr,
-		FeePoolWithdrawalHeight: height,
-		Pool:           types.DecCoins{},
-		PoolCommission: types.DecCoins{},
-		DelAccum:       types.NewTotalAccum(height),
-	}
-	k.SetValidatorDistInfo(ctx, vdi)
-}
-
-// Withdrawal all validator rewards
-func (k Keeper) onValidatorCommissionChange(ctx sdk.Context, addr sdk.ValAddress) {
-	k.WithdrawValidatorRewardsAll(ctx, addr)
+	k.initializeValidator(ctx, addr)
 }
 
 // Withdrawal all validator distribution rewards and cleanup the distribution record
 func (k Keeper) onValidatorRemoved(ctx sdk.Context, addr sdk.ValAddress) {
-	k.RemoveValidatorDistInfo(ctx, addr)
+	if k.HasValidatorDistInfo(ctx, addr) {
+		k.removeValidator(ctx, addr)
+	}
+}
+
+// Record the slash of a validator, which reduces the stake of its delegations
+func (k Keeper) onValidatorSlashed(ctx sdk.Context, addr sdk.ValAddress, fraction sdk.Dec) {
+	k.updateValidatorSlashFraction(ctx, addr, fraction)
 }
 
 //_________________________________________________________________________________________
 
-// Create a new delegator distribution record
+// Close the current period of the validator before its tokens change
 func (k Keeper) onDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress,
 	valAddr sdk.ValAddress) {
 
-	ddi := types.DelegationDistInfo{
-		DelegatorAddr:    delAddr,
-		ValOperatorAddr:  valAddr,
-		WithdrawalHeight: ctx.BlockHeight(),
-	}
-	k.SetDelegationDistInfo(ctx, ddi)
-	ctx.Logger().With("module", "x/distribution").Error(fmt.Sprintf("ddi created: %v", ddi))
+	k.incrementValidatorPeriod(ctx, valAddr)
 }
 
-// Withdrawal all validator rewards
+// Withdrawal all delegation rewards before the shares are modified
 func (k Keeper) onDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress,
 	valAddr sdk.ValAddress) {
 
-	k.WithdrawDelegationReward(ctx, delAddr, valAddr)
+	if k.HasDelegationDistInfo(ctx, delAddr, valAddr) {
+		rewards := k.takeDelegationRewards(ctx, delAddr, valAddr)
+		k.payout(ctx, delAddr, rewards)
+	}
 }
 
-// Withdrawal all validator distribution rewards and cleanup the distribution record
+// Create the delegation distribution record for the new shares
+func (k Keeper) onDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress) {
+
+	k.initializeDelegation(ctx, delAddr, valAddr)
+}
+
+// Withdrawal any remaining delegation rewards and cleanup the distribution record
 func (k Keeper) onDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress,
 	valAddr sdk.ValAddress) {
 
-	k.RemoveDelegationDistInfo(ctx, delAddr, valAddr)
+	if k.HasDelegationDistInfo(ctx, delAddr, valAddr) {
+		rewards := k.takeDelegationRewards(ctx, delAddr, valAddr)
+		k.payout(ctx, delAddr, rewards)
+	}
 }
 
 //_________________________________________________________________________________________
@@ -76,22 +73,27 @@ func (k Keeper) Hooks() Hooks { return Hooks{k} }
 func (h Hooks) OnValidatorCreated(ctx sdk.Context, addr sdk.ValAddress) {
 	h.k.onValidatorCreated(ctx, addr)
 }
-func (h Hooks) OnValidatorCommissionChange(ctx sdk.Context, addr sdk.ValAddress) {
-	h.k.onValidatorCommissionChange(ctx, addr)
-}
 func (h Hooks) OnValidatorRemoved(ctx sdk.Context, addr sdk.ValAddress) {
 	h.k.onValidatorRemoved(ctx, addr)
 }
+func (h Hooks) OnValidatorSlashed(ctx sdk.Context, addr sdk.ValAddress, fraction sdk.Dec) {
+	h.k.onValidatorSlashed(ctx, addr, fraction)
+}
 func (h Hooks) OnDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.k.onDelegationCreated(ctx, delAddr, valAddr)
 }
 func (h Hooks) OnDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.k.onDelegationSharesModified(ctx, delAddr, valAddr)
 }
+func (h Hooks) OnDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.onDelegationModified(ctx, delAddr, valAddr)
+}
 func (h Hooks) OnDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	h.k.onDelegationRemoved(ctx, delAddr, valAddr)
 }
 
 // nolint - unused hooks for interface
-func (h Hooks) OnValidatorBonded(ctx sdk.Context, addr sdk.ConsAddress)         {}
-func (h Hooks) OnValidatorBeginUnbonding(ctx sdk.Context, addr sdk.ConsAddress) {}
+func (h Hooks) OnValidatorCommissionChange(ctx sdk.Context, addr sdk.ValAddress)                   {}
+func (h Hooks) OnValidatorBonded(ctx sdk.Context, addr sdk.ConsAddress)                            {}
+func (h Hooks) OnValidatorBeginUnbonding(ctx sdk.Context, addr sdk.ConsAddress)                    {}
+func (h Hooks) OnValidatorConsPubKeyRotated(_ sdk.Context, _ sdk.ValAddress, _, _ sdk.ConsAddress) {}
@@ -86,6 +86,7 @@ func ParamTypeTable() params.TypeTable {
 		ParamStoreKeyCommunityTax, sdk.Dec{},
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
+		ParamStoreKeyAutoCompoundInterval, int64(0),
 	)
 }
 
@@ -127,3 +128,17 @@ func (k Keeper) GetBonusProposerReward(ctx sdk.Context) sdk.Dec {
 func (k Keeper) SetBonusProposerReward(ctx sdk.Context, percent sdk.Dec) {
 	k.paramSpace.Set(ctx, ParamStoreKeyBonusProposerReward, &percent)
 }
+
+// Returns the number of blocks between the compounding of the rewards of the
+// delegators which enabled auto-compounding, zero if disabled
+// nolint: errcheck
+func (k Keeper) GetAutoCompoundInterval(ctx sdk.Context) int64 {
+	var interval int64
+	k.paramSpace.Get(ctx, ParamStoreKeyAutoCompoundInterval, &interval)
+	return interval
+}
+
+// nolint: errcheck
+func (k Keeper) SetAutoCompoundInterval(ctx sdk.Context, interval int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAutoCompoundInterval, &interval)
+}
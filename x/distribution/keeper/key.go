@@ -1,26 +1,36 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // keys/key-prefixes
 var (
-	FeePoolKey               = []byte{0x00} // key for global distribution state
-	ValidatorDistInfoKey     = []byte{0x01} // prefix for each key to a validator distribution
-	DelegationDistInfoKey    = []byte{0x02} // prefix for each key to a delegation distribution
-	DelegatorWithdrawInfoKey = []byte{0x03} // prefix for each key to a delegator withdraw info
-	ProposerKey              = []byte{0x04} // key for storing the proposer operator address
+	FeePoolKey                    = []byte{0x00} // key for global distribution state
+	ValidatorDistInfoKey          = []byte{0x01} // prefix for each key to a validator distribution
+	DelegationDistInfoKey         = []byte{0x02} // prefix for each key to a delegation distribution
+	DelegatorWithdrawInfoKey      = []byte{0x03} // prefix for each key to a delegator withdraw info
+	ProposerKey                   = []byte{0x04} // key for storing the proposer operator address
+	ValidatorHistoricalRewardsKey = []byte{0x05} // prefix for each key to the historical rewards of a validator period
+	ValidatorSlashEventKey        = []byte{0x06} // prefix for each key to a validator slash event
+	DelegatorAutoCompoundKey      = []byte{0x07} // prefix for each key to a delegator auto-compounding flag
+	AutoCompoundCursorKey         = []byte{0x08} // key for the next delegator to auto-compound the rewards of
 
 	// params store
-	ParamStoreKeyCommunityTax        = []byte("community-tax")
-	ParamStoreKeyBaseProposerReward  = []byte("base-proposer-reward")
-	ParamStoreKeyBonusProposerReward = []byte("bonus-proposer-reward")
+	ParamStoreKeyCommunityTax         = []byte("community-tax")
+	ParamStoreKeyBaseProposerReward   = []byte("base-proposer-reward")
+	ParamStoreKeyBonusProposerReward  = []byte("bonus-proposer-reward")
+	ParamStoreKeyAutoCompoundInterval = []byte("auto-compound-interval")
 )
 
 const (
 	// default paramspace for params keeper
 	DefaultParamspace = "distr"
+
+	// maximum number of delegators whose rewards are auto-compounded in a block
+	AutoCompoundBatchSize = 100
 )
 
 // gets the key for the validator distribution info from address
@@ -44,3 +54,57 @@ func GetDelegationDistInfosKey(delAddr sdk.AccAddress) []byte {
 func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawInfoKey, delAddr.Bytes()...)
 }
+
+// gets the key for the auto-compounding flag of a delegator
+func GetDelegatorAutoCompoundKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorAutoCompoundKey, delAddr.Bytes()...)
+}
+
+// gets the key for the historical rewards of a validator at the end of a period
+// VALUE: distribution/types.ValidatorHistoricalRewards
+func GetValidatorHistoricalRewardsKey(valAddr sdk.ValAddress, period uint64) []byte {
+	periodBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(periodBz, period)
+	return append(GetValidatorHistoricalRewardsPrefix(valAddr), periodBz...)
+}
+
+// gets the prefix for the historical rewards of a validator
+func GetValidatorHistoricalRewardsPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorHistoricalRewardsKey, valAddr.Bytes()...)
+}
+
+// gets the key for a slash event of a validator, ordered by height then period
+// VALUE: distribution/types.ValidatorSlashEvent
+func GetValidatorSlashEventKey(valAddr sdk.ValAddress, height int64, period uint64) []byte {
+	periodBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(periodBz, period)
+	return append(GetValidatorSlashEventHeightKey(valAddr, height), periodBz...)
+}
+
+// gets the prefix for the slash events of a validator at a height
+func GetValidatorSlashEventHeightKey(valAddr sdk.ValAddress, height int64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+	return append(GetValidatorSlashEventsPrefix(valAddr), heightBz...)
+}
+
+// gets the prefix for the slash events of a validator
+func GetValidatorSlashEventsPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorSlashEventKey, valAddr.Bytes()...)
+}
+
+// parses the validator address and the period from a historical rewards key
+func parseValidatorHistoricalRewardsKey(key []byte) (valAddr sdk.ValAddress, period uint64) {
+	addrEnd := len(ValidatorHistoricalRewardsKey) + sdk.AddrLen
+	valAddr = sdk.ValAddress(key[len(ValidatorHistoricalRewardsKey):addrEnd])
+	period = binary.BigEndian.Uint64(key[addrEnd:])
+	return
+}
+
+// parses the validator address and the height from a slash event key
+func parseValidatorSlashEventKey(key []byte) (valAddr sdk.ValAddress, height int64) {
+	addrEnd := len(ValidatorSlashEventKey) + sdk.AddrLen
+	valAddr = sdk.ValAddress(key[len(ValidatorSlashEventKey):addrEnd])
+	height = int64(binary.BigEndian.Uint64(key[addrEnd : addrEnd+8]))
+	return
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the distribution Querier
+const (
+	QueryParams                      = "params"
+	QueryFeePool                     = "fee_pool"
+	QueryCommunityPool               = "community_pool"
+	QueryValidatorCommission         = "validator_commission"
+	QueryValidatorOutstandingRewards = "validator_outstanding_rewards"
+	QueryDelegationRewards           = "delegation_rewards"
+	QueryDelegatorTotalRewards       = "delegator_total_rewards"
+	QueryWithdrawAddr                = "withdraw_addr"
+	QueryAutoCompound                = "auto_compound"
+)
+
+// creates a querier for distribution REST endpoints
+//
+// Pending rewards are computed by performing the withdrawal against the query
+// context, whose state changes are discarded.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, k)
+		case QueryFeePool:
+			return queryFeePool(ctx, k)
+		case QueryCommunityPool:
+			return queryCommunityPool(ctx, k)
+		case QueryValidatorCommission:
+			return queryValidatorCommission(ctx, req, k)
+		case QueryValidatorOutstandingRewards:
+			return queryValidatorOutstandingRewards(ctx, req, k)
+		case QueryDelegationRewards:
+			return queryDelegationRewards(ctx, req, k)
+		case QueryDelegatorTotalRewards:
+			return queryDelegatorTotalRewards(ctx, req, k)
+		case QueryWithdrawAddr:
+			return queryWithdrawAddr(ctx, req, k)
+		case QueryAutoCompound:
+			return queryAutoCompound(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
+		}
+	}
+}
+
+// distribution parameters, as returned by 'custom/distr/params'
+type Params struct {
+	CommunityTax         sdk.Dec `json:"community_tax"`
+	BaseProposerReward   sdk.Dec `json:"base_proposer_reward"`
+	BonusProposerReward  sdk.Dec `json:"bonus_proposer_reward"`
+	AutoCompoundInterval int64   `json:"auto_compound_interval"`
+}
+
+// defines the params for the following queries:
+// - 'custom/distr/validator_commission'
+// - 'custom/distr/validator_outstanding_rewards'
+type QueryValidatorParams struct {
+	ValidatorAddr sdk.ValAddress
+}
+
+// defines the params for the following queries:
+// - 'custom/distr/delegation_rewards'
+type QueryDelegationRewardsParams struct {
+	DelegatorAddr sdk.AccAddress
+	ValidatorAddr sdk.ValAddress
+}
+
+// defines the params for the following queries:
+// - 'custom/distr/delegator_total_rewards'
+// - 'custom/distr/withdraw_addr'
+// - 'custom/distr/auto_compound'
+type QueryDelegatorParams struct {
+	DelegatorAddr sdk.AccAddress
+}
+
+func queryParams(ctx sdk.Context, k Keeper) (res []byte, err sdk.Error) {
+	params := Params{
+		CommunityTax:         k.GetCommunityTax(ctx),
+		BaseProposerReward:   k.GetBaseProposerReward(ctx),
+		BonusProposerReward:  k.GetBonusProposerReward(ctx),
+		AutoCompoundInterval: k.GetAutoCompoundInterval(ctx),
+	}
+	return marshalQueryResult(k.cdc, params)
+}
+
+func queryFeePool(ctx sdk.Context, k Keeper) (res []byte, err sdk.Error) {
+	return marshalQueryResult(k.cdc, k.GetFeePool(ctx))
+}
+
+func queryCommunityPool(ctx sdk.Context, k Keeper) (res []byte, err sdk.Error) {
+	return marshalQueryResult(k.cdc, k.GetFeePool(ctx).CommunityPool)
+}
+
+func queryValidatorCommission(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	valInfo, err := queryValidatorDistInfo(ctx, req, k)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(k.cdc, valInfo.PoolCommission)
+}
+
+func queryValidatorOutstandingRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	valInfo, err := queryValidatorDistInfo(ctx, req, k)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(k.cdc, valInfo.Pool)
+}
+
+// returns the distribution info of the queried validator
+func queryValidatorDistInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) (vi types.ValidatorDistInfo, err sdk.Error) {
+	var params QueryValidatorParams
+	errRes := k.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return vi, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+	}
+
+	if !k.HasValidatorDistInfo(ctx, params.ValidatorAddr) {
+		return vi, types.ErrNoValidatorDistInfo(k.codespace)
+	}
+	return k.GetValidatorDistInfo(ctx, params.ValidatorAddr), nil
+}
+
+func queryDelegationRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryDelegationRewardsParams
+	errRes := k.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+	}
+
+	if !k.HasDelegationDistInfo(ctx, params.DelegatorAddr, params.ValidatorAddr) {
+		return nil, types.ErrNoDelegationDistInfo(k.codespace)
+	}
+
+	rewards := k.withdrawDelegationReward(ctx, params.DelegatorAddr, params.ValidatorAddr)
+	return marshalQueryResult(k.cdc, rewards)
+}
+
+func queryDelegatorTotalRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryDelegatorParams
+	errRes := k.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+	}
+
+	rewards := k.withdrawDelegationRewardsAll(ctx, params.DelegatorAddr)
+	return marshalQueryResult(k.cdc, rewards)
+}
+
+func queryWithdrawAddr(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryDelegatorParams
+	errRes := k.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+	}
+
+	return marshalQueryResult(k.cdc, k.GetDelegatorWithdrawAddr(ctx, params.DelegatorAddr))
+}
+
+func queryAutoCompound(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryDelegatorParams
+	errRes := k.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+	}
+
+	return marshalQueryResult(k.cdc, k.GetDelegatorAutoCompound(ctx, params.DelegatorAddr))
+}
+
+func marshalQueryResult(cdc *codec.Codec, result interface{}) (res []byte, err sdk.Error) {
+	res, errRes := codec.MarshalJSONIndent(cdc, result)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", errRes.Error()))
+	}
+	return res, nil
+}
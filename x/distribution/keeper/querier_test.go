@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestQueryParamsAndPools(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 100)
+	querier := NewQuerier(keeper)
+
+	res, err := querier(ctx, []string{QueryParams}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var params Params
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &params))
+	require.True(t, sdk.NewDecWithPrec(2, 2).Equal(params.CommunityTax))
+	require.True(t, sdk.NewDecWithPrec(1, 2).Equal(params.BaseProposerReward))
+	require.True(t, sdk.NewDecWithPrec(4, 2).Equal(params.BonusProposerReward))
+	require.Equal(t, int64(100), params.AutoCompoundInterval)
+
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoin("steak", 10)}
+	keeper.SetFeePool(ctx, feePool)
+
+	res, err = querier(ctx, []string{QueryFeePool}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var queriedFeePool types.FeePool
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &queriedFeePool))
+	require.True(sdk.DecEq(t, sdk.NewDec(10), queriedFeePool.CommunityPool.AmountOf("steak")))
+
+	res, err = querier(ctx, []string{QueryCommunityPool}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var communityPool sdk.DecCoins
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &communityPool))
+	require.True(sdk.DecEq(t, sdk.NewDec(10), communityPool.AmountOf("steak")))
+}
+
+func TestQueryRewards(t *testing.T) {
+	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+	querier := NewQuerier(keeper)
+
+	// make a validator with 10% commission and a delegation
+	msgCreateValidator := stake.NewTestMsgCreateValidatorWithCommission(
+		valOpAddr1, valConsPk1, 10, sdk.NewDecWithPrec(1, 1))
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	msgDelegate := stake.NewTestMsgDelegate(delAddr1, valOpAddr1, 10)
+	got = stakeHandler(ctx, msgDelegate)
+	require.True(t, got.IsOK())
+
+	// allocate 100 denom of fees
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(100))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+	ctx = ctx.WithBlockHeight(1)
+
+	// queries must not modify the state, as in the query context of the app
+	queryCtx := ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+
+	bz, errRes := keeper.cdc.MarshalJSON(QueryValidatorParams{ValidatorAddr: valOpAddr1})
+	require.NoError(t, errRes)
+	res, err := querier(queryCtx, []string{QueryValidatorCommission}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var commission sdk.DecCoins
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &commission))
+	require.True(sdk.DecEq(t, sdk.NewDec(10), commission.AmountOf(denom))) // 100 tokens * 10%
+
+	res, err = querier(queryCtx, []string{QueryValidatorOutstandingRewards}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var outstanding sdk.DecCoins
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &outstanding))
+	require.True(sdk.DecEq(t, sdk.NewDec(90), outstanding.AmountOf(denom)))
+
+	bz, errRes = keeper.cdc.MarshalJSON(QueryDelegationRewardsParams{DelegatorAddr: delAddr1, ValidatorAddr: valOpAddr1})
+	require.NoError(t, errRes)
+	res, err = querier(queryCtx, []string{QueryDelegationRewards}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var rewards sdk.DecCoins
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &rewards))
+	require.True(sdk.DecEq(t, sdk.NewDec(45), rewards.AmountOf(denom))) // 100*90% tokens * 10/20
+
+	bz, errRes = keeper.cdc.MarshalJSON(QueryDelegatorParams{DelegatorAddr: delAddr1})
+	require.NoError(t, errRes)
+	res, err = querier(ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore()),
+		[]string{QueryDelegatorTotalRewards}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var total sdk.DecCoins
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &total))
+	require.True(sdk.DecEq(t, rewards.AmountOf(denom), total.AmountOf(denom)))
+
+	res, err = querier(ctx, []string{QueryWithdrawAddr}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var withdrawAddr sdk.AccAddress
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &withdrawAddr))
+	require.Equal(t, delAddr1, withdrawAddr)
+
+	keeper.SetDelegatorAutoCompound(ctx, delAddr1, true)
+	res, err = querier(ctx, []string{QueryAutoCompound}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var autoCompound bool
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &autoCompound))
+	require.True(t, autoCompound)
+	keeper.SetDelegatorAutoCompound(ctx, delAddr1, false)
+
+	// the pending rewards are the ones withdrawn
+	keeper.WithdrawDelegationReward(ctx, delAddr1, valOpAddr1)
+	amt := accMapper.GetAccount(ctx, delAddr1).GetCoins().AmountOf(denom)
+	require.True(sdk.IntEq(t, sdk.NewInt(90+45), amt))
+
+	// unknown validator and delegation
+	bz, errRes = keeper.cdc.MarshalJSON(QueryValidatorParams{ValidatorAddr: valOpAddr2})
+	require.NoError(t, errRes)
+	_, err = querier(ctx, []string{QueryValidatorCommission}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, err)
+
+	bz, errRes = keeper.cdc.MarshalJSON(QueryDelegationRewardsParams{DelegatorAddr: delAddr2, ValidatorAddr: valOpAddr1})
+	require.NoError(t, errRes)
+	_, err = querier(ctx, []string{QueryDelegationRewards}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, err)
+}
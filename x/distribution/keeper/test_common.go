@@ -128,7 +128,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initCoins int64,
 	}
 
 	fck := DummyFeeCollectionKeeper{}
-	keeper := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), ck, sk, fck, types.DefaultCodespace)
+	keeper := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), ck, &sk, fck, types.DefaultCodespace)
 
 	// set the distribution hooks on staking
 	sk = sk.WithHooks(keeper.Hooks())
@@ -138,10 +138,23 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initCoins int64,
 	keeper.SetCommunityTax(ctx, communityTax)
 	keeper.SetBaseProposerReward(ctx, sdk.NewDecWithPrec(1, 2))
 	keeper.SetBonusProposerReward(ctx, sdk.NewDecWithPrec(4, 2))
+	keeper.SetAutoCompoundInterval(ctx, 100)
 
 	return ctx, accountMapper, keeper, sk, fck
 }
 
+// votes of the previous block, which all the given validators signed
+func signedVotes(ctx sdk.Context, sk stake.Keeper, valAddrs ...sdk.ValAddress) (votes []abci.VoteInfo) {
+	for _, valAddr := range valAddrs {
+		validator := sk.Validator(ctx, valAddr)
+		votes = append(votes, abci.VoteInfo{
+			Validator:       sdk.ABCIValidator(validator),
+			SignedLastBlock: true,
+		})
+	}
+	return votes
+}
+
 //__________________________________________________________________________________
 // fee collection keeper used only for testing
 type DummyFeeCollectionKeeper struct{}
@@ -27,34 +27,231 @@ func (k Keeper) SetValidatorDistInfo(ctx sdk.Context, vdi types.ValidatorDistInf
 	store.Set(GetValidatorDistInfoKey(vdi.OperatorAddr), b)
 }
 
+// check if a validator distribution info exists
+func (k Keeper) HasValidatorDistInfo(ctx sdk.Context, operatorAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorDistInfoKey(operatorAddr))
+}
+
 // remove a validator distribution info
 func (k Keeper) RemoveValidatorDistInfo(ctx sdk.Context, valAddr sdk.ValAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(GetValidatorDistInfoKey(valAddr))
 }
 
+//___________________________________________________________________________________________
+
+// get the historical rewards of a validator at the end of a period
+func (k Keeper) GetValidatorHistoricalRewards(ctx sdk.Context, valAddr sdk.ValAddress,
+	period uint64) (rewards types.ValidatorHistoricalRewards) {
+
+	store := ctx.KVStore(k.storeKey)
+
+	b := store.Get(GetValidatorHistoricalRewardsKey(valAddr, period))
+	if b == nil {
+		panic("Stored validator historical rewards should not have been nil")
+	}
+
+	k.cdc.MustUnmarshalBinary(b, &rewards)
+	return
+}
+
+// set the historical rewards of a validator at the end of a period
+func (k Keeper) SetValidatorHistoricalRewards(ctx sdk.Context, valAddr sdk.ValAddress,
+	period uint64, rewards types.ValidatorHistoricalRewards) {
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(rewards)
+	store.Set(GetValidatorHistoricalRewardsKey(valAddr, period), b)
+}
+
+// remove the historical rewards of a validator at the end of a period
+func (k Keeper) RemoveValidatorHistoricalRewards(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorHistoricalRewardsKey(valAddr, period))
+}
+
+// iterate over the historical rewards of a validator, or of all validators
+// if the address is empty
+func (k Keeper) IterateValidatorHistoricalRewards(ctx sdk.Context, valAddr sdk.ValAddress,
+	fn func(valAddr sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorHistoricalRewardsPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.ValidatorHistoricalRewards
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &rewards)
+		addr, period := parseValidatorHistoricalRewardsKey(iterator.Key())
+		if fn(addr, period, rewards) {
+			break
+		}
+	}
+}
+
+// increment the number of references to the historical rewards of a period
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	rewards := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	rewards.ReferenceCount++
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, rewards)
+}
+
+// decrement the number of references to the historical rewards of a period,
+// removing them once they are no longer referenced
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, valAddr sdk.ValAddress, period uint64) {
+	rewards := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if rewards.ReferenceCount == 0 {
+		panic("cannot set negative reference count")
+	}
+	rewards.ReferenceCount--
+	if rewards.ReferenceCount == 0 {
+		k.RemoveValidatorHistoricalRewards(ctx, valAddr, period)
+		return
+	}
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, rewards)
+}
+
+//___________________________________________________________________________________________
+
+// set a slash event of a validator
+func (k Keeper) SetValidatorSlashEvent(ctx sdk.Context, valAddr sdk.ValAddress,
+	height int64, event types.ValidatorSlashEvent) {
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(event)
+	store.Set(GetValidatorSlashEventKey(valAddr, height, event.ValidatorPeriod), b)
+}
+
+// iterate over the slash events of a validator since a height, inclusive, in
+// the order they occurred
+func (k Keeper) IterateValidatorSlashEventsSince(ctx sdk.Context, valAddr sdk.ValAddress,
+	startingHeight int64, fn func(height int64, event types.ValidatorSlashEvent) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		GetValidatorSlashEventHeightKey(valAddr, startingHeight),
+		sdk.PrefixEndBytes(GetValidatorSlashEventsPrefix(valAddr)),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.ValidatorSlashEvent
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &event)
+		_, height := parseValidatorSlashEventKey(iterator.Key())
+		if fn(height, event) {
+			break
+		}
+	}
+}
+
+// iterate over the slash events of a validator, or of all validators if the
+// address is empty
+func (k Keeper) IterateValidatorSlashEvents(ctx sdk.Context, valAddr sdk.ValAddress,
+	fn func(valAddr sdk.ValAddress, height int64, event types.ValidatorSlashEvent) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorSlashEventsPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.ValidatorSlashEvent
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &event)
+		addr, height := parseValidatorSlashEventKey(iterator.Key())
+		if fn(addr, height, event) {
+			break
+		}
+	}
+}
+
+// remove all the slash events of a validator
+func (k Keeper) RemoveValidatorSlashEvents(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorSlashEventsPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+//___________________________________________________________________________________________
+
+// initialize the distribution records of a new validator, which starts at
+// period 1 with the empty historical rewards of period 0
+func (k Keeper) initializeValidator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	k.SetValidatorHistoricalRewards(ctx, valAddr, 0, types.NewValidatorHistoricalRewards(sdk.DecCoins{}, 1))
+	k.SetValidatorDistInfo(ctx, types.NewValidatorDistInfo(valAddr))
+}
+
+// close the current period of a validator, storing its historical rewards, and
+// return the period which was closed
+func (k Keeper) incrementValidatorPeriod(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	validator := k.stakeKeeper.Validator(ctx, valAddr)
+	valInfo := k.GetValidatorDistInfo(ctx, valAddr)
+	period := valInfo.Period
+
+	valInfo, ratio, remainder := valInfo.IncrementPeriod(validator.GetTokens())
+	if !remainder.IsZero() {
+		feePool := k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Plus(remainder)
+		k.SetFeePool(ctx, feePool)
+	}
+
+	// the previous period is no longer the latest one
+	previous := k.GetValidatorHistoricalRewards(ctx, valAddr, period-1)
+	k.decrementReferenceCount(ctx, valAddr, period-1)
+	cumulativeRewardRatio := previous.CumulativeRewardRatio.Plus(ratio)
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, types.NewValidatorHistoricalRewards(cumulativeRewardRatio, 1))
+
+	k.SetValidatorDistInfo(ctx, valInfo)
+	return period
+}
+
+// close the current period of a validator which is being slashed, recording
+// the slash for the delegations of the validator
+func (k Keeper) updateValidatorSlashFraction(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	period := k.incrementValidatorPeriod(ctx, valAddr)
+	k.incrementReferenceCount(ctx, valAddr, period)
+	k.SetValidatorSlashEvent(ctx, valAddr, ctx.BlockHeight(), types.NewValidatorSlashEvent(period, fraction))
+}
+
+// remove all the distribution records of a validator, paying out its commission
+// and moving the rewards not withdrawn by its delegations to the community pool
+func (k Keeper) removeValidator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	valInfo, commission := k.GetValidatorDistInfo(ctx, valAddr).WithdrawCommission()
+	k.payout(ctx, sdk.AccAddress(valAddr.Bytes()), commission)
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Plus(valInfo.Pool)
+	k.SetFeePool(ctx, feePool)
+
+	k.IterateValidatorHistoricalRewards(ctx, valAddr,
+		func(_ sdk.ValAddress, period uint64, _ types.ValidatorHistoricalRewards) (stop bool) {
+			k.RemoveValidatorHistoricalRewards(ctx, valAddr, period)
+			return false
+		})
+	k.RemoveValidatorSlashEvents(ctx, valAddr)
+	k.RemoveValidatorDistInfo(ctx, valAddr)
+}
+
+//___________________________________________________________________________________________
+
 // withdrawal all the validator rewards including the commission
-func (k Keeper) WithdrawValidatorRewardsAll(ctx sdk.Context, operatorAddr sdk.ValAddress) {
+func (k Keeper) WithdrawValidatorRewardsAll(ctx sdk.Context, operatorAddr sdk.ValAddress) sdk.Error {
+	if !k.HasValidatorDistInfo(ctx, operatorAddr) {
+		return types.ErrNoValidatorDistInfo(k.codespace)
+	}
 
 	// withdraw self-delegation
-	height := ctx.BlockHeight()
-	validator := k.stakeKeeper.Validator(ctx, operatorAddr)
 	accAddr := sdk.AccAddress(operatorAddr.Bytes())
-	withdraw := k.getDelegatorRewardsAll(ctx, accAddr, height)
+	withdraw := k.compoundDelegationRewardsAll(ctx, accAddr)
 
 	// withdrawal validator commission rewards
-	bondedTokens := k.stakeKeeper.TotalPower(ctx)
-	valInfo := k.GetValidatorDistInfo(ctx, operatorAddr)
-	feePool := k.GetFeePool(ctx)
-	valInfo, feePool, commission := valInfo.WithdrawCommission(feePool, height, bondedTokens,
-		validator.GetTokens(), validator.GetCommission())
+	valInfo, commission := k.GetValidatorDistInfo(ctx, operatorAddr).WithdrawCommission()
 	withdraw = withdraw.Plus(commission)
 	k.SetValidatorDistInfo(ctx, valInfo)
-	k.SetFeePool(ctx, feePool)
 
-	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-	_, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, withdraw.TruncateDecimal())
-	if err != nil {
-		panic(err)
-	}
+	k.payout(ctx, accAddr, withdraw)
+	return nil
 }
@@ -23,7 +23,7 @@ func TestWithdrawValidatorRewardsAllNoDelegator(t *testing.T) {
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
 	// withdraw self-delegation reward
 	ctx = ctx.WithBlockHeight(1)
@@ -55,7 +55,7 @@ func TestWithdrawValidatorRewardsAllDelegatorNoCommission(t *testing.T) {
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
 	// withdraw self-delegation reward
 	ctx = ctx.WithBlockHeight(1)
@@ -89,7 +89,7 @@ func TestWithdrawValidatorRewardsAllDelegatorWithCommission(t *testing.T) {
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
 	// withdraw validator reward
 	ctx = ctx.WithBlockHeight(1)
@@ -129,7 +129,7 @@ func TestWithdrawValidatorRewardsAllMultipleValidator(t *testing.T) {
 	feeInputs := sdk.NewInt(1000)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1, valOpAddr2, valOpAddr3))
 
 	// withdraw validator reward
 	ctx = ctx.WithBlockHeight(1)
@@ -175,7 +175,7 @@ func TestWithdrawValidatorRewardsAllMultipleDelegator(t *testing.T) {
 	feeInputs := sdk.NewInt(100)
 	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, feeInputs)})
 	require.Equal(t, feeInputs, fck.GetCollectedFees(ctx).AmountOf(denom))
-	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1)
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
 
 	// withdraw validator reward
 	ctx = ctx.WithBlockHeight(1)
@@ -190,3 +190,29 @@ func TestWithdrawValidatorRewardsAllMultipleDelegator(t *testing.T) {
 		TruncateInt() // 90 + 100*90% tokens * 10/40
 	require.True(sdk.IntEq(t, expRes, amt))
 }
+
+func TestWithdrawValidatorRewardsAllAutoCompound(t *testing.T) {
+	ctx, accMapper, keeper, sk, fck := CreateTestInputAdvanced(t, false, 100, sdk.ZeroDec())
+	stakeHandler := stake.NewHandler(sk)
+	denom := sk.GetParams(ctx).BondDenom
+
+	//first make a validator with auto-compounding
+	msgCreateValidator := stake.NewTestMsgCreateValidator(valOpAddr1, valConsPk1, 10)
+	got := stakeHandler(ctx, msgCreateValidator)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_ = sk.ApplyAndReturnValidatorSetUpdates(ctx)
+	keeper.SetDelegatorAutoCompound(ctx, valAccAddr1, true)
+
+	// allocate 100 denom of fees
+	fck.SetCollectedFees(sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(100))})
+	keeper.AllocateFees(ctx, sdk.OneDec(), valConsAddr1, signedVotes(ctx, sk, valOpAddr1))
+
+	// the self-delegation reward is delegated again
+	ctx = ctx.WithBlockHeight(1)
+	keeper.WithdrawValidatorRewardsAll(ctx, valOpAddr1)
+	delegation, found := sk.GetDelegation(ctx, valAccAddr1, valOpAddr1)
+	require.True(t, found)
+	require.True(sdk.DecEq(t, sdk.NewDec(10+100), delegation.Shares))
+	amt := accMapper.GetAccount(ctx, valAccAddr1).GetCoins().AmountOf(denom)
+	require.Equal(t, int64(90), amt.Int64())
+}
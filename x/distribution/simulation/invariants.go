@@ -0,0 +1,144 @@
+package simulation
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+// AllInvariants runs all invariants of the distribution module.
+// Currently: non-negative pools, reference counts, delegation stakes, can withdraw
+func AllInvariants(d distr.Keeper) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		err := NonNegativePoolsInvariant(d)(app)
+		if err != nil {
+			return err
+		}
+		err = ReferenceCountInvariant(d)(app)
+		if err != nil {
+			return err
+		}
+		err = DelegationStakeInvariant(d)(app)
+		if err != nil {
+			return err
+		}
+		err = CanWithdrawInvariant(d)(app)
+		return err
+	}
+}
+
+// NonNegativePoolsInvariant checks that the community pool and the pools of
+// all validators are valid and non-negative
+func NonNegativePoolsInvariant(d distr.Keeper) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		ctx := app.NewContext(false, abci.Header{})
+		return nonNegativePools(ctx, d)
+	}
+}
+
+// ReferenceCountInvariant checks that the reference counts of the historical
+// rewards of each validator match the number of delegations and slash events
+// referencing them, plus one for the latest period
+func ReferenceCountInvariant(d distr.Keeper) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		ctx := app.NewContext(false, abci.Header{})
+
+		expected := make(map[string]uint64)
+		for _, vdi := range d.GetAllValidatorDistInfos(ctx) {
+			expected[vdi.OperatorAddr.String()] = 1
+		}
+		for _, ddi := range d.GetAllDelegationDistInfos(ctx) {
+			if _, ok := expected[ddi.ValOperatorAddr.String()]; ok {
+				expected[ddi.ValOperatorAddr.String()]++
+			}
+		}
+		d.IterateValidatorSlashEvents(ctx, sdk.ValAddress{},
+			func(valAddr sdk.ValAddress, _ int64, _ distr.ValidatorSlashEvent) (stop bool) {
+				expected[valAddr.String()]++
+				return false
+			})
+
+		actual := make(map[string]uint64)
+		d.IterateValidatorHistoricalRewards(ctx, sdk.ValAddress{},
+			func(valAddr sdk.ValAddress, _ uint64, rewards distr.ValidatorHistoricalRewards) (stop bool) {
+				actual[valAddr.String()] += rewards.ReferenceCount
+				return false
+			})
+
+		for valAddr, count := range expected {
+			if actual[valAddr] != count {
+				return fmt.Errorf("expected reference count of validator %s to be %d, got %d",
+					valAddr, count, actual[valAddr])
+			}
+		}
+		if len(actual) != len(expected) {
+			return fmt.Errorf("expected historical rewards for %d validators, got %d", len(expected), len(actual))
+		}
+		return nil
+	}
+}
+
+// DelegationStakeInvariant checks that the stake of each delegation computed
+// from the slashes of its validator does not exceed its current stake by more
+// than the rounding can explain
+func DelegationStakeInvariant(d distr.Keeper) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		ctx := app.NewContext(false, abci.Header{})
+
+		for _, ddi := range d.GetAllDelegationDistInfos(ctx) {
+			if !d.HasValidatorDistInfo(ctx, ddi.ValOperatorAddr) {
+				continue
+			}
+			stake, currentStake := d.RecomputeDelegationStake(ctx, ddi)
+			if distr.StakeOvershootExceeded(stake, currentStake) {
+				return fmt.Errorf("computed stake %v of delegation %s to %s exceeds its current stake %v",
+					stake, ddi.DelegatorAddr, ddi.ValOperatorAddr, currentStake)
+			}
+		}
+		return nil
+	}
+}
+
+// CanWithdrawInvariant checks that all the delegations and validators can
+// withdraw their rewards without any pool becoming negative
+func CanWithdrawInvariant(d distr.Keeper) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		ctx := app.NewContext(false, abci.Header{})
+
+		// the withdrawals are not written to the state
+		ctx, _ = ctx.CacheContext()
+
+		for _, ddi := range d.GetAllDelegationDistInfos(ctx) {
+			err := d.WithdrawDelegationReward(ctx, ddi.DelegatorAddr, ddi.ValOperatorAddr)
+			if err != nil {
+				return fmt.Errorf("could not withdraw rewards of delegation %v: %v", ddi, err)
+			}
+		}
+		for _, vdi := range d.GetAllValidatorDistInfos(ctx) {
+			err := d.WithdrawValidatorRewardsAll(ctx, vdi.OperatorAddr)
+			if err != nil {
+				return fmt.Errorf("could not withdraw rewards of validator %s: %v", vdi.OperatorAddr, err)
+			}
+		}
+		return nonNegativePools(ctx, d)
+	}
+}
+
+func nonNegativePools(ctx sdk.Context, d distr.Keeper) error {
+	communityPool := d.GetFeePool(ctx).CommunityPool
+	if !communityPool.IsValid() {
+		return fmt.Errorf("negative community pool: %v", communityPool)
+	}
+	for _, vdi := range d.GetAllValidatorDistInfos(ctx) {
+		if !vdi.Pool.IsValid() || !vdi.PoolCommission.IsValid() || !vdi.CurrentRewards.IsValid() {
+			return fmt.Errorf("negative pool of validator %s: pool %v, commission %v, current rewards %v",
+				vdi.OperatorAddr, vdi.Pool, vdi.PoolCommission, vdi.CurrentRewards)
+		}
+	}
+	return nil
+}
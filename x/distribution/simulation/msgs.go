@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+// SimulateMsgSetWithdrawAddress
+func SimulateMsgSetWithdrawAddress(k distr.Keeper) simulation.Operation {
+	handler := distr.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		withdrawAcc := simulation.RandomAcc(r, accs)
+		msg := distr.NewMsgSetWithdrawAddress(delegatorAcc.Address, withdrawAcc.Address)
+
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+
+		event(fmt.Sprintf("distribution/MsgSetWithdrawAddress/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgSetWithdrawAddress: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
+// SimulateMsgSetAutoCompound
+func SimulateMsgSetAutoCompound(k distr.Keeper) simulation.Operation {
+	handler := distr.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		msg := distr.NewMsgSetAutoCompound(delegatorAcc.Address, r.Intn(2) == 0)
+
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+
+		event(fmt.Sprintf("distribution/MsgSetAutoCompound/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgSetAutoCompound: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
+// SimulateMsgWithdrawDelegatorRewardsAll
+func SimulateMsgWithdrawDelegatorRewardsAll(k distr.Keeper) simulation.Operation {
+	handler := distr.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		msg := distr.NewMsgWithdrawDelegatorRewardsAll(delegatorAcc.Address)
+
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+
+		event(fmt.Sprintf("distribution/MsgWithdrawDelegatorRewardsAll/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgWithdrawDelegatorRewardsAll: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
+// SimulateMsgWithdrawDelegatorReward
+func SimulateMsgWithdrawDelegatorReward(k distr.Keeper) simulation.Operation {
+	handler := distr.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		validatorAcc := simulation.RandomAcc(r, accs)
+		msg := distr.NewMsgWithdrawDelegationReward(delegatorAcc.Address, sdk.ValAddress(validatorAcc.Address))
+
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+
+		event(fmt.Sprintf("distribution/MsgWithdrawDelegatorReward/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgWithdrawDelegatorReward: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
+// SimulateMsgWithdrawValidatorRewardsAll
+func SimulateMsgWithdrawValidatorRewardsAll(k distr.Keeper) simulation.Operation {
+	handler := distr.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		validatorAcc := simulation.RandomAcc(r, accs)
+		msg := distr.NewMsgWithdrawValidatorRewardsAll(sdk.ValAddress(validatorAcc.Address))
+
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+
+		event(fmt.Sprintf("distribution/MsgWithdrawValidatorRewardsAll/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgWithdrawValidatorRewardsAll: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
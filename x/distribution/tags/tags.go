@@ -10,6 +10,7 @@ var (
 	ActionWithdrawDelegatorRewardsAll = []byte("withdraw-delegator-rewards-all")
 	ActionWithdrawDelegatorReward     = []byte("withdraw-delegator-reward")
 	ActionWithdrawValidatorRewardsAll = []byte("withdraw-validator-rewards-all")
+	ActionSetAutoCompound             = []byte("set-auto-compound")
 
 	Action    = sdk.TagAction
 	Validator = sdk.TagSrcValidator
@@ -6,42 +6,31 @@ import (
 
 // distribution info for a delegation - used to determine entitled rewards
 type DelegationDistInfo struct {
-	DelegatorAddr    sdk.AccAddress `json:"delegator_addr"`
-	ValOperatorAddr  sdk.ValAddress `json:"val_operator_addr"`
-	WithdrawalHeight int64          `json:"withdrawal_height"` // last time this delegation withdrew rewards
+	DelegatorAddr   sdk.AccAddress `json:"delegator_addr"`
+	ValOperatorAddr sdk.ValAddress `json:"val_operator_addr"`
+	StartingPeriod  uint64         `json:"starting_period"` // last period of the validator ended before the delegation was modified
+	StartingHeight  int64          `json:"starting_height"` // last time the delegation was modified
+	Stake           sdk.Dec        `json:"stake"`           // validator tokens held by the delegation when it was modified
 }
 
 func NewDelegationDistInfo(delegatorAddr sdk.AccAddress, valOperatorAddr sdk.ValAddress,
-	currentHeight int64) DelegationDistInfo {
+	startingPeriod uint64, startingHeight int64, stake sdk.Dec) DelegationDistInfo {
 
 	return DelegationDistInfo{
-		DelegatorAddr:    delegatorAddr,
-		ValOperatorAddr:  valOperatorAddr,
-		WithdrawalHeight: currentHeight,
+		DelegatorAddr:   delegatorAddr,
+		ValOperatorAddr: valOperatorAddr,
+		StartingPeriod:  startingPeriod,
+		StartingHeight:  startingHeight,
+		Stake:           stake,
 	}
 }
 
-// withdraw rewards from delegator
-func (di DelegationDistInfo) WithdrawRewards(fp FeePool, vi ValidatorDistInfo,
-	height int64, totalBonded, vdTokens, totalDelShares, delegatorShares,
-	commissionRate sdk.Dec) (DelegationDistInfo, ValidatorDistInfo, FeePool, DecCoins) {
-
-	vi = vi.UpdateTotalDelAccum(height, totalDelShares)
-
-	if vi.DelAccum.Accum.IsZero() {
-		return di, vi, fp, DecCoins{}
+// rewards earned by a stake between the ends of two periods of a validator,
+// given the cumulative reward ratios of the validator at these ends
+func RewardsBetween(startingRatio, endingRatio sdk.DecCoins, stake sdk.Dec) sdk.DecCoins {
+	difference := endingRatio.Minus(startingRatio)
+	if !difference.IsValid() {
+		panic("cumulative reward ratio should not decrease")
 	}
-
-	vi, fp = vi.TakeFeePoolRewards(fp, height, totalBonded, vdTokens, commissionRate)
-
-	blocks := height - di.WithdrawalHeight
-	di.WithdrawalHeight = height
-	accum := delegatorShares.MulInt(sdk.NewInt(blocks))
-	withdrawalTokens := vi.Pool.MulDec(accum).QuoDec(vi.DelAccum.Accum)
-	remainingTokens := vi.Pool.Minus(withdrawalTokens)
-
-	vi.Pool = remainingTokens
-	vi.DelAccum.Accum = vi.DelAccum.Accum.Sub(accum)
-
-	return di, vi, fp, withdrawalTokens
+	return difference.MulDecTruncate(stake)
 }
@@ -5,52 +5,27 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestWithdrawRewards(t *testing.T) {
+func TestRewardsBetween(t *testing.T) {
 
 	// initialize
-	height := int64(0)
-	fp := InitialFeePool()
-	vi := NewValidatorDistInfo(valAddr1, height)
-	commissionRate := sdk.NewDecWithPrec(2, 2)
-	validatorTokens := sdk.NewDec(10)
-	validatorDelShares := sdk.NewDec(10)
-	totalBondedTokens := validatorTokens.Add(sdk.NewDec(90)) // validator-1 is 10% of total power
-
-	di1 := NewDelegationDistInfo(delAddr1, valAddr1, height)
-	di1Shares := sdk.NewDec(5) // this delegator has half the shares in the validator
-
-	di2 := NewDelegationDistInfo(delAddr2, valAddr1, height)
-	di2Shares := sdk.NewDec(5)
-
-	// simulate adding some stake for inflation
-	height = 10
-	fp.Pool = DecCoins{NewDecCoin("stake", 1000)}
-
-	// withdraw rewards
-	di1, vi, fp, rewardRecv1 := di1.WithdrawRewards(fp, vi, height, totalBondedTokens,
-		validatorTokens, validatorDelShares, di1Shares, commissionRate)
-
-	assert.Equal(t, height, di1.WithdrawalHeight)
-	assert.True(sdk.DecEq(t, sdk.NewDec(900), fp.ValAccum.Accum))
-	assert.True(sdk.DecEq(t, sdk.NewDec(900), fp.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(49), vi.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(2), vi.PoolCommission[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(49), rewardRecv1[0].Amount))
-
-	// add more blocks and inflation
-	height = 20
-	fp.Pool[0].Amount = fp.Pool[0].Amount.Add(sdk.NewDec(1000))
-
-	// withdraw rewards
-	di2, vi, fp, rewardRecv2 := di2.WithdrawRewards(fp, vi, height, totalBondedTokens,
-		validatorTokens, validatorDelShares, di2Shares, commissionRate)
-
-	assert.Equal(t, height, di2.WithdrawalHeight)
-	assert.True(sdk.DecEq(t, sdk.NewDec(1800), fp.ValAccum.Accum))
-	assert.True(sdk.DecEq(t, sdk.NewDec(1800), fp.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(49), vi.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(4), vi.PoolCommission[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(98), rewardRecv2[0].Amount))
+	di := NewDelegationDistInfo(delAddr1, valAddr1, 1, 10, sdk.NewDec(5))
+	startingRatio := sdk.DecCoins{{"stake", sdk.NewDecWithPrec(25, 1)}}
+	endingRatio := sdk.DecCoins{
+		{"atom", sdk.NewDecWithPrec(1, 1)},
+		{"stake", sdk.NewDecWithPrec(125, 1)},
+	}
+
+	rewards := RewardsBetween(startingRatio, endingRatio, di.Stake)
+	assert.True(sdk.DecEq(t, sdk.NewDecWithPrec(5, 1), rewards.AmountOf("atom")))
+	assert.True(sdk.DecEq(t, sdk.NewDec(50), rewards.AmountOf("stake")))
+
+	// no rewards between the same periods
+	rewards = RewardsBetween(endingRatio, endingRatio, di.Stake)
+	assert.True(t, rewards.IsZero())
+
+	// the cumulative reward ratio never decreases
+	require.Panics(t, func() { RewardsBetween(endingRatio, startingRatio, di.Stake) })
 }
@@ -10,6 +10,8 @@ type CodeType = sdk.CodeType
 const (
 	DefaultCodespace sdk.CodespaceType = 6
 	CodeInvalidInput CodeType          = 103
+	CodeNoDistInfo   CodeType          = 104
+	CodeNoDelegation CodeType          = 105
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -21,3 +23,12 @@ func ErrNilWithdrawAddr(codespace sdk.CodespaceType) sdk.Error {
 func ErrNilValidatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "validator address is nil")
 }
+func ErrNoValidatorDistInfo(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDistInfo, "no validator distribution info found")
+}
+func ErrNoDelegationDistInfo(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDistInfo, "no delegation distribution info found")
+}
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "delegator has no delegation to auto-compound the rewards of")
+}
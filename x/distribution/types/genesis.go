@@ -2,66 +2,70 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-// the address for where distributions rewards are withdrawn to by default
+// the address for where distributions rewards are withdrawn to by default,
+// and whether the rewards in the bond denom are delegated again instead
 // this struct is only used at genesis to feed in default withdraw addresses
 type DelegatorWithdrawInfo struct {
 	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
 	WithdrawAddr  sdk.AccAddress `json:"withdraw_addr"`
+	AutoCompound  bool           `json:"auto_compound"`
+}
+
+// the historical rewards of a validator for one of its periods
+// this struct is only used at genesis to feed in the historical rewards
+type ValidatorHistoricalRewardsRecord struct {
+	ValidatorAddr sdk.ValAddress             `json:"validator_addr"`
+	Period        uint64                     `json:"period"`
+	Rewards       ValidatorHistoricalRewards `json:"rewards"`
+}
+
+// a slash event of a validator at a height
+// this struct is only used at genesis to feed in the slash events
+type ValidatorSlashEventRecord struct {
+	ValidatorAddr sdk.ValAddress      `json:"validator_addr"`
+	Height        int64               `json:"height"`
+	Event         ValidatorSlashEvent `json:"event"`
 }
 
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
-	FeePool                FeePool                 `json:"fee_pool"`
-	CommunityTax           sdk.Dec                 `json:"community_tax"`
-	BaseProposerReward     sdk.Dec                 `json:"base_proposer_reward"`
-	BonusProposerReward    sdk.Dec                 `json:"bonus_proposer_reward"`
-	ValidatorDistInfos     []ValidatorDistInfo     `json:"validator_dist_infos"`
-	DelegationDistInfos    []DelegationDistInfo    `json:"delegator_dist_infos"`
-	DelegatorWithdrawInfos []DelegatorWithdrawInfo `json:"delegator_withdraw_infos"`
+	FeePool                    FeePool                            `json:"fee_pool"`
+	CommunityTax               sdk.Dec                            `json:"community_tax"`
+	BaseProposerReward         sdk.Dec                            `json:"base_proposer_reward"`
+	BonusProposerReward        sdk.Dec                            `json:"bonus_proposer_reward"`
+	AutoCompoundInterval       int64                              `json:"auto_compound_interval"`
+	ValidatorDistInfos         []ValidatorDistInfo                `json:"validator_dist_infos"`
+	ValidatorHistoricalRewards []ValidatorHistoricalRewardsRecord `json:"validator_historical_rewards"`
+	ValidatorSlashEvents       []ValidatorSlashEventRecord        `json:"validator_slash_events"`
+	DelegationDistInfos        []DelegationDistInfo               `json:"delegator_dist_infos"`
+	DelegatorWithdrawInfos     []DelegatorWithdrawInfo            `json:"delegator_withdraw_infos"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
-	vdis []ValidatorDistInfo, ddis []DelegationDistInfo, dwis []DelegatorWithdrawInfo) GenesisState {
+	autoCompoundInterval int64, vdis []ValidatorDistInfo, vhrs []ValidatorHistoricalRewardsRecord,
+	vses []ValidatorSlashEventRecord, ddis []DelegationDistInfo, dwis []DelegatorWithdrawInfo) GenesisState {
 
 	return GenesisState{
-		FeePool:                feePool,
-		CommunityTax:           communityTax,
-		BaseProposerReward:     baseProposerReward,
-		BonusProposerReward:    bonusProposerReward,
-		ValidatorDistInfos:     vdis,
-		DelegationDistInfos:    ddis,
-		DelegatorWithdrawInfos: dwis,
+		FeePool:                    feePool,
+		CommunityTax:               communityTax,
+		BaseProposerReward:         baseProposerReward,
+		BonusProposerReward:        bonusProposerReward,
+		AutoCompoundInterval:       autoCompoundInterval,
+		ValidatorDistInfos:         vdis,
+		ValidatorHistoricalRewards: vhrs,
+		ValidatorSlashEvents:       vses,
+		DelegationDistInfos:        ddis,
+		DelegatorWithdrawInfos:     dwis,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		FeePool:             InitialFeePool(),
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-	}
-}
-
-// default genesis utility function, initialize for starting validator set
-func DefaultGenesisWithValidators(valAddrs []sdk.ValAddress) GenesisState {
-
-	vdis := make([]ValidatorDistInfo, len(valAddrs))
-	ddis := make([]DelegationDistInfo, len(valAddrs))
-
-	for i, valAddr := range valAddrs {
-		vdis[i] = NewValidatorDistInfo(valAddr, 0)
-		accAddr := sdk.AccAddress(valAddr)
-		ddis[i] = NewDelegationDistInfo(accAddr, valAddr, 0)
-	}
-
-	return GenesisState{
-		FeePool:             InitialFeePool(),
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		ValidatorDistInfos:  vdis,
-		DelegationDistInfos: ddis,
+		FeePool:              InitialFeePool(),
+		CommunityTax:         sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:   sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:  sdk.NewDecWithPrec(4, 2), // 4%
+		AutoCompoundInterval: 100,
 	}
 }
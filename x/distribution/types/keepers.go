@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
 
 // expected stake keeper
 type StakeKeeper interface {
@@ -9,7 +12,10 @@ type StakeKeeper interface {
 	Delegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Delegation
 	Validator(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Validator
 	ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.Validator
-	TotalPower(ctx sdk.Context) sdk.Dec
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stake.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Coin,
+		validator stake.Validator, subtractAccount bool) (newShares sdk.Dec, err sdk.Error)
+	BondDenom(ctx sdk.Context) string
 }
 
 // expected coin keeper
@@ -11,6 +11,7 @@ const MsgType = "distr"
 // Verify interface at compile time
 var _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorRewardsAll{}
 var _, _ sdk.Msg = &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorRewardsAll{}
+var _ sdk.Msg = &MsgSetAutoCompound{}
 
 //______________________________________________________________________
 
@@ -173,3 +174,44 @@ func (msg MsgWithdrawValidatorRewardsAll) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// msg struct for enabling or disabling the auto-compounding of the rewards of
+// a delegator
+type MsgSetAutoCompound struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	AutoCompound  bool           `json:"auto_compound"`
+}
+
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, autoCompound bool) MsgSetAutoCompound {
+	return MsgSetAutoCompound{
+		DelegatorAddr: delAddr,
+		AutoCompound:  autoCompound,
+	}
+}
+
+func (msg MsgSetAutoCompound) Type() string { return MsgType }
+func (msg MsgSetAutoCompound) Name() string { return "set_auto_compound" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgSetAutoCompound) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
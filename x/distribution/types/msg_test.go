@@ -91,3 +91,24 @@ func TestMsgWithdrawValidatorRewardsAll(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		autoCompound  bool
+		expectPass    bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.autoCompound)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -8,65 +8,83 @@ import (
 type ValidatorDistInfo struct {
 	OperatorAddr sdk.ValAddress `json:"operator_addr"`
 
-	FeePoolWithdrawalHeight int64    `json:"global_withdrawal_height"` // last height this validator withdrew from the global pool
-	Pool                    DecCoins `json:"pool"`                     // rewards owed to delegators, commission has already been charged (includes proposer reward)
-	PoolCommission          DecCoins `json:"pool_commission"`          // commission collected by this validator (pending withdrawal)
-
-	DelAccum TotalAccum `json:"del_accum"` // total proposer pool accumulation factor held by delegators
+	Period         uint64       `json:"period"`          // current period, closed whenever the validator's tokens change
+	CurrentRewards sdk.DecCoins `json:"current_rewards"` // rewards owed to delegators for the current period
+	Pool           sdk.DecCoins `json:"pool"`            // rewards owed to delegators, commission has already been charged (includes proposer reward)
+	PoolCommission sdk.DecCoins `json:"pool_commission"` // commission collected by this validator (pending withdrawal)
 }
 
-func NewValidatorDistInfo(operatorAddr sdk.ValAddress, currentHeight int64) ValidatorDistInfo {
+func NewValidatorDistInfo(operatorAddr sdk.ValAddress) ValidatorDistInfo {
 	return ValidatorDistInfo{
-		OperatorAddr:            operatorAddr,
-		FeePoolWithdrawalHeight: currentHeight,
-		Pool:           DecCoins{},
-		PoolCommission: DecCoins{},
-		DelAccum:       NewTotalAccum(currentHeight),
+		OperatorAddr:   operatorAddr,
+		Period:         1,
+		CurrentRewards: sdk.DecCoins{},
+		Pool:           sdk.DecCoins{},
+		PoolCommission: sdk.DecCoins{},
 	}
 }
 
-// update total delegator accumululation
-func (vi ValidatorDistInfo) UpdateTotalDelAccum(height int64, totalDelShares sdk.Dec) ValidatorDistInfo {
-	vi.DelAccum = vi.DelAccum.UpdateForNewHeight(height, totalDelShares)
+// allocate rewards to the validator and its delegators, charging the commission
+func (vi ValidatorDistInfo) AllocateRewards(rewards sdk.DecCoins, commissionRate sdk.Dec) ValidatorDistInfo {
+	commission := rewards.MulDec(commissionRate)
+	afterCommission := rewards.Minus(commission)
+
+	vi.PoolCommission = vi.PoolCommission.Plus(commission)
+	vi.CurrentRewards = vi.CurrentRewards.Plus(afterCommission)
+	vi.Pool = vi.Pool.Plus(afterCommission)
 	return vi
 }
 
-// move any available accumulated fees in the FeePool to the validator's pool
-func (vi ValidatorDistInfo) TakeFeePoolRewards(fp FeePool, height int64, totalBonded, vdTokens,
-	commissionRate sdk.Dec) (ValidatorDistInfo, FeePool) {
-
-	fp = fp.UpdateTotalValAccum(height, totalBonded)
-
-	if fp.ValAccum.Accum.IsZero() {
-		return vi, fp
+// close the current period, returning the rewards earned per token of the
+// validator during the period. The rewards of a validator without tokens
+// cannot be attributed to any delegation, they are removed from the pool and
+// returned as the remainder.
+func (vi ValidatorDistInfo) IncrementPeriod(tokens sdk.Dec) (vio ValidatorDistInfo, ratio, remainder sdk.DecCoins) {
+	ratio, remainder = sdk.DecCoins{}, sdk.DecCoins{}
+	if tokens.IsZero() {
+		remainder = vi.CurrentRewards
+		vi.Pool = vi.Pool.Minus(remainder)
+	} else {
+		ratio = vi.CurrentRewards.QuoDecTruncate(tokens)
 	}
 
-	// update the validators pool
-	blocks := height - vi.FeePoolWithdrawalHeight
-	vi.FeePoolWithdrawalHeight = height
-	accum := vdTokens.MulInt(sdk.NewInt(blocks))
-	withdrawalTokens := fp.Pool.MulDec(accum).QuoDec(fp.ValAccum.Accum)
-	remainingTokens := fp.Pool.Minus(withdrawalTokens)
-
-	commission := withdrawalTokens.MulDec(commissionRate)
-	afterCommission := withdrawalTokens.Minus(commission)
+	vi.CurrentRewards = sdk.DecCoins{}
+	vi.Period++
+	return vi, ratio, remainder
+}
 
-	fp.ValAccum.Accum = fp.ValAccum.Accum.Sub(accum)
-	fp.Pool = remainingTokens
-	vi.PoolCommission = vi.PoolCommission.Plus(commission)
-	vi.Pool = vi.Pool.Plus(afterCommission)
+// withdraw commission rewards
+func (vi ValidatorDistInfo) WithdrawCommission() (vio ValidatorDistInfo, withdrawn sdk.DecCoins) {
+	withdrawalTokens := vi.PoolCommission
+	vi.PoolCommission = sdk.DecCoins{} // zero
 
-	return vi, fp
+	return vi, withdrawalTokens
 }
 
-// withdraw commission rewards
-func (vi ValidatorDistInfo) WithdrawCommission(fp FeePool, height int64,
-	totalBonded, vdTokens, commissionRate sdk.Dec) (vio ValidatorDistInfo, fpo FeePool, withdrawn DecCoins) {
+//___________________________________________________________________________________________
 
-	vi, fp = vi.TakeFeePoolRewards(fp, height, totalBonded, vdTokens, commissionRate)
+// cumulative rewards per token of a validator at the end of one of its periods
+type ValidatorHistoricalRewards struct {
+	CumulativeRewardRatio sdk.DecCoins `json:"cumulative_reward_ratio"` // rewards earned per token since the validator was created
+	ReferenceCount        uint64       `json:"reference_count"`         // delegations and slash events starting at the period, plus one for the latest period
+}
 
-	withdrawalTokens := vi.PoolCommission
-	vi.PoolCommission = DecCoins{} // zero
+func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.DecCoins, referenceCount uint64) ValidatorHistoricalRewards {
+	return ValidatorHistoricalRewards{
+		CumulativeRewardRatio: cumulativeRewardRatio,
+		ReferenceCount:        referenceCount,
+	}
+}
 
-	return vi, fp, withdrawalTokens
+// slash of a validator, which ends one of its periods
+type ValidatorSlashEvent struct {
+	ValidatorPeriod uint64  `json:"validator_period"` // period ended by the slash
+	Fraction        sdk.Dec `json:"fraction"`         // fraction of the validator's tokens which were slashed
+}
+
+func NewValidatorSlashEvent(validatorPeriod uint64, fraction sdk.Dec) ValidatorSlashEvent {
+	return ValidatorSlashEvent{
+		ValidatorPeriod: validatorPeriod,
+		Fraction:        fraction,
+	}
 }
@@ -8,77 +8,55 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestTakeFeePoolRewards(t *testing.T) {
+func TestAllocateRewards(t *testing.T) {
 
 	// initialize
-	height := int64(0)
-	fp := InitialFeePool()
-	vi1 := NewValidatorDistInfo(valAddr1, height)
-	vi2 := NewValidatorDistInfo(valAddr2, height)
-	vi3 := NewValidatorDistInfo(valAddr3, height)
-	commissionRate1 := sdk.NewDecWithPrec(2, 2)
-	commissionRate2 := sdk.NewDecWithPrec(3, 2)
-	commissionRate3 := sdk.NewDecWithPrec(4, 2)
-	validatorTokens1 := sdk.NewDec(10)
-	validatorTokens2 := sdk.NewDec(40)
-	validatorTokens3 := sdk.NewDec(50)
-	totalBondedTokens := validatorTokens1.Add(validatorTokens2).Add(validatorTokens3)
-
-	// simulate adding some stake for inflation
-	height = 10
-	fp.Pool = DecCoins{NewDecCoin("stake", 1000)}
+	vi := NewValidatorDistInfo(valAddr1)
+	commissionRate := sdk.NewDecWithPrec(2, 2)
+	require.Equal(t, uint64(1), vi.Period)
 
-	vi1, fp = vi1.TakeFeePoolRewards(fp, height, totalBondedTokens, validatorTokens1, commissionRate1)
-	require.True(sdk.DecEq(t, sdk.NewDec(900), fp.ValAccum.Accum))
-	assert.True(sdk.DecEq(t, sdk.NewDec(900), fp.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(100-2), vi1.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(2), vi1.PoolCommission[0].Amount))
+	vi = vi.AllocateRewards(sdk.DecCoins{sdk.NewDecCoin("stake", 100)}, commissionRate)
+	assert.True(sdk.DecEq(t, sdk.NewDec(100-2), vi.Pool[0].Amount))
+	assert.True(sdk.DecEq(t, sdk.NewDec(100-2), vi.CurrentRewards[0].Amount))
+	assert.True(sdk.DecEq(t, sdk.NewDec(2), vi.PoolCommission[0].Amount))
 
-	vi2, fp = vi2.TakeFeePoolRewards(fp, height, totalBondedTokens, validatorTokens2, commissionRate2)
-	require.True(sdk.DecEq(t, sdk.NewDec(500), fp.ValAccum.Accum))
-	assert.True(sdk.DecEq(t, sdk.NewDec(500), fp.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(400-12), vi2.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, vi2.PoolCommission[0].Amount, sdk.NewDec(12)))
+	vi = vi.AllocateRewards(sdk.DecCoins{sdk.NewDecCoin("stake", 50)}, commissionRate)
+	assert.True(sdk.DecEq(t, sdk.NewDec(150-3), vi.Pool[0].Amount))
+	assert.True(sdk.DecEq(t, sdk.NewDec(150-3), vi.CurrentRewards[0].Amount))
+	assert.True(sdk.DecEq(t, sdk.NewDec(3), vi.PoolCommission[0].Amount))
+}
 
-	// add more blocks and inflation
-	height = 20
-	fp.Pool[0].Amount = fp.Pool[0].Amount.Add(sdk.NewDec(1000))
+func TestIncrementPeriod(t *testing.T) {
 
-	vi3, fp = vi3.TakeFeePoolRewards(fp, height, totalBondedTokens, validatorTokens3, commissionRate3)
-	require.True(sdk.DecEq(t, sdk.NewDec(500), fp.ValAccum.Accum))
-	assert.True(sdk.DecEq(t, sdk.NewDec(500), fp.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(1000-40), vi3.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, vi3.PoolCommission[0].Amount, sdk.NewDec(40)))
+	// initialize
+	vi := NewValidatorDistInfo(valAddr1)
+	vi = vi.AllocateRewards(sdk.DecCoins{sdk.NewDecCoin("stake", 100)}, sdk.ZeroDec())
+
+	// the rewards of the period are split over the tokens
+	vi, ratio, remainder := vi.IncrementPeriod(sdk.NewDec(40))
+	require.Equal(t, uint64(2), vi.Period)
+	assert.True(sdk.DecEq(t, sdk.NewDecWithPrec(25, 1), ratio.AmountOf("stake")))
+	assert.True(t, remainder.IsZero())
+	assert.True(t, vi.CurrentRewards.IsZero())
+	assert.True(sdk.DecEq(t, sdk.NewDec(100), vi.Pool.AmountOf("stake")))
+
+	// the rewards of a validator without tokens are removed from its pool
+	vi = vi.AllocateRewards(sdk.DecCoins{sdk.NewDecCoin("stake", 10)}, sdk.ZeroDec())
+	vi, ratio, remainder = vi.IncrementPeriod(sdk.ZeroDec())
+	require.Equal(t, uint64(3), vi.Period)
+	assert.True(t, ratio.IsZero())
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), remainder.AmountOf("stake")))
+	assert.True(sdk.DecEq(t, sdk.NewDec(100), vi.Pool.AmountOf("stake")))
 }
 
 func TestWithdrawCommission(t *testing.T) {
 
 	// initialize
-	height := int64(0)
-	fp := InitialFeePool()
-	vi := NewValidatorDistInfo(valAddr1, height)
+	vi := NewValidatorDistInfo(valAddr1)
 	commissionRate := sdk.NewDecWithPrec(2, 2)
-	validatorTokens := sdk.NewDec(10)
-	totalBondedTokens := validatorTokens.Add(sdk.NewDec(90)) // validator-1 is 10% of total power
-
-	// simulate adding some stake for inflation
-	height = 10
-	fp.Pool = DecCoins{NewDecCoin("stake", 1000)}
-
-	// for a more fun staring condition, have an non-withdraw update
-	vi, fp = vi.TakeFeePoolRewards(fp, height, totalBondedTokens, validatorTokens, commissionRate)
-	require.True(sdk.DecEq(t, sdk.NewDec(900), fp.ValAccum.Accum))
-	assert.True(sdk.DecEq(t, sdk.NewDec(900), fp.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(100-2), vi.Pool[0].Amount))
-	assert.True(sdk.DecEq(t, sdk.NewDec(2), vi.PoolCommission[0].Amount))
-
-	// add more blocks and inflation
-	height = 20
-	fp.Pool[0].Amount = fp.Pool[0].Amount.Add(sdk.NewDec(1000))
+	vi = vi.AllocateRewards(sdk.DecCoins{sdk.NewDecCoin("stake", 200)}, commissionRate)
 
-	vi, fp, commissionRecv := vi.WithdrawCommission(fp, height, totalBondedTokens, validatorTokens, commissionRate)
-	require.True(sdk.DecEq(t, sdk.NewDec(1800), fp.ValAccum.Accum))
-	assert.True(sdk.DecEq(t, sdk.NewDec(1800), fp.Pool[0].Amount))
+	vi, commissionRecv := vi.WithdrawCommission()
 	assert.True(sdk.DecEq(t, sdk.NewDec(200-4), vi.Pool[0].Amount))
 	assert.Zero(t, len(vi.PoolCommission))
 	assert.True(sdk.DecEq(t, sdk.NewDec(4), commissionRecv[0].Amount))
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -292,8 +293,14 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			strProposalStatus := viper.GetString(flagStatus)
 			latestProposalsIDs := viper.GetInt64(flagLatestProposalIDs)
 
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+
 			params := gov.QueryProposalsParams{
 				NumLatestProposals: latestProposalsIDs,
+				Pagination:         page,
 			}
 
 			if len(bechDepositerAddr) != 0 {
@@ -332,20 +339,21 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			var matchingProposals []gov.Proposal
+			var matchingProposals gov.QueryProposalsResponse
 			err = cdc.UnmarshalJSON(res, &matchingProposals)
 			if err != nil {
 				return err
 			}
 
-			if len(matchingProposals) == 0 {
+			if len(matchingProposals.Proposals) == 0 {
 				fmt.Println("No matching proposals found")
 				return nil
 			}
 
-			for _, proposal := range matchingProposals {
+			for _, proposal := range matchingProposals.Proposals {
 				fmt.Printf("  %d - %s\n", proposal.GetProposalID(), proposal.GetTitle())
 			}
+			utils.PrintNextPageHint(matchingProposals.Pagination)
 
 			return nil
 		},
@@ -356,7 +364,7 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
 	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status")
 
-	return cmd
+	return client.PaginationFlags(cmd)
 }
 
 // Command to Get a Proposal Information
@@ -408,8 +416,14 @@ func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			proposalID := viper.GetInt64(flagProposalID)
 
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+
 			params := gov.QueryVotesParams{
 				ProposalID: proposalID,
+				Pagination: page,
 			}
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
@@ -428,7 +442,7 @@ func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's votes are being queried")
 
-	return cmd
+	return client.PaginationFlags(cmd)
 }
 
 // Command to Get a specific Deposit Information
@@ -480,8 +494,14 @@ func GetCmdQueryDeposits(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			proposalID := viper.GetInt64(flagProposalID)
 
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+
 			params := gov.QueryDepositsParams{
 				ProposalID: proposalID,
+				Pagination: page,
 			}
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
@@ -500,7 +520,7 @@ func GetCmdQueryDeposits(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's deposits are being queried")
 
-	return cmd
+	return client.PaginationFlags(cmd)
 }
 
 // GetCmdQueryDeposits implements the command to query for proposal deposits.
@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+
+	gogrpc "google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sgrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// RegisterQueryService registers the gRPC query service of the governance
+// module, which runs the queries of the governance querier. The codec must
+// know the proposal types.
+func RegisterQueryService(srv *gogrpc.Server, cdc *codec.Codec, querier sgrpc.Querier) {
+	RegisterQueryServer(srv, queryServer{cdc: cdc, querier: querier})
+}
+
+type queryServer struct {
+	cdc     *codec.Codec
+	querier sgrpc.Querier
+}
+
+var _ QueryServer = queryServer{}
+
+func (s queryServer) query(ctx context.Context, endpoint string, params, res interface{}) error {
+	return sgrpc.Query(ctx, s.cdc, s.querier, "custom/gov/"+endpoint, params, res)
+}
+
+// Proposal implements QueryServer.
+func (s queryServer) Proposal(ctx context.Context, req *ProposalRequest) (*ProposalResponse, error) {
+	var proposal gov.Proposal
+	if err := s.query(ctx, gov.QueryProposal, gov.QueryProposalParams{ProposalID: req.ProposalId}, &proposal); err != nil {
+		return nil, err
+	}
+	return &ProposalResponse{Proposal: newProposal(proposal)}, nil
+}
+
+// Proposals implements QueryServer.
+func (s queryServer) Proposals(ctx context.Context, req *ProposalsRequest) (*ProposalsResponse, error) {
+	params, err := proposalsParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals gov.QueryProposalsResponse
+	if err := s.query(ctx, gov.QueryProposals, params, &proposals); err != nil {
+		return nil, err
+	}
+
+	res := &ProposalsResponse{}
+	for _, proposal := range proposals.Proposals {
+		res.Proposals = append(res.Proposals, newProposal(proposal))
+	}
+	return res, nil
+}
+
+// Deposits implements QueryServer.
+func (s queryServer) Deposits(ctx context.Context, req *ProposalPageRequest) (*DepositsResponse, error) {
+	params := gov.QueryDepositsParams{ProposalID: req.ProposalId, Pagination: req.Pagination.SDKPageRequest()}
+	var deposits gov.QueryDepositsResponse
+	if err := s.query(ctx, gov.QueryDeposits, params, &deposits); err != nil {
+		return nil, err
+	}
+
+	res := &DepositsResponse{Pagination: sgrpc.NewPageResponse(deposits.Pagination)}
+	for _, deposit := range deposits.Deposits {
+		res.Deposits = append(res.Deposits, newDeposit(deposit))
+	}
+	return res, nil
+}
+
+// Deposit implements QueryServer.
+func (s queryServer) Deposit(ctx context.Context, req *DepositRequest) (*DepositResponse, error) {
+	depositer, err := sdk.AccAddressFromBech32(req.Depositer)
+	if err != nil {
+		return nil, sgrpc.InvalidArgument(err)
+	}
+
+	var deposit gov.Deposit
+	params := gov.QueryDepositParams{ProposalID: req.ProposalId, Depositer: depositer}
+	if err := s.query(ctx, gov.QueryDeposit, params, &deposit); err != nil {
+		return nil, err
+	}
+	return &DepositResponse{Deposit: newDeposit(deposit)}, nil
+}
+
+// Votes implements QueryServer.
+func (s queryServer) Votes(ctx context.Context, req *ProposalPageRequest) (*VotesResponse, error) {
+	params := gov.QueryVotesParams{ProposalID: req.ProposalId, Pagination: req.Pagination.SDKPageRequest()}
+	var votes gov.QueryVotesResponse
+	if err := s.query(ctx, gov.QueryVotes, params, &votes); err != nil {
+		return nil, err
+	}
+
+	res := &VotesResponse{Pagination: sgrpc.NewPageResponse(votes.Pagination)}
+	for _, vote := range votes.Votes {
+		res.Votes = append(res.Votes, newVote(vote))
+	}
+	return res, nil
+}
+
+// Vote implements QueryServer.
+func (s queryServer) Vote(ctx context.Context, req *VoteRequest) (*VoteResponse, error) {
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, sgrpc.InvalidArgument(err)
+	}
+
+	var vote gov.Vote
+	params := gov.QueryVoteParams{ProposalID: req.ProposalId, Voter: voter}
+	if err := s.query(ctx, gov.QueryVote, params, &vote); err != nil {
+		return nil, err
+	}
+	return &VoteResponse{Vote: newVote(vote)}, nil
+}
+
+// Tally implements QueryServer.
+func (s queryServer) Tally(ctx context.Context, req *ProposalRequest) (*TallyResponse, error) {
+	var tally gov.TallyResult
+	if err := s.query(ctx, gov.QueryTally, gov.QueryTallyParams{ProposalID: req.ProposalId}, &tally); err != nil {
+		return nil, err
+	}
+	return &TallyResponse{Tally: newTallyResult(tally)}, nil
+}
+
+func proposalsParams(req *ProposalsRequest) (gov.QueryProposalsParams, error) {
+	params := gov.QueryProposalsParams{NumLatestProposals: req.Limit}
+
+	var err error
+	if req.Voter != "" {
+		if params.Voter, err = sdk.AccAddressFromBech32(req.Voter); err != nil {
+			return params, sgrpc.InvalidArgument(err)
+		}
+	}
+	if req.Depositer != "" {
+		if params.Depositer, err = sdk.AccAddressFromBech32(req.Depositer); err != nil {
+			return params, sgrpc.InvalidArgument(err)
+		}
+	}
+	if params.ProposalStatus, err = gov.ProposalStatusFromString(req.Status); err != nil {
+		return params, sgrpc.InvalidArgument(err)
+	}
+	return params, nil
+}
+
+// newProposal converts a proposal to its protobuf message.
+func newProposal(p gov.Proposal) *Proposal {
+	return &Proposal{
+		ProposalId:      p.GetProposalID(),
+		Title:           p.GetTitle(),
+		Description:     p.GetDescription(),
+		ProposalType:    ProposalKind(p.GetProposalType()),
+		Status:          ProposalStatus(p.GetStatus()),
+		TallyResult:     newTallyResult(p.GetTallyResult()),
+		SubmitTime:      sgrpc.NewTimestamp(p.GetSubmitTime()),
+		TotalDeposit:    sgrpc.NewCoins(p.GetTotalDeposit()),
+		VotingStartTime: sgrpc.NewTimestamp(p.GetVotingStartTime()),
+	}
+}
+
+func newTallyResult(t gov.TallyResult) *TallyResult {
+	return &TallyResult{
+		Yes:        t.Yes.String(),
+		Abstain:    t.Abstain.String(),
+		No:         t.No.String(),
+		NoWithVeto: t.NoWithVeto.String(),
+	}
+}
+
+func newDeposit(d gov.Deposit) *Deposit {
+	return &Deposit{
+		Depositer:  d.Depositer.String(),
+		ProposalId: d.ProposalID,
+		Amount:     sgrpc.NewCoins(d.Amount),
+	}
+}
+
+func newVote(v gov.Vote) *Vote {
+	return &Vote{
+		Voter:      v.Voter.String(),
+		ProposalId: v.ProposalID,
+		Option:     VoteOption(v.Option),
+	}
+}
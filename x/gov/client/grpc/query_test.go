@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func TestProposalQuery(t *testing.T) {
+	cdc := codec.New()
+	gov.RegisterCodec(cdc)
+
+	submitTime := time.Unix(100, 0).UTC()
+	var proposal gov.Proposal = &gov.TextProposal{
+		ProposalID:   1,
+		Title:        "title",
+		Description:  "description",
+		ProposalType: gov.ProposalTypeText,
+		Status:       gov.StatusVotingPeriod,
+		TallyResult:  gov.EmptyTallyResult(),
+		SubmitTime:   submitTime,
+		TotalDeposit: sdk.Coins{sdk.NewInt64Coin("steak", 10)},
+	}
+
+	s := queryServer{cdc: cdc, querier: func(path string, data []byte, height int64) ([]byte, int64, error) {
+		require.Equal(t, "custom/gov/"+gov.QueryProposal, path)
+		var params gov.QueryProposalParams
+		require.NoError(t, cdc.UnmarshalJSON(data, &params))
+		require.Equal(t, int64(1), params.ProposalID)
+		return cdc.MustMarshalJSON(proposal), 1, nil
+	}}
+
+	res, err := s.Proposal(context.Background(), &ProposalRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Proposal.ProposalId)
+	require.Equal(t, "title", res.Proposal.Title)
+	require.Equal(t, ProposalKind_PROPOSAL_TYPE_TEXT, res.Proposal.ProposalType)
+	require.Equal(t, ProposalStatus_STATUS_VOTING_PERIOD, res.Proposal.Status)
+	require.Equal(t, submitTime.Unix(), res.Proposal.SubmitTime.Seconds)
+	require.Len(t, res.Proposal.TotalDeposit, 1)
+	require.Equal(t, "10", res.Proposal.TotalDeposit[0].Amount)
+}
+
+func TestProposalsParams(t *testing.T) {
+	voter := sdk.AccAddress([]byte("voter_______________"))
+
+	params, err := proposalsParams(&ProposalsRequest{Voter: voter.String(), Status: "Passed", Limit: 3})
+	require.NoError(t, err)
+	require.Equal(t, gov.QueryProposalsParams{Voter: voter, ProposalStatus: gov.StatusPassed, NumLatestProposals: 3}, params)
+
+	_, err = proposalsParams(&ProposalsRequest{Status: "Unknown"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = proposalsParams(&ProposalsRequest{Depositer: "cosmos"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -209,8 +209,15 @@ func queryDepositsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 			return
 		}
 
+		page, err := utils.ParsePageRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		params := gov.QueryDepositsParams{
 			ProposalID: proposalID,
+			Pagination: page,
 		}
 
 		bz, err := cdc.MarshalJSON(params)
@@ -225,7 +232,14 @@ func queryDepositsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 			return
 		}
 
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		var deposits gov.QueryDepositsResponse
+		if err := cdc.UnmarshalJSON(res, &deposits); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.WritePageHeaders(w, page, deposits.Pagination)
+		utils.PostProcessResponse(w, cdc, deposits.Deposits, cliCtx.Indent)
 	}
 }
 
@@ -379,8 +393,15 @@ func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 			return
 		}
 
+		page, err := utils.ParsePageRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		params := gov.QueryVotesParams{
 			ProposalID: proposalID,
+			Pagination: page,
 		}
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
@@ -394,7 +415,14 @@ func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 			return
 		}
 
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		var votes gov.QueryVotesResponse
+		if err := cdc.UnmarshalJSON(res, &votes); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.WritePageHeaders(w, page, votes.Pagination)
+		utils.PostProcessResponse(w, cdc, votes.Votes, cliCtx.Indent)
 	}
 }
 
@@ -406,7 +434,12 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 		strProposalStatus := r.URL.Query().Get(RestProposalStatus)
 		strNumLatest := r.URL.Query().Get(RestNumLatest)
 
-		params := gov.QueryProposalsParams{}
+		page, err := utils.ParsePageRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := gov.QueryProposalsParams{Pagination: page}
 
 		if len(bechVoterAddr) != 0 {
 			voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
@@ -457,7 +490,14 @@ func queryProposalsWithParameterFn(cdc *codec.Codec, cliCtx context.CLIContext)
 			return
 		}
 
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		var proposals gov.QueryProposalsResponse
+		if err := cdc.UnmarshalJSON(res, &proposals); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.WritePageHeaders(w, page, proposals.Pagination)
+		utils.PostProcessResponse(w, cdc, proposals.Proposals, cliCtx.Indent)
 	}
 }
 
@@ -1,6 +1,9 @@
 package gov
 
 import (
+	"encoding/binary"
+	"errors"
+
 	codec "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -139,34 +142,113 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddr
 	}
 
 	for proposalID := maxProposalID - numLatest; proposalID < maxProposalID; proposalID++ {
-		if voterAddr != nil && len(voterAddr) != 0 {
-			_, found := keeper.GetVote(ctx, proposalID, voterAddr)
-			if !found {
-				continue
-			}
+		proposal := keeper.getProposalFiltered(ctx, proposalID, voterAddr, depositerAddr, status)
+		if proposal == nil {
+			continue
 		}
+		matchingProposals = append(matchingProposals, proposal)
+	}
+	return matchingProposals
+}
+
+// GetProposalsPaginated returns the page of the proposals matching the
+// filters of GetProposalsFiltered, ordered by ID. Proposals are not stored in
+// the order of their IDs, hence they are looked up ID by ID and page keys are
+// big-endian proposal IDs. Only matching proposals count towards the offset,
+// the limit and the total.
+func (keeper Keeper) GetProposalsPaginated(ctx sdk.Context, voterAddr sdk.AccAddress, depositerAddr sdk.AccAddress,
+	status ProposalStatus, numLatest int64, page sdk.PageRequest) (proposals []Proposal, res sdk.PageResponse, err error) {
+
+	if err := page.ValidateBasic(); err != nil {
+		return nil, res, err
+	}
+	if len(page.Key) > 0 && len(page.Key) != 8 {
+		return nil, res, errors.New("invalid page key")
+	}
+	limit := page.Limit
+	if limit == 0 {
+		limit = sdk.DefaultPageLimit
+	}
 
-		if depositerAddr != nil && len(depositerAddr) != 0 {
-			_, found := keeper.GetDeposit(ctx, proposalID, depositerAddr)
-			if !found {
-				continue
-			}
+	maxProposalID, errSdk := keeper.peekCurrentProposalID(ctx)
+	if errSdk != nil {
+		return nil, res, errSdk
+	}
+	minProposalID := int64(0)
+	if numLatest > 0 && numLatest < maxProposalID {
+		minProposalID = maxProposalID - numLatest
+	}
+
+	// range of the IDs to look up, in the order of the page
+	first, last, step := minProposalID, maxProposalID-1, int64(1)
+	if page.Descending {
+		first, last, step = last, first, -1
+	}
+	if len(page.Key) > 0 {
+		// the page key cannot widen the range
+		if key := int64(binary.BigEndian.Uint64(page.Key)); key*step > first*step {
+			first = key
 		}
+	}
 
-		proposal := keeper.GetProposal(ctx, proposalID)
+	proposals = []Proposal{}
+	var count int64
+	for proposalID := first; proposalID*step <= last*step; proposalID += step {
+		proposal := keeper.getProposalFiltered(ctx, proposalID, voterAddr, depositerAddr, status)
 		if proposal == nil {
 			continue
 		}
 
-		if validProposalStatus(status) {
-			if proposal.GetStatus() != status {
-				continue
-			}
+		count++
+		switch {
+		case count <= page.Offset:
+			continue
+		case count <= page.Offset+limit:
+			proposals = append(proposals, proposal)
+			continue
+		case res.NextKey == nil:
+			res.NextKey = make([]byte, 8)
+			binary.BigEndian.PutUint64(res.NextKey, uint64(proposalID))
+		}
+		if !page.CountTotal {
+			break
 		}
+	}
 
-		matchingProposals = append(matchingProposals, proposal)
+	if page.CountTotal {
+		res.Total = count
 	}
-	return matchingProposals
+	return proposals, res, nil
+}
+
+// getProposalFiltered returns the proposal with the given ID if it matches
+// the filters, zero filters being ignored, or else nil.
+func (keeper Keeper) getProposalFiltered(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress,
+	depositerAddr sdk.AccAddress, status ProposalStatus) Proposal {
+
+	if len(voterAddr) != 0 {
+		_, found := keeper.GetVote(ctx, proposalID, voterAddr)
+		if !found {
+			return nil
+		}
+	}
+
+	if len(depositerAddr) != 0 {
+		_, found := keeper.GetDeposit(ctx, proposalID, depositerAddr)
+		if !found {
+			return nil
+		}
+	}
+
+	proposal := keeper.GetProposal(ctx, proposalID)
+	if proposal == nil {
+		return nil
+	}
+
+	if validProposalStatus(status) && proposal.GetStatus() != status {
+		return nil
+	}
+	return proposal
 }
 
 func (keeper Keeper) setInitialProposalID(ctx sdk.Context, proposalID int64) sdk.Error {
@@ -314,6 +396,19 @@ func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID int64) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, KeyVotesSubspace(proposalID))
 }
 
+// Gets a page of the votes on a specific proposal
+func (keeper Keeper) GetVotesPaginated(ctx sdk.Context, proposalID int64, page sdk.PageRequest) (votes []Vote, res sdk.PageResponse, err error) {
+	store := ctx.KVStore(keeper.storeKey)
+	votes = []Vote{}
+	res, err = sdk.Paginate(store, KeyVotesSubspace(proposalID), page, func(_, value []byte) error {
+		var vote Vote
+		keeper.cdc.MustUnmarshalBinary(value, &vote)
+		votes = append(votes, vote)
+		return nil
+	})
+	return votes, res, err
+}
+
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(KeyVote(proposalID, voterAddr))
@@ -391,6 +486,19 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID int64) sdk.Iterator
 	return sdk.KVStorePrefixIterator(store, KeyDepositsSubspace(proposalID))
 }
 
+// Gets a page of the deposits on a specific proposal
+func (keeper Keeper) GetDepositsPaginated(ctx sdk.Context, proposalID int64, page sdk.PageRequest) (deposits []Deposit, res sdk.PageResponse, err error) {
+	store := ctx.KVStore(keeper.storeKey)
+	deposits = []Deposit{}
+	res, err = sdk.Paginate(store, KeyDepositsSubspace(proposalID), page, func(_, value []byte) error {
+		var deposit Deposit
+		keeper.cdc.MustUnmarshalBinary(value, &deposit)
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	return deposits, res, err
+}
+
 // Returns and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
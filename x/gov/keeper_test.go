@@ -248,3 +248,56 @@ func TestProposalQueues(t *testing.T) {
 	require.Equal(t, keeper.ActiveProposalQueuePeek(ctx).GetProposalID(), proposal4.GetProposalID())
 	require.Equal(t, keeper.ActiveProposalQueuePop(ctx).GetProposalID(), proposal4.GetProposalID())
 }
+
+func TestGetProposalsPaginated(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	mapp.InitChainer(ctx, abci.RequestInitChain{})
+
+	// more than 10 proposals, as their keys do not sort by ID
+	for i := 0; i < 12; i++ {
+		proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+		if i%2 == 1 {
+			keeper.activateVotingPeriod(ctx, proposal)
+		}
+	}
+
+	proposalIDs := func(proposals []Proposal) (ids []int64) {
+		for _, proposal := range proposals {
+			ids = append(ids, proposal.GetProposalID())
+		}
+		return ids
+	}
+
+	proposals, res, err := keeper.GetProposalsPaginated(ctx, nil, nil, StatusNil, 0, sdk.PageRequest{Offset: 7, Limit: 4, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{8, 9, 10, 11}, proposalIDs(proposals))
+	require.Equal(t, int64(12), res.Total)
+
+	proposals, res, err = keeper.GetProposalsPaginated(ctx, nil, nil, StatusNil, 0, sdk.PageRequest{Key: res.NextKey, Limit: 4})
+	require.NoError(t, err)
+	require.Equal(t, []int64{12}, proposalIDs(proposals))
+	require.Nil(t, res.NextKey)
+
+	// only matching proposals are counted, in both orders
+	proposals, res, err = keeper.GetProposalsPaginated(ctx, nil, nil, StatusVotingPeriod, 0,
+		sdk.PageRequest{Limit: 4, CountTotal: true, Descending: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{12, 10, 8, 6}, proposalIDs(proposals))
+	require.Equal(t, int64(6), res.Total)
+
+	proposals, res, err = keeper.GetProposalsPaginated(ctx, nil, nil, StatusVotingPeriod, 0,
+		sdk.PageRequest{Key: res.NextKey, Limit: 4, Descending: true})
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 2}, proposalIDs(proposals))
+	require.Nil(t, res.NextKey)
+
+	// the latest proposals filter still applies
+	proposals, _, err = keeper.GetProposalsPaginated(ctx, nil, nil, StatusNil, 3, sdk.PageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 11, 12}, proposalIDs(proposals))
+
+	_, _, err = keeper.GetProposalsPaginated(ctx, nil, nil, StatusNil, 0, sdk.PageRequest{Key: []byte{1}})
+	require.Error(t, err)
+}
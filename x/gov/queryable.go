@@ -114,6 +114,13 @@ func queryVote(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 // Params for query 'custom/gov/deposits'
 type QueryDepositsParams struct {
 	ProposalID int64
+	Pagination sdk.PageRequest
+}
+
+// Response of query 'custom/gov/deposits'
+type QueryDepositsResponse struct {
+	Deposits   []Deposit        `json:"deposits"`
+	Pagination sdk.PageResponse `json:"pagination"`
 }
 
 // nolint: unparam
@@ -124,15 +131,12 @@ func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
 	}
 
-	var deposits []Deposit
-	depositsIterator := keeper.GetDeposits(ctx, params.ProposalID)
-	for ; depositsIterator.Valid(); depositsIterator.Next() {
-		deposit := Deposit{}
-		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), &deposit)
-		deposits = append(deposits, deposit)
+	deposits, page, err2 := keeper.GetDepositsPaginated(ctx, params.ProposalID, params.Pagination)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(err2.Error())
 	}
 
-	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, deposits)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, QueryDepositsResponse{Deposits: deposits, Pagination: page})
 	if err2 != nil {
 		panic("could not marshal result to JSON")
 	}
@@ -142,6 +146,13 @@ func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 // Params for query 'custom/gov/votes'
 type QueryVotesParams struct {
 	ProposalID int64
+	Pagination sdk.PageRequest
+}
+
+// Response of query 'custom/gov/votes'
+type QueryVotesResponse struct {
+	Votes      []Vote           `json:"votes"`
+	Pagination sdk.PageResponse `json:"pagination"`
 }
 
 // nolint: unparam
@@ -153,15 +164,12 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
 	}
 
-	var votes []Vote
-	votesIterator := keeper.GetVotes(ctx, params.ProposalID)
-	for ; votesIterator.Valid(); votesIterator.Next() {
-		vote := Vote{}
-		keeper.cdc.MustUnmarshalBinary(votesIterator.Value(), &vote)
-		votes = append(votes, vote)
+	votes, page, err2 := keeper.GetVotesPaginated(ctx, params.ProposalID, params.Pagination)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(err2.Error())
 	}
 
-	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, votes)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, QueryVotesResponse{Votes: votes, Pagination: page})
 	if err2 != nil {
 		panic("could not marshal result to JSON")
 	}
@@ -174,6 +182,13 @@ type QueryProposalsParams struct {
 	Depositer          sdk.AccAddress
 	ProposalStatus     ProposalStatus
 	NumLatestProposals int64
+	Pagination         sdk.PageRequest
+}
+
+// Response of query 'custom/gov/proposals'
+type QueryProposalsResponse struct {
+	Proposals  []Proposal       `json:"proposals"`
+	Pagination sdk.PageResponse `json:"pagination"`
 }
 
 // nolint: unparam
@@ -184,9 +199,13 @@ func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
 	}
 
-	proposals := keeper.GetProposalsFiltered(ctx, params.Voter, params.Depositer, params.ProposalStatus, params.NumLatestProposals)
+	proposals, page, err2 := keeper.GetProposalsPaginated(ctx, params.Voter, params.Depositer, params.ProposalStatus,
+		params.NumLatestProposals, params.Pagination)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(err2.Error())
+	}
 
-	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, proposals)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, QueryProposalsResponse{Proposals: proposals, Pagination: page})
 	if err2 != nil {
 		panic("could not marshal result to JSON")
 	}
@@ -7,7 +7,9 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/cli"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec" // XXX fix
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -57,3 +59,86 @@ func GetCmdQuerySigningInfo(storeName string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySigningInfos implements the command to query the signing info of
+// all validators.
+func GetCmdQuerySigningInfos(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "Query the signing information of all validators, including their missed blocks counter",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(slashing.QuerySigningInfosParams{Pagination: page})
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, slashing.QuerySigningInfos), bz)
+			if err != nil {
+				return err
+			}
+
+			var signingInfos slashing.QuerySigningInfosResponse
+			if err := cdc.UnmarshalJSON(res, &signingInfos); err != nil {
+				return err
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, signingInfos.SigningInfos)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			utils.PrintNextPageHint(signingInfos.Pagination)
+			return nil
+		},
+	}
+
+	return client.PaginationFlags(cmd)
+}
+
+// GetCmdQueryMissedBlocks implements the command to query the missed blocks of
+// a validator within the signed blocks window.
+func GetCmdQueryMissedBlocks(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "missed-blocks [validator-pubkey]",
+		Short: "Query the blocks a validator missed within the signed blocks window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := slashing.QueryValidatorParams{ConsAddr: sdk.ConsAddress(pk.Address())}
+			return queryAndPrint(cdc, queryRoute, slashing.QueryMissedBlocks, params)
+		},
+	}
+}
+
+func queryAndPrint(cdc *codec.Codec, queryRoute, endpoint string, params interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	var bz []byte
+	if params != nil {
+		var err error
+		bz, err = cdc.MarshalJSON(params)
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, endpoint), bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
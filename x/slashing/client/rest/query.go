@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -16,6 +17,16 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		"/slashing/signing_info/{validator}",
 		signingInfoHandlerFn(cliCtx, "slashing", cdc),
 	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/signing_infos",
+		signingInfosHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/missed_blocks/{validator}",
+		missedBlocksHandlerFn(cliCtx, cdc),
+	).Methods("GET")
 }
 
 // http request handler to query signing info
@@ -59,3 +70,62 @@ func signingInfoHandlerFn(cliCtx context.CLIContext, storeName string, cdc *code
 		w.Write(output)
 	}
 }
+
+// http request handler to query the signing info of all validators
+func signingInfosHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, err := utils.ParsePageRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(slashing.QuerySigningInfosParams{Pagination: page})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/slashing/%s", slashing.QuerySigningInfos), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var signingInfos slashing.QuerySigningInfosResponse
+		if err := cdc.UnmarshalJSON(res, &signingInfos); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.WritePageHeaders(w, page, signingInfos.Pagination)
+		utils.PostProcessResponse(w, cdc, signingInfos.SigningInfos, cliCtx.Indent)
+	}
+}
+
+// http request handler to query the missed blocks of a validator
+func missedBlocksHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pk, err := sdk.GetConsPubKeyBech32(mux.Vars(r)["validator"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := slashing.QueryValidatorParams{ConsAddr: sdk.ConsAddress(pk.Address())}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/slashing/%s", slashing.QueryMissedBlocks), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(res)
+	}
+}
@@ -16,6 +16,9 @@ const (
 	CodeValidatorJailed       CodeType = 102
 	CodeValidatorNotJailed    CodeType = 103
 	CodeMissingSelfDelegation CodeType = 104
+	CodeMissingSigningInfo    CodeType = 105
+	CodeValidatorTombstoned   CodeType = 106
+	CodeSelfDelegationTooLow  CodeType = 107
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -34,6 +37,18 @@ func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator not jailed, cannot be unjailed")
 }
 
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator tombstoned for double signing, cannot be unjailed")
+}
+
 func ErrMissingSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMissingSelfDelegation, "validator has no self-delegation; cannot be unjailed")
 }
+
+func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfDelegationTooLow, "validator's self delegation less than minimum; cannot be unjailed")
+}
+
+func ErrNoSigningInfoFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMissingSigningInfo, "no signing info found for that address")
+}
@@ -7,7 +7,17 @@ import (
 
 // GenesisState - all slashing state that must be provided at genesis
 type GenesisState struct {
-	Params Params
+	Params          Params
+	SigningInfos    []SigningInfo             `json:"signing_infos"`
+	SigningBitArray []SigningBitArrayEntry    `json:"signing_bit_array"`
+	SlashingPeriods []ValidatorSlashingPeriod `json:"slashing_periods"`
+}
+
+// entry of the signed blocks bit array of a validator
+type SigningBitArrayEntry struct {
+	ConsAddr sdk.ConsAddress `json:"cons_address"`
+	Index    int64           `json:"index"`
+	Signed   bool            `json:"signed"`
 }
 
 // HubDefaultGenesisState - default GenesisState used by Cosmos Hub
@@ -24,5 +34,56 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, sdata types.
 		keeper.addPubkey(ctx, validator.GetConsPubKey())
 	}
 
-	keeper.paramspace.SetParamSet(ctx, &data.Params)
+	for _, signingInfo := range data.SigningInfos {
+		keeper.setValidatorSigningInfo(ctx, signingInfo.ConsAddr, signingInfo.SigningInfo)
+	}
+
+	for _, entry := range data.SigningBitArray {
+		keeper.setValidatorSigningBitArray(ctx, entry.ConsAddr, entry.Index, entry.Signed)
+	}
+
+	for _, slashingPeriod := range data.SlashingPeriods {
+		keeper.addOrUpdateValidatorSlashingPeriod(ctx, slashingPeriod)
+	}
+
+	keeper.SetParams(ctx, data.Params)
+}
+
+// ValidateGenesis validates the slashing genesis state, i.e. that the params
+// are within bounds
+func ValidateGenesis(data GenesisState) error {
+	return validateParams(data.Params)
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper. The
+// GenesisState will contain the signing infos, including whether the
+// validators are tombstoned, the signed blocks bit arrays and the slashing
+// periods.
+func WriteGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	var params Params
+	keeper.paramspace.GetParamSet(ctx, &params)
+
+	signingInfos := []SigningInfo{}
+	signingBitArray := []SigningBitArrayEntry{}
+	keeper.iterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info ValidatorSigningInfo) (stop bool) {
+		signingInfos = append(signingInfos, SigningInfo{ConsAddr: address, SigningInfo: info})
+		keeper.iterateValidatorSigningBitArray(ctx, address, func(index int64, signed bool) (stop bool) {
+			signingBitArray = append(signingBitArray, SigningBitArrayEntry{ConsAddr: address, Index: index, Signed: signed})
+			return false
+		})
+		return false
+	})
+
+	slashingPeriods := []ValidatorSlashingPeriod{}
+	keeper.iterateValidatorSlashingPeriods(ctx, func(slashingPeriod ValidatorSlashingPeriod) (stop bool) {
+		slashingPeriods = append(slashingPeriods, slashingPeriod)
+		return false
+	})
+
+	return GenesisState{
+		Params:          params,
+		SigningInfos:    signingInfos,
+		SigningBitArray: signingBitArray,
+		SlashingPeriods: slashingPeriods,
+	}
 }
@@ -0,0 +1,65 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestExportAndInitGenesis(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	addr := sdk.ConsAddress(addrs[0])
+
+	info := NewValidatorSigningInfo(0, 3, DoubleSignJailEndTime, 2, 1)
+	info.Tombstoned = true
+	keeper.setValidatorSigningInfo(ctx, addr, info)
+	keeper.setValidatorSigningBitArray(ctx, addr, 0, true)
+	keeper.setValidatorSigningBitArray(ctx, addr, 2, true)
+	keeper.addOrUpdateValidatorSlashingPeriod(ctx, ValidatorSlashingPeriod{addr, 0, 0, sdk.NewDecWithPrec(5, 2)})
+
+	genesis := WriteGenesis(ctx, keeper)
+	require.Equal(t, DefaultParams().SignedBlocksWindow, genesis.Params.SignedBlocksWindow)
+	require.Equal(t, 1, len(genesis.SigningInfos))
+	require.Equal(t, 2, len(genesis.SigningBitArray))
+	require.Equal(t, 1, len(genesis.SlashingPeriods))
+
+	params := DefaultParams()
+	params.MaxEvidenceAge = time.Second
+	ctx, _, _, _, keeper = createTestInput(t, params)
+	InitGenesis(ctx, keeper, genesis, stake.GenesisState{})
+
+	require.Equal(t, DefaultParams().MaxEvidenceAge, keeper.MaxEvidenceAge(ctx))
+	imported, found := keeper.getValidatorSigningInfo(ctx, addr)
+	require.True(t, found)
+	require.True(t, imported.Tombstoned)
+	require.True(t, imported.JailedUntil.Equal(DoubleSignJailEndTime))
+	require.Equal(t, info.IndexOffset, imported.IndexOffset)
+	require.Equal(t, info.SignedBlocksCounter, imported.SignedBlocksCounter)
+	require.Equal(t, info.MissedBlocksCounter, imported.MissedBlocksCounter)
+	require.True(t, keeper.getValidatorSigningBitArray(ctx, addr, 0))
+	require.False(t, keeper.getValidatorSigningBitArray(ctx, addr, 1))
+	require.True(t, keeper.getValidatorSigningBitArray(ctx, addr, 2))
+	period := keeper.getValidatorSlashingPeriodForHeight(ctx, addr, 0)
+	require.True(t, period.SlashedSoFar.Equal(sdk.NewDecWithPrec(5, 2)))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	for _, fraction := range []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(-1), sdk.NewDecWithPrec(11, 1)} {
+		genesis := DefaultGenesisState()
+		genesis.Params.MissedBlocksWarningThresholds = []sdk.Dec{sdk.NewDecWithPrec(5, 1), fraction}
+		require.Error(t, ValidateGenesis(genesis), fraction.String())
+
+		ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+		require.Panics(t, func() { keeper.SetParams(ctx, genesis.Params) }, fraction.String())
+	}
+
+	genesis := DefaultGenesisState()
+	genesis.Params.MissedBlocksWarningThresholds = []sdk.Dec{sdk.OneDec()}
+	require.NoError(t, ValidateGenesis(genesis))
+}
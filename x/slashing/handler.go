@@ -34,6 +34,13 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 		return ErrValidatorNotJailed(k.codespace).Result()
 	}
 
+	// cannot be unjailed while the tokens of the self-delegation are below the
+	// minimum self-delegation, e.g. after being jailed for undelegating them
+	selfDelTokens := validator.GetDelegatorShareExRate().Mul(selfDel.GetShares()).TruncateInt()
+	if selfDelTokens.LT(validator.GetMinSelfDelegation()) {
+		return ErrSelfDelegationTooLowToUnjail(k.codespace).Result()
+	}
+
 	consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
 
 	info, found := k.getValidatorSigningInfo(ctx, consAddr)
@@ -41,6 +48,11 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 		return ErrNoValidatorForAddress(k.codespace).Result()
 	}
 
+	// cannot be unjailed if tombstoned
+	if info.Tombstoned {
+		return ErrValidatorTombstoned(k.codespace).Result()
+	}
+
 	// cannot be unjailed until out of jail
 	if ctx.BlockHeader().Time.Before(info.JailedUntil) {
 		return ErrValidatorJailed(k.codespace).Result()
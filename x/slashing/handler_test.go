@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 )
@@ -29,6 +31,60 @@ func TestCannotUnjailUnlessJailed(t *testing.T) {
 	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeValidatorNotJailed), got.Code)
 }
 
+func TestCannotUnjailTombstoned(t *testing.T) {
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, DefaultParams())
+	sk = sk.WithHooks(keeper.Hooks())
+	slh := NewHandler(keeper)
+	amtInt := int64(100)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(amtInt)
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	validatorUpdates := stake.EndBlocker(ctx, sk)
+	keeper.AddValidators(ctx, validatorUpdates)
+
+	// double sign
+	keeper.handleValidatorSignature(ctx, val.Address(), amtInt, true)
+	keeper.handleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), amtInt)
+	require.True(t, sk.Validator(ctx, addr).GetJailed())
+
+	// a tombstoned validator can't be unjailed, even long after
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(100 * 365 * 24 * time.Hour)})
+	got = slh(ctx, NewMsgUnjail(addr))
+	require.False(t, got.IsOK(), "allowed unjail of tombstoned validator")
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeValidatorTombstoned), got.Code)
+}
+
+func TestCannotUnjailSelfDelegationTooLow(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, DefaultParams())
+	slh := NewHandler(keeper)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(100)
+	msg := NewTestMsgCreateValidator(addr, val, amt)
+	msg.MinSelfDelegation = sdk.NewInt(50)
+	got := stake.NewHandler(sk)(ctx, msg)
+	require.True(t, got.IsOK())
+	stake.EndBlocker(ctx, sk)
+
+	consAddr := sdk.ConsAddress(val.Address())
+	keeper.setValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(0, 0, time.Unix(0, 0), 0, 0))
+
+	// unbonding below the minimum self-delegation jails the validator
+	got = stake.NewHandler(sk)(ctx, stake.NewMsgBeginUnbonding(sdk.AccAddress(addr), addr, sdk.NewDec(60)))
+	require.True(t, got.IsOK(), "%v", got)
+	require.True(t, sk.Validator(ctx, addr).GetJailed())
+
+	got = slh(ctx, NewMsgUnjail(addr))
+	require.False(t, got.IsOK(), "allowed unjail of validator with too low self-delegation")
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSelfDelegationTooLow), got.Code)
+
+	// self-delegating back to the minimum allows unjailing
+	got = stake.NewHandler(sk)(ctx, newTestMsgDelegate(sdk.AccAddress(addr), addr, sdk.NewInt(10)))
+	require.True(t, got.IsOK(), "%v", got)
+	got = slh(ctx, NewMsgUnjail(addr))
+	require.True(t, got.IsOK(), "%v", got)
+	require.False(t, sk.Validator(ctx, addr).GetJailed())
+}
+
 func TestJailedValidatorDelegations(t *testing.T) {
 	ctx, _, stakeKeeper, _, slashingKeeper := createTestInput(t, DefaultParams())
 
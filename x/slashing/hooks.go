@@ -22,6 +22,44 @@ func (k Keeper) onValidatorBeginUnbonding(ctx sdk.Context, address sdk.ConsAddre
 	k.addOrUpdateValidatorSlashingPeriod(ctx, slashingPeriod)
 }
 
+// Move the signing info, signed block bit array and slashing periods of a
+// validator to its new consensus address when its consensus pubkey is rotated
+func (k Keeper) onValidatorConsPubKeyRotated(ctx sdk.Context, oldAddress, newAddress sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	signInfo, found := k.getValidatorSigningInfo(ctx, oldAddress)
+	if found {
+		k.setValidatorSigningInfo(ctx, newAddress, signInfo)
+		store.Delete(GetValidatorSigningInfoKey(oldAddress))
+	}
+
+	// the bit array keys end with the index in the signed blocks window
+	oldPrefix := GetValidatorSigningBitArrayPrefix(oldAddress)
+	var bitArrayKeys, bitArrayValues [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, oldPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		bitArrayKeys = append(bitArrayKeys, iterator.Key())
+		bitArrayValues = append(bitArrayValues, iterator.Value())
+	}
+	iterator.Close()
+	for i, key := range bitArrayKeys {
+		store.Set(append(GetValidatorSigningBitArrayPrefix(newAddress), key[len(oldPrefix):]...), bitArrayValues[i])
+		store.Delete(key)
+	}
+
+	var slashingPeriods []ValidatorSlashingPeriod
+	iterator = sdk.KVStorePrefixIterator(store, GetValidatorSlashingPeriodPrefix(oldAddress))
+	for ; iterator.Valid(); iterator.Next() {
+		slashingPeriods = append(slashingPeriods, k.unmarshalSlashingPeriodKeyValue(iterator.Key(), iterator.Value()))
+	}
+	iterator.Close()
+	for _, slashingPeriod := range slashingPeriods {
+		store.Delete(GetValidatorSlashingPeriodKey(oldAddress, slashingPeriod.StartHeight))
+		slashingPeriod.ValidatorAddr = newAddress
+		k.addOrUpdateValidatorSlashingPeriod(ctx, slashingPeriod)
+	}
+}
+
 //_________________________________________________________________________________________
 
 // Wrapper struct
@@ -46,10 +84,17 @@ func (h Hooks) OnValidatorBeginUnbonding(ctx sdk.Context, address sdk.ConsAddres
 	h.k.onValidatorBeginUnbonding(ctx, address)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) OnValidatorConsPubKeyRotated(ctx sdk.Context, _ sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	h.k.onValidatorConsPubKeyRotated(ctx, oldConsAddr, newConsAddr)
+}
+
 // nolint - unused hooks
 func (h Hooks) OnValidatorCreated(_ sdk.Context, _ sdk.ValAddress)                           {}
 func (h Hooks) OnValidatorCommissionChange(_ sdk.Context, _ sdk.ValAddress)                  {}
 func (h Hooks) OnValidatorRemoved(_ sdk.Context, _ sdk.ValAddress)                           {}
+func (h Hooks) OnValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
 func (h Hooks) OnDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) OnDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h Hooks) OnDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)       {}
 func (h Hooks) OnDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,3 +25,24 @@ func TestHookOnValidatorBeginUnbonding(t *testing.T) {
 	period := keeper.getValidatorSlashingPeriodForHeight(ctx, addr, ctx.BlockHeight())
 	require.Equal(t, ValidatorSlashingPeriod{addr, ctx.BlockHeight(), ctx.BlockHeight(), sdk.ZeroDec()}, period)
 }
+
+func TestHookOnValidatorConsPubKeyRotated(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	oldAddr, newAddr := sdk.ConsAddress(addrs[0]), sdk.ConsAddress(addrs[1])
+	keeper.onValidatorBonded(ctx, oldAddr)
+	signInfo := NewValidatorSigningInfo(ctx.BlockHeight(), 1, time.Unix(0, 0), 1, 0)
+	keeper.setValidatorSigningInfo(ctx, oldAddr, signInfo)
+	keeper.setValidatorSigningBitArray(ctx, oldAddr, 0, true)
+
+	keeper.onValidatorConsPubKeyRotated(ctx, oldAddr, newAddr)
+
+	_, found := keeper.getValidatorSigningInfo(ctx, oldAddr)
+	require.False(t, found)
+	info, found := keeper.getValidatorSigningInfo(ctx, newAddr)
+	require.True(t, found)
+	require.Equal(t, signInfo, info)
+	require.False(t, keeper.getValidatorSigningBitArray(ctx, oldAddr, 0))
+	require.True(t, keeper.getValidatorSigningBitArray(ctx, newAddr, 0))
+	period := keeper.getValidatorSlashingPeriodForHeight(ctx, newAddr, ctx.BlockHeight())
+	require.Equal(t, ValidatorSlashingPeriod{newAddr, ctx.BlockHeight(), 0, sdk.ZeroDec()}, period)
+}
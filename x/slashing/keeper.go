@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -9,11 +10,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
 	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 )
 
+// Jail end time of the validators tombstoned for double signing
+var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
 // Keeper of the slashing store
 type Keeper struct {
 	storeKey     sdk.StoreKey
@@ -48,6 +53,9 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		panic(fmt.Sprintf("Validator consensus-address %v not found", consAddr))
 	}
 
+	// Evidence may be signed with a consensus pubkey the validator has since rotated
+	consAddr = k.currentConsAddr(ctx, consAddr)
+
 	// Double sign too old
 	maxEvidenceAge := k.MaxEvidenceAge(ctx)
 	if age > maxEvidenceAge {
@@ -55,6 +63,17 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		return
 	}
 
+	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
+	}
+
+	// Validator already tombstoned for a previous double sign
+	if signInfo.Tombstoned {
+		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator already tombstoned", pubkey.Address(), infractionHeight))
+		return
+	}
+
 	// Double sign confirmed
 	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d less than max age of %d", pubkey.Address(), infractionHeight, age, maxEvidenceAge))
 
@@ -64,14 +83,13 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	// That's fine since this is just used to filter unbonding delegations & redelegations.
 	distributionHeight := infractionHeight - stake.ValidatorUpdateDelay
 
-	// Cap the amount slashed to the penalty for the worst infraction
-	// within the slashing period when this infraction was committed
+	// Record the slash in the slashing period when this infraction was committed
 	fraction := k.SlashFractionDoubleSign(ctx)
-	revisedFraction := k.capBySlashingPeriod(ctx, consAddr, fraction, distributionHeight)
-	logger.Info(fmt.Sprintf("Fraction slashed capped by slashing period from %v to %v", fraction, revisedFraction))
+	k.setSlashedInSlashingPeriod(ctx, consAddr, fraction, distributionHeight)
 
 	// Slash validator
-	k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, revisedFraction)
+	power = k.bondedPowerAt(ctx, consAddr, distributionHeight, power)
+	k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, fraction)
 
 	// Jail validator if not already jailed
 	validator := k.validatorSet.ValidatorByConsAddr(ctx, consAddr)
@@ -79,18 +97,16 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		k.validatorSet.Jail(ctx, consAddr)
 	}
 
-	// Set or updated validator jail duration
-	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
-	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
-	}
-	signInfo.JailedUntil = time.Add(k.DoubleSignUnbondDuration(ctx))
+	// Tombstone the validator, it is jailed forever
+	signInfo.Tombstoned = true
+	signInfo.JailedUntil = DoubleSignJailEndTime
 	k.setValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
 // handle a validator signature, must be called once per validator per block
+// returns the downtime warning tags of the validator, if any
 // TODO refactor to take in a consensus address, additionally should maybe just take in the pubkey too
-func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) {
+func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) (resTags sdk.Tags) {
 	logger := ctx.Logger().With("module", "x/slashing")
 	height := ctx.BlockHeight()
 	consAddr := sdk.ConsAddress(addr)
@@ -98,12 +114,15 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	if err != nil {
 		panic(fmt.Sprintf("Validator consensus-address %v not found", consAddr))
 	}
+	// The validator keeps signing with a rotated consensus pubkey until the rotation
+	// takes effect in Tendermint
+	consAddr = k.currentConsAddr(ctx, consAddr)
 	// Local index, so counts blocks validator *should* have signed
 	// Will use the 0-value default signing info if not present, except for start height
 	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
 	if !found {
 		// If this validator has never been seen before, construct a new SigningInfo with the correct start height
-		signInfo = NewValidatorSigningInfo(height, 0, time.Unix(0, 0), 0)
+		signInfo = NewValidatorSigningInfo(height, 0, time.Unix(0, 0), 0, 0)
 	}
 	index := signInfo.IndexOffset % k.SignedBlocksWindow(ctx)
 	signInfo.IndexOffset++
@@ -127,6 +146,21 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	if !signed {
 		logger.Info(fmt.Sprintf("Absent validator %s at height %d, %d signed, threshold %d", addr, height, signInfo.SignedBlocksCounter, k.MinSignedPerWindow(ctx)))
 	}
+
+	// Tag a downtime warning when the missed blocks cross one of the warning
+	// thresholds, ahead of the validator being jailed
+	missedBefore := signInfo.MissedBlocksCounter
+	signInfo.MissedBlocksCounter = signInfo.missedBlocks(k.SignedBlocksWindow(ctx))
+	threshold, crossed := k.crossedMissedBlocksWarningThreshold(ctx, missedBefore, signInfo.MissedBlocksCounter)
+	if crossed {
+		logger.Info(fmt.Sprintf("Validator %s missed %d blocks, crossing the warning threshold of %s", consAddr, signInfo.MissedBlocksCounter, threshold))
+		resTags = sdk.NewTags(
+			tags.Action, tags.ActionDowntimeWarning,
+			tags.Validator, []byte(consAddr.String()),
+			tags.MissedBlocks, []byte(strconv.FormatInt(signInfo.MissedBlocksCounter, 10)),
+			tags.Threshold, []byte(threshold.String()),
+		)
+	}
 	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
 	if height > minHeight && signInfo.SignedBlocksCounter < k.MinSignedPerWindow(ctx) {
 		validator := k.validatorSet.ValidatorByConsAddr(ctx, consAddr)
@@ -140,6 +174,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - stake.ValidatorUpdateDelay - 1
+			power = k.bondedPowerAt(ctx, consAddr, distributionHeight, power)
 			k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
 			k.validatorSet.Jail(ctx, consAddr)
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeUnbondDuration(ctx))
@@ -152,6 +187,45 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 
 	// Set the updated signing info
 	k.setValidatorSigningInfo(ctx, consAddr, signInfo)
+	return resTags
+}
+
+// return the power to slash a validator on for an infraction: the power
+// reported by Tendermint is capped by the MaxVotingPowerFraction, so the
+// bonded tokens of the validator at the distribution height are used instead
+// when they are still known
+func (k Keeper) bondedPowerAt(ctx sdk.Context, consAddr sdk.ConsAddress, distributionHeight, reportedPower int64) int64 {
+	if power, found := k.validatorSet.ValidatorPowerAt(ctx, consAddr, distributionHeight); found {
+		return power
+	}
+	return reportedPower
+}
+
+// return the highest warning threshold crossed by the missed blocks of a
+// validator going from missedBefore to missedAfter
+func (k Keeper) crossedMissedBlocksWarningThreshold(ctx sdk.Context, missedBefore, missedAfter int64) (threshold sdk.Dec, crossed bool) {
+	maxMissed := k.SignedBlocksWindow(ctx) - k.MinSignedPerWindow(ctx)
+	for _, fraction := range k.MissedBlocksWarningThresholds(ctx) {
+		limit := sdk.NewDec(maxMissed).Mul(fraction).RoundInt64()
+		if limit < 1 {
+			limit = 1
+		}
+		if missedBefore < limit && missedAfter >= limit && (!crossed || fraction.GT(threshold)) {
+			threshold, crossed = fraction, true
+		}
+	}
+	return threshold, crossed
+}
+
+// The signing info and slashing periods of a validator are stored under its
+// current consensus address, returns that address for any of the current or
+// previous consensus addresses of a validator
+func (k Keeper) currentConsAddr(ctx sdk.Context, address sdk.ConsAddress) sdk.ConsAddress {
+	validator := k.validatorSet.ValidatorByConsAddr(ctx, address)
+	if validator == nil {
+		return address
+	}
+	return validator.GetConsAddr()
 }
 
 // AddValidators adds the validators to the keepers validator addr to pubkey mapping.
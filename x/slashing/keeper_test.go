@@ -1,13 +1,16 @@
 package slashing
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 // Have to change these parameters for tests
@@ -16,7 +19,6 @@ func keeperTestParams() Params {
 	params := DefaultParams()
 	params.SignedBlocksWindow = 1000
 	params.DowntimeUnbondDuration = 60 * 60
-	params.DoubleSignUnbondDuration = 60 * 60
 	return params
 }
 
@@ -65,9 +67,9 @@ func TestHandleDoubleSign(t *testing.T) {
 	)
 }
 
-// Test that the amount a validator is slashed for multiple double signs
-// is correctly capped by the slashing period in which they were committed
-func TestSlashingPeriodCap(t *testing.T) {
+// Test that a validator is tombstoned on its first double sign, and that
+// the evidence of further double signs is ignored
+func TestHandleDoubleSignTombstone(t *testing.T) {
 
 	// initial setup
 	ctx, ck, sk, _, keeper := createTestInput(t, DefaultParams())
@@ -88,8 +90,15 @@ func TestSlashingPeriodCap(t *testing.T) {
 
 	// double sign less than max age
 	keeper.handleDoubleSign(ctx, valConsAddr, 1, time.Unix(0, 0), amtInt)
-	// should be jailed
+	// should be jailed and tombstoned
 	require.True(t, sk.Validator(ctx, operatorAddr).GetJailed())
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(valConsAddr))
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+	require.Equal(t, DoubleSignJailEndTime, info.JailedUntil)
+	// the slash is recorded in the slashing period
+	period := keeper.getValidatorSlashingPeriodForHeight(ctx, sdk.ConsAddress(valConsAddr), 1)
+	require.Equal(t, keeper.SlashFractionDoubleSign(ctx), period.SlashedSoFar)
 	// end block
 	stake.EndBlocker(ctx, sk)
 	// update block height
@@ -102,35 +111,113 @@ func TestSlashingPeriodCap(t *testing.T) {
 	expectedPower := sdk.NewDecFromInt(amt).Mul(sdk.NewDec(19).Quo(sdk.NewDec(20)))
 	require.Equal(t, expectedPower, sk.Validator(ctx, operatorAddr).GetPower())
 
-	// double sign again, same slashing period
-	keeper.handleDoubleSign(ctx, valConsAddr, 1, time.Unix(0, 0), amtInt)
-	// should be jailed
-	require.True(t, sk.Validator(ctx, operatorAddr).GetJailed())
-	// end block
-	stake.EndBlocker(ctx, sk)
-	// update block height
-	ctx = ctx.WithBlockHeight(int64(3))
-	// unjail to measure power
-	sk.Unjail(ctx, sdk.ConsAddress(valConsAddr))
-	// end block
-	stake.EndBlocker(ctx, sk)
-	// power should be equal, no more should have been slashed
-	expectedPower = sdk.NewDecFromInt(amt).Mul(sdk.NewDec(19).Quo(sdk.NewDec(20)))
+	// double sign again, the evidence is ignored
+	keeper.handleDoubleSign(ctx, valConsAddr, 2, time.Unix(0, 0), amtInt)
+	// should not be jailed nor slashed
+	require.False(t, sk.Validator(ctx, operatorAddr).GetJailed())
 	require.Equal(t, expectedPower, sk.Validator(ctx, operatorAddr).GetPower())
+}
 
-	// double sign again, new slashing period
-	keeper.handleDoubleSign(ctx, valConsAddr, 3, time.Unix(0, 0), amtInt)
-	// should be jailed
+// Test that a validator whose power reported to Tendermint is capped is
+// slashed on its bonded tokens
+func TestHandleDoubleSignCappedPower(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, DefaultParams())
+	sk = sk.WithHooks(keeper.Hooks())
+	ctx = ctx.WithBlockHeight(1)
+	stakeParams := sk.GetParams(ctx)
+	stakeParams.MaxVotingPowerFraction = sdk.NewDecWithPrec(5, 1)
+	sk.SetParams(ctx, stakeParams)
+	amtInt := int64(100)
+	operatorAddr, amt := addrs[0], sdk.NewInt(amtInt)
+	valConsPubKey, valConsAddr := pks[0], pks[0].Address()
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, valConsPubKey, amt))
+	require.True(t, got.IsOK())
+	got = stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addrs[1], pks[1], sdk.NewInt(20)))
+	require.True(t, got.IsOK())
+	validatorUpdates := stake.EndBlocker(ctx, sk)
+	keeper.AddValidators(ctx, validatorUpdates)
+
+	// the power reported to Tendermint is capped
+	var cappedPower int64
+	for _, update := range validatorUpdates {
+		pk, err := tmtypes.PB2TM.PubKey(update.PubKey)
+		require.Nil(t, err)
+		if bytes.Equal(pk.Address(), valConsAddr) {
+			cappedPower = update.Power
+		}
+	}
+	require.True(t, cappedPower > 0 && cappedPower < amtInt)
+
+	// handle a signature to set signing info
+	ctx = ctx.WithBlockHeight(3)
+	keeper.handleValidatorSignature(ctx, valConsAddr, cappedPower, true)
+
+	// double sign with the capped power as evidence
+	keeper.handleDoubleSign(ctx, valConsAddr, 2, time.Unix(0, 0), cappedPower)
 	require.True(t, sk.Validator(ctx, operatorAddr).GetJailed())
-	// unjail to measure power
+
+	// the bonded tokens are slashed, not the capped power
 	sk.Unjail(ctx, sdk.ConsAddress(valConsAddr))
-	// end block
-	stake.EndBlocker(ctx, sk)
-	// power should be reduced
-	expectedPower = sdk.NewDecFromInt(amt).Mul(sdk.NewDec(18).Quo(sdk.NewDec(20)))
+	expectedPower := sdk.NewDecFromInt(amt).Mul(sdk.NewDec(19).Quo(sdk.NewDec(20)))
 	require.Equal(t, expectedPower, sk.Validator(ctx, operatorAddr).GetPower())
 }
 
+// Test that downtime warnings are tagged when the missed blocks of a
+// validator cross the warning thresholds
+func TestHandleAbsentValidatorWarnings(t *testing.T) {
+
+	// initial setup
+	params := keeperTestParams()
+	params.SignedBlocksWindow = 100
+	ctx, _, sk, _, keeper := createTestInput(t, params)
+	sk = sk.WithHooks(keeper.Hooks())
+	amtInt := int64(100)
+	addr, val, amt := addrs[0], pks[0], sdk.NewInt(amtInt)
+	got := stake.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	validatorUpdates := stake.EndBlocker(ctx, sk)
+	keeper.AddValidators(ctx, validatorUpdates)
+	consAddr := sdk.ConsAddress(val.Address())
+	height := int64(0)
+
+	// up to 50 blocks can be missed, the warnings are at 25, 38 and 45 blocks
+	warnings := make(map[int64]sdk.Tags)
+	for ; height < 45; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		resTags := keeper.handleValidatorSignature(ctx, val.Address(), amtInt, false)
+		if len(resTags) > 0 {
+			warnings[height+1] = resTags
+		}
+	}
+	require.Equal(t, 3, len(warnings))
+	expected := sdk.NewTags(
+		tags.Action, tags.ActionDowntimeWarning,
+		tags.Validator, []byte(consAddr.String()),
+		tags.MissedBlocks, []byte("25"),
+		tags.Threshold, []byte(sdk.NewDecWithPrec(5, 1).String()),
+	)
+	require.Equal(t, expected, warnings[25])
+	require.NotNil(t, warnings[45])
+	info, found := keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(45), info.MissedBlocksCounter)
+
+	// the missed blocks stay in the window until it wraps around
+	for ; height < keeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		resTags := keeper.handleValidatorSignature(ctx, val.Address(), amtInt, true)
+		require.Empty(t, resTags)
+	}
+	info, _ = keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(45), info.MissedBlocksCounter)
+	ctx = ctx.WithBlockHeight(height)
+	keeper.handleValidatorSignature(ctx, val.Address(), amtInt, true)
+	info, _ = keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(44), info.MissedBlocksCounter)
+}
+
 // Test a validator through uptime, downtime, revocation,
 // unrevocation, starting height reset, and revocation again
 func TestHandleAbsentValidator(t *testing.T) {
@@ -27,6 +27,11 @@ func GetValidatorSigningBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(ValidatorSigningBitArrayKey, append(v.Bytes(), b...)...)
 }
 
+// stored by *Tendermint* address (not operator address)
+func GetValidatorSigningBitArrayPrefix(v sdk.ConsAddress) []byte {
+	return append(ValidatorSigningBitArrayKey, v.Bytes()...)
+}
+
 // stored by *Tendermint* address (not operator address)
 func GetValidatorSlashingPeriodPrefix(v sdk.ConsAddress) []byte {
 	return append(ValidatorSlashingPeriodKey, v.Bytes()...)
@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,13 +15,14 @@ const (
 
 // Parameter store key
 var (
-	KeyMaxEvidenceAge           = []byte("MaxEvidenceAge")
-	KeySignedBlocksWindow       = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow       = []byte("MinSignedPerWindow")
-	KeyDoubleSignUnbondDuration = []byte("DoubleSignUnbondDuration")
-	KeyDowntimeUnbondDuration   = []byte("DowntimeUnbondDuration")
-	KeySlashFractionDoubleSign  = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime    = []byte("SlashFractionDowntime")
+	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
+	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
+	KeyDowntimeUnbondDuration  = []byte("DowntimeUnbondDuration")
+	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyMissedBlocksWarningThresholds = []byte("MissedBlocksWarningThresholds")
 )
 
 // ParamTypeTable for slashing module
@@ -30,13 +32,16 @@ func ParamTypeTable() params.TypeTable {
 
 // Params - used for initializing default parameter for slashing at genesis
 type Params struct {
-	MaxEvidenceAge           time.Duration `json:"max-evidence-age"`
-	SignedBlocksWindow       int64         `json:"signed-blocks-window"`
-	MinSignedPerWindow       sdk.Dec       `json:"min-signed-per-window"`
-	DoubleSignUnbondDuration time.Duration `json:"double-sign-unbond-duration"`
-	DowntimeUnbondDuration   time.Duration `json:"downtime-unbond-duration"`
-	SlashFractionDoubleSign  sdk.Dec       `json:"slash-fraction-double-sign"`
-	SlashFractionDowntime    sdk.Dec       `json:"slash-fraction-downtime"`
+	MaxEvidenceAge          time.Duration `json:"max-evidence-age"`
+	SignedBlocksWindow      int64         `json:"signed-blocks-window"`
+	MinSignedPerWindow      sdk.Dec       `json:"min-signed-per-window"`
+	DowntimeUnbondDuration  time.Duration `json:"downtime-unbond-duration"`
+	SlashFractionDoubleSign sdk.Dec       `json:"slash-fraction-double-sign"`
+	SlashFractionDowntime   sdk.Dec       `json:"slash-fraction-downtime"`
+
+	// fractions of the blocks a validator may miss within the signed blocks
+	// window before being jailed, at which a downtime warning is tagged
+	MissedBlocksWarningThresholds []sdk.Dec `json:"missed-blocks-warning-thresholds"`
 }
 
 // Implements params.ParamStruct
@@ -45,10 +50,10 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeyMaxEvidenceAge, &p.MaxEvidenceAge},
 		{KeySignedBlocksWindow, &p.SignedBlocksWindow},
 		{KeyMinSignedPerWindow, &p.MinSignedPerWindow},
-		{KeyDoubleSignUnbondDuration, &p.DoubleSignUnbondDuration},
 		{KeyDowntimeUnbondDuration, &p.DowntimeUnbondDuration},
 		{KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign},
 		{KeySlashFractionDowntime, &p.SlashFractionDowntime},
+		{KeyMissedBlocksWarningThresholds, &p.MissedBlocksWarningThresholds},
 	}
 }
 
@@ -59,9 +64,6 @@ func DefaultParams() Params {
 		// TODO Temporarily set to 2 minutes for testnets.
 		MaxEvidenceAge: 60 * 2 * time.Second,
 
-		// TODO Temporarily set to five minutes for testnets
-		DoubleSignUnbondDuration: 60 * 5 * time.Second,
-
 		// TODO Temporarily set to 100 blocks for testnets
 		SignedBlocksWindow: 100,
 
@@ -73,7 +75,31 @@ func DefaultParams() Params {
 		SlashFractionDoubleSign: sdk.NewDec(1).Quo(sdk.NewDec(20)),
 
 		SlashFractionDowntime: sdk.NewDec(1).Quo(sdk.NewDec(100)),
+
+		MissedBlocksWarningThresholds: []sdk.Dec{
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewDecWithPrec(75, 2),
+			sdk.NewDecWithPrec(9, 1),
+		},
+	}
+}
+
+// validateParams checks that the params are within bounds
+func validateParams(params Params) error {
+	for _, fraction := range params.MissedBlocksWarningThresholds {
+		if fraction.IsNil() || !fraction.GT(sdk.ZeroDec()) || fraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("slashing parameter MissedBlocksWarningThresholds must be in (0, 1], instead got %v", fraction)
+		}
+	}
+	return nil
+}
+
+// SetParams sets the params, which must be valid
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	if err := validateParams(params); err != nil {
+		panic(err)
 	}
+	k.paramspace.SetParamSet(ctx, &params)
 }
 
 // MaxEvidenceAge - Max age for evidence - 21 days (3 weeks)
@@ -97,12 +123,6 @@ func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
 	return sdk.NewDec(signedBlocksWindow).Mul(minSignedPerWindow).RoundInt64()
 }
 
-// Double-sign unbond duration
-func (k Keeper) DoubleSignUnbondDuration(ctx sdk.Context) (res time.Duration) {
-	k.paramspace.Get(ctx, KeyDoubleSignUnbondDuration, &res)
-	return
-}
-
 // Downtime unbond duration
 func (k Keeper) DowntimeUnbondDuration(ctx sdk.Context) (res time.Duration) {
 	k.paramspace.Get(ctx, KeyDowntimeUnbondDuration, &res)
@@ -120,3 +140,10 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	k.paramspace.Get(ctx, KeySlashFractionDowntime, &res)
 	return
 }
+
+// MissedBlocksWarningThresholds - fractions of the maximum missed blocks at
+// which a downtime warning is tagged - default 50%, 75% and 90%
+func (k Keeper) MissedBlocksWarningThresholds(ctx sdk.Context) (res []sdk.Dec) {
+	k.paramspace.Get(ctx, KeyMissedBlocksWarningThresholds, &res)
+	return
+}
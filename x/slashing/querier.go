@@ -0,0 +1,112 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the slashing Querier
+const (
+	QuerySigningInfos = "signing_infos"
+	QueryMissedBlocks = "missed_blocks"
+)
+
+// creates a querier for slashing REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QuerySigningInfos:
+			return querySigningInfos(ctx, req, k)
+		case QueryMissedBlocks:
+			return queryMissedBlocks(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
+		}
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/signing_infos'
+type QuerySigningInfosParams struct {
+	Pagination sdk.PageRequest
+}
+
+// defines the response of the following queries:
+// - 'custom/slashing/signing_infos'
+type QuerySigningInfosResponse struct {
+	SigningInfos []SigningInfo    `json:"signing_infos"`
+	Pagination   sdk.PageResponse `json:"pagination"`
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/missed_blocks'
+type QueryValidatorParams struct {
+	ConsAddr sdk.ConsAddress
+}
+
+// signing info of a validator, as returned by 'custom/slashing/signing_infos'
+type SigningInfo struct {
+	ConsAddr    sdk.ConsAddress      `json:"cons_address"`
+	SigningInfo ValidatorSigningInfo `json:"signing_info"`
+}
+
+// missed blocks of a validator, as returned by 'custom/slashing/missed_blocks'
+type MissedBlocks struct {
+	ConsAddr            sdk.ConsAddress `json:"cons_address"`
+	WindowSize          int64           `json:"window_size"`           // size of the signed blocks window
+	IndexOffset         int64           `json:"index_offset"`          // number of blocks the validator was expected to sign
+	WindowPosition      int64           `json:"window_position"`       // position in the window of the next block
+	MissedBlocksCounter int64           `json:"missed_blocks_counter"` // missed blocks within the window
+	MissedBlocks        []bool          `json:"missed_blocks"`         // whether each block of the window was missed, by position
+}
+
+func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QuerySigningInfosParams
+	if len(req.Data) > 0 {
+		errRes := k.cdc.UnmarshalJSON(req.Data, &params)
+		if errRes != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+		}
+	}
+
+	signingInfos, page, errRes := k.getValidatorSigningInfosPaginated(ctx, params.Pagination)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(errRes.Error())
+	}
+	return marshalQueryResult(k.cdc, QuerySigningInfosResponse{SigningInfos: signingInfos, Pagination: page})
+}
+
+func queryMissedBlocks(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryValidatorParams
+	errRes := k.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+	}
+
+	info, found := k.getValidatorSigningInfo(ctx, params.ConsAddr)
+	if !found {
+		return nil, ErrNoSigningInfoFound(k.codespace)
+	}
+
+	window := k.SignedBlocksWindow(ctx)
+	missedBlocks := MissedBlocks{
+		ConsAddr:            params.ConsAddr,
+		WindowSize:          window,
+		IndexOffset:         info.IndexOffset,
+		WindowPosition:      info.IndexOffset % window,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+		MissedBlocks:        k.getValidatorMissedBlocks(ctx, params.ConsAddr, info),
+	}
+	return marshalQueryResult(k.cdc, missedBlocks)
+}
+
+func marshalQueryResult(cdc *codec.Codec, result interface{}) (res []byte, err sdk.Error) {
+	res, errRes := codec.MarshalJSONIndent(cdc, result)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", errRes.Error()))
+	}
+	return res, nil
+}
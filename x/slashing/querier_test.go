@@ -0,0 +1,81 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQuerySigningInfos(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	querier := NewQuerier(keeper)
+
+	info0 := NewValidatorSigningInfo(0, 10, time.Unix(0, 0), 8, 2)
+	info1 := NewValidatorSigningInfo(5, 3, time.Unix(0, 0), 3, 0)
+	keeper.setValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]), info0)
+	keeper.setValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[1]), info1)
+
+	res, err := querier(ctx, []string{QuerySigningInfos}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var signingInfos QuerySigningInfosResponse
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &signingInfos))
+	require.Equal(t, 2, len(signingInfos.SigningInfos))
+	for _, signingInfo := range signingInfos.SigningInfos {
+		info, found := keeper.getValidatorSigningInfo(ctx, signingInfo.ConsAddr)
+		require.True(t, found)
+		require.Equal(t, info.MissedBlocksCounter, signingInfo.SigningInfo.MissedBlocksCounter)
+		require.Equal(t, info.SignedBlocksCounter, signingInfo.SigningInfo.SignedBlocksCounter)
+	}
+	require.Nil(t, signingInfos.Pagination.NextKey)
+
+	// page through the signing infos in descending order
+	bz, errRes := keeper.cdc.MarshalJSON(QuerySigningInfosParams{Pagination: sdk.PageRequest{Limit: 1, CountTotal: true, Descending: true}})
+	require.NoError(t, errRes)
+	res, err = querier(ctx, []string{QuerySigningInfos}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var page QuerySigningInfosResponse
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &page))
+	require.Equal(t, []SigningInfo{signingInfos.SigningInfos[1]}, page.SigningInfos)
+	require.Equal(t, int64(2), page.Pagination.Total)
+
+	bz, errRes = keeper.cdc.MarshalJSON(QuerySigningInfosParams{Pagination: sdk.PageRequest{Key: page.Pagination.NextKey, Descending: true}})
+	require.NoError(t, errRes)
+	res, err = querier(ctx, []string{QuerySigningInfos}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &page))
+	require.Equal(t, []SigningInfo{signingInfos.SigningInfos[0]}, page.SigningInfos)
+	require.Nil(t, page.Pagination.NextKey)
+}
+
+func TestQueryMissedBlocks(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	querier := NewQuerier(keeper)
+	consAddr := sdk.ConsAddress(addrs[0])
+
+	bz, errRes := keeper.cdc.MarshalJSON(QueryValidatorParams{ConsAddr: consAddr})
+	require.NoError(t, errRes)
+
+	// no signing info yet
+	_, err := querier(ctx, []string{QueryMissedBlocks}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, err)
+
+	// signed, missed, signed
+	keeper.setValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(0, 3, time.Unix(0, 0), 2, 1))
+	keeper.setValidatorSigningBitArray(ctx, consAddr, 0, true)
+	keeper.setValidatorSigningBitArray(ctx, consAddr, 2, true)
+
+	res, err := querier(ctx, []string{QueryMissedBlocks}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+	var missedBlocks MissedBlocks
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &missedBlocks))
+	require.Equal(t, keeper.SignedBlocksWindow(ctx), missedBlocks.WindowSize)
+	require.Equal(t, int64(3), missedBlocks.WindowPosition)
+	require.Equal(t, int64(1), missedBlocks.MissedBlocksCounter)
+	expected := make([]bool, keeper.SignedBlocksWindow(ctx))
+	expected[1] = true
+	require.Equal(t, expected, missedBlocks.MissedBlocks)
+}
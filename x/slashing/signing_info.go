@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -47,13 +48,76 @@ func (k Keeper) setValidatorSigningBitArray(ctx sdk.Context, address sdk.ConsAdd
 	store.Set(GetValidatorSigningBitArrayKey(address, index), bz)
 }
 
+// Iterate over the signed blocks bit array of a validator
+// Stored by *validator* address (not operator address)
+func (k Keeper) iterateValidatorSigningBitArray(ctx sdk.Context, address sdk.ConsAddress, handler func(index int64, signed bool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorSigningBitArrayPrefix(address)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		index := int64(binary.LittleEndian.Uint64(iterator.Key()[len(prefix):]))
+		var signed bool
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &signed)
+		if handler(index, signed) {
+			break
+		}
+	}
+}
+
+// Get the blocks missed by a validator, indexed by position in the signed
+// blocks window
+// Stored by *validator* address (not operator address)
+func (k Keeper) getValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress, info ValidatorSigningInfo) []bool {
+	window := k.SignedBlocksWindow(ctx)
+	missed := make([]bool, window)
+	for index := int64(0); index < window; index++ {
+		// positions of the window not reached yet have not been missed
+		if index >= info.IndexOffset {
+			break
+		}
+		missed[index] = !k.getValidatorSigningBitArray(ctx, address, index)
+	}
+	return missed
+}
+
+// Iterate over the signing infos of all validators
+func (k Keeper) iterateValidatorSigningInfos(ctx sdk.Context, handler func(address sdk.ConsAddress, info ValidatorSigningInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSigningInfoKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.ConsAddress(iterator.Key()[1:])
+		var info ValidatorSigningInfo
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &info)
+		if handler(address, info) {
+			break
+		}
+	}
+}
+
+// return the page of the signing infos of the validators selected by page,
+// ordered by consensus address
+func (k Keeper) getValidatorSigningInfosPaginated(ctx sdk.Context, page sdk.PageRequest) (signingInfos []SigningInfo, res sdk.PageResponse, err error) {
+	store := ctx.KVStore(k.storeKey)
+	signingInfos = []SigningInfo{}
+	res, err = sdk.Paginate(store, ValidatorSigningInfoKey, page, func(key, value []byte) error {
+		var info ValidatorSigningInfo
+		k.cdc.MustUnmarshalBinary(value, &info)
+		signingInfos = append(signingInfos, SigningInfo{ConsAddr: sdk.ConsAddress(key[1:]), SigningInfo: info})
+		return nil
+	})
+	return signingInfos, res, err
+}
+
 // Construct a new `ValidatorSigningInfo` struct
-func NewValidatorSigningInfo(startHeight int64, indexOffset int64, jailedUntil time.Time, signedBlocksCounter int64) ValidatorSigningInfo {
+func NewValidatorSigningInfo(startHeight int64, indexOffset int64, jailedUntil time.Time, signedBlocksCounter int64, missedBlocksCounter int64) ValidatorSigningInfo {
 	return ValidatorSigningInfo{
 		StartHeight:         startHeight,
 		IndexOffset:         indexOffset,
 		JailedUntil:         jailedUntil,
 		SignedBlocksCounter: signedBlocksCounter,
+		MissedBlocksCounter: missedBlocksCounter,
 	}
 }
 
@@ -63,10 +127,25 @@ type ValidatorSigningInfo struct {
 	IndexOffset         int64     `json:"index_offset"`          // index offset into signed block bit array
 	JailedUntil         time.Time `json:"jailed_until"`          // timestamp validator cannot be unjailed until
 	SignedBlocksCounter int64     `json:"signed_blocks_counter"` // signed blocks counter (to avoid scanning the array every time)
+	MissedBlocksCounter int64     `json:"missed_blocks_counter"` // missed blocks counter within the signed blocks window
+	Tombstoned          bool      `json:"tombstoned"`            // whether the validator double signed, it cannot be unjailed anymore
+}
+
+// Number of blocks missed within a signed blocks window of the given size,
+// only the blocks the validator was expected to sign are counted
+func (i ValidatorSigningInfo) missedBlocks(window int64) int64 {
+	expected := i.IndexOffset
+	if expected > window {
+		expected = window
+	}
+	if expected < i.SignedBlocksCounter {
+		return 0
+	}
+	return expected - i.SignedBlocksCounter
 }
 
 // Return human readable signing info
 func (i ValidatorSigningInfo) HumanReadableString() string {
-	return fmt.Sprintf("Start height: %d, index offset: %d, jailed until: %v, signed blocks counter: %d",
-		i.StartHeight, i.IndexOffset, i.JailedUntil, i.SignedBlocksCounter)
+	return fmt.Sprintf("Start height: %d, index offset: %d, jailed until: %v, signed blocks counter: %d, missed blocks counter: %d, tombstoned: %t",
+		i.StartHeight, i.IndexOffset, i.JailedUntil, i.SignedBlocksCounter, i.MissedBlocksCounter, i.Tombstoned)
 }
@@ -8,8 +8,14 @@ import (
 	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
-// Cap an infraction's slash amount by the slashing period in which it was committed
-func (k Keeper) capBySlashingPeriod(ctx sdk.Context, address sdk.ConsAddress, fraction sdk.Dec, infractionHeight int64) (revisedFraction sdk.Dec) {
+// Record the fraction slashed for a double sign in the slashing period in
+// which it was committed
+//
+// A validator is tombstoned on its first double sign and the evidence of any
+// further double sign is ignored, so a validator is slashed for at most one
+// double sign and the fraction no longer needs to be capped by the worst
+// infraction within the slashing period.
+func (k Keeper) setSlashedInSlashingPeriod(ctx sdk.Context, address sdk.ConsAddress, fraction sdk.Dec, infractionHeight int64) {
 
 	// Fetch the newest slashing period starting before this infraction was committed
 	slashingPeriod := k.getValidatorSlashingPeriodForHeight(ctx, address, infractionHeight)
@@ -19,18 +25,8 @@ func (k Keeper) capBySlashingPeriod(ctx sdk.Context, address sdk.ConsAddress, fr
 		panic(fmt.Sprintf("slashing period ended before infraction: validator %s, infraction height %d, slashing period ended at %d", address, infractionHeight, slashingPeriod.EndHeight))
 	}
 
-	// Calculate the updated total slash amount
-	// This is capped at the slashing fraction for the worst infraction within this slashing period
-	totalToSlash := sdk.MaxDec(slashingPeriod.SlashedSoFar, fraction)
-
-	// Calculate the remainder which we now must slash
-	revisedFraction = totalToSlash.Sub(slashingPeriod.SlashedSoFar)
-
-	// Update the slashing period struct
-	slashingPeriod.SlashedSoFar = totalToSlash
+	slashingPeriod.SlashedSoFar = fraction
 	k.addOrUpdateValidatorSlashingPeriod(ctx, slashingPeriod)
-
-	return
 }
 
 // Stored by validator Tendermint address (not operator address)
@@ -78,6 +74,19 @@ func (k Keeper) unmarshalSlashingPeriodKeyValue(key []byte, value []byte) Valida
 	}
 }
 
+// Iterate over the slashing periods of all validators
+func (k Keeper) iterateValidatorSlashingPeriods(ctx sdk.Context, handler func(slashingPeriod ValidatorSlashingPeriod) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSlashingPeriodKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		slashingPeriod := k.unmarshalSlashingPeriodKeyValue(iterator.Key(), iterator.Value())
+		if handler(slashingPeriod) {
+			break
+		}
+	}
+}
+
 // Construct a new `ValidatorSlashingPeriod` struct
 func NewValidatorSlashingPeriod(startHeight int64, endHeight int64, slashedSoFar sdk.Dec) ValidatorSlashingPeriod {
 	return ValidatorSlashingPeriod{
@@ -92,7 +101,7 @@ type ValidatorSlashingPeriod struct {
 	ValidatorAddr sdk.ConsAddress `json:"validator_addr"` // validator which this slashing period is for
 	StartHeight   int64           `json:"start_height"`   // starting height of the slashing period
 	EndHeight     int64           `json:"end_height"`     // ending height of the slashing period, or sentinel value of 0 for in-progress
-	SlashedSoFar  sdk.Dec         `json:"slashed_so_far"` // fraction of validator stake slashed so far in this slashing period
+	SlashedSoFar  sdk.Dec         `json:"slashed_so_far"` // fraction of validator stake slashed for a double sign in this slashing period
 }
 
 // Value part of slashing period (validator address & start height are stored in the key)
@@ -35,7 +35,7 @@ func TestGetSetValidatorSlashingPeriod(t *testing.T) {
 	// Get after end height (panic)
 	newPeriod.EndHeight = int64(4)
 	keeper.addOrUpdateValidatorSlashingPeriod(ctx, newPeriod)
-	require.Panics(t, func() { keeper.capBySlashingPeriod(ctx, addr, sdk.ZeroDec(), height) })
+	require.Panics(t, func() { keeper.setSlashedInSlashingPeriod(ctx, addr, sdk.ZeroDec(), height) })
 
 	// Back to old end height
 	newPeriod.EndHeight = height + 10
@@ -59,7 +59,7 @@ func TestGetSetValidatorSlashingPeriod(t *testing.T) {
 	require.Equal(t, anotherPeriod, retrieved)
 }
 
-func TestValidatorSlashingPeriodCap(t *testing.T) {
+func TestSetSlashedInSlashingPeriod(t *testing.T) {
 	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
 	addr := sdk.ConsAddress(addrs[0])
 	height := int64(5)
@@ -72,15 +72,8 @@ func TestValidatorSlashingPeriodCap(t *testing.T) {
 	keeper.addOrUpdateValidatorSlashingPeriod(ctx, newPeriod)
 	half := sdk.NewDec(1).Quo(sdk.NewDec(2))
 
-	// First slash should be full
-	fractionA := keeper.capBySlashingPeriod(ctx, addr, half, height)
-	require.True(t, fractionA.Equal(half))
-
-	// Second slash should be capped
-	fractionB := keeper.capBySlashingPeriod(ctx, addr, half, height)
-	require.True(t, fractionB.Equal(sdk.ZeroDec()))
-
-	// Third slash should be capped to difference
-	fractionC := keeper.capBySlashingPeriod(ctx, addr, sdk.OneDec(), height)
-	require.True(t, fractionC.Equal(half))
+	// The slash is recorded in the period of the infraction
+	keeper.setSlashedInSlashingPeriod(ctx, addr, half, height+1)
+	retrieved := keeper.getValidatorSlashingPeriodForHeight(ctx, addr, height)
+	require.True(t, retrieved.SlashedSoFar.Equal(half))
 }
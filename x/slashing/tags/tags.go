@@ -0,0 +1,15 @@
+// nolint
+package tags
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ActionDowntimeWarning = []byte("downtime-warning")
+
+	Action       = sdk.TagAction
+	Validator    = "validator"
+	MissedBlocks = "missed-blocks"
+	Threshold    = "threshold"
+)
@@ -90,7 +90,7 @@ func createTestInput(t *testing.T, defaults Params) (sdk.Context, bank.Keeper, s
 	keeper := NewKeeper(cdc, keySlashing, sk, paramstore, DefaultCodespace)
 
 	require.NotPanics(t, func() {
-		InitGenesis(ctx, keeper, GenesisState{defaults}, genesis)
+		InitGenesis(ctx, keeper, GenesisState{Params: defaults}, genesis)
 	})
 
 	return ctx, ck, sk, paramstore, keeper
@@ -114,12 +114,13 @@ func testAddr(addr string) sdk.AccAddress {
 func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) stake.MsgCreateValidator {
 	commission := stake.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	return stake.MsgCreateValidator{
-		Description:   stake.Description{},
-		Commission:    commission,
-		DelegatorAddr: sdk.AccAddress(address),
-		ValidatorAddr: address,
-		PubKey:        pubKey,
-		Delegation:    sdk.NewCoin("steak", amt),
+		Description:       stake.Description{},
+		Commission:        commission,
+		DelegatorAddr:     sdk.AccAddress(address),
+		ValidatorAddr:     address,
+		PubKey:            pubKey,
+		Delegation:        sdk.NewCoin("steak", amt),
+		MinSelfDelegation: sdk.OneInt(),
 	}
 }
 
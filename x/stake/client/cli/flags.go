@@ -12,10 +12,12 @@ const (
 	FlagAddressValidator    = "validator"
 	FlagAddressValidatorSrc = "addr-validator-source"
 	FlagAddressValidatorDst = "addr-validator-dest"
+	FlagAddressRecipient    = "recipient"
 	FlagPubKey              = "pubkey"
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesPercent       = "shares-percent"
+	FlagCreationHeight      = "creation-height"
 
 	FlagMoniker  = "moniker"
 	FlagIdentity = "identity"
@@ -25,6 +27,8 @@ const (
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
+
+	FlagMinSelfDelegation = "min-self-delegation"
 )
 
 // common flagsets to add to various functions
@@ -36,9 +40,12 @@ var (
 	fsCommissionCreate  = flag.NewFlagSet("", flag.ContinueOnError)
 	fsCommissionUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionEdit   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsMinSelfDelegation = flag.NewFlagSet("", flag.ContinueOnError)
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDelegator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsRecipient         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCreationHeight    = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -54,6 +61,7 @@ func init() {
 	fsCommissionCreate.String(FlagCommissionRate, "", "The initial commission rate percentage")
 	fsCommissionCreate.String(FlagCommissionMaxRate, "", "The maximum commission rate percentage")
 	fsCommissionCreate.String(FlagCommissionMaxChangeRate, "", "The maximum commission change rate percentage (per day)")
+	fsMinSelfDelegation.String(FlagMinSelfDelegation, "", "The minimum self delegation required on the validator")
 	fsDescriptionEdit.String(FlagMoniker, types.DoNotModifyDesc, "validator name")
 	fsDescriptionEdit.String(FlagIdentity, types.DoNotModifyDesc, "optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "optional website")
@@ -62,4 +70,6 @@ func init() {
 	fsDelegator.String(FlagAddressDelegator, "", "bech address of the delegator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "bech address of the source validator")
 	fsRedelegation.String(FlagAddressValidatorDst, "", "bech address of the destination validator")
+	fsRecipient.String(FlagAddressRecipient, "", "bech address of the recipient of the delegation")
+	fsCreationHeight.Int64(FlagCreationHeight, 0, "creation height of the unbonding delegation to cancel")
 }
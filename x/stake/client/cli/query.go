@@ -7,7 +7,9 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/cli"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -70,22 +72,29 @@ func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 		Use:   "validators",
 		Short: "Query for all validators",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			key := stake.ValidatorsKey
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			resKVs, err := cliCtx.QuerySubspace(key, storeName)
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(stake.QueryValidatorsParams{Pagination: page})
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
-			var validators []stake.Validator
-			for _, kv := range resKVs {
-				addr := kv.Key[1:]
-				validator := types.MustUnmarshalValidator(cdc, addr, kv.Value)
-				validators = append(validators, validator)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, stake.QueryValidators), bz)
+			if err != nil {
+				return err
 			}
 
+			var queryRes stake.QueryValidatorsResponse
+			if err := cdc.UnmarshalJSON(res, &queryRes); err != nil {
+				return err
+			}
+			validators := queryRes.Validators
+			defer utils.PrintNextPageHint(queryRes.Pagination)
+
 			switch viper.Get(cli.OutputFlag) {
 			case "text":
 				for _, validator := range validators {
@@ -111,7 +120,7 @@ func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
-	return cmd
+	return client.PaginationFlags(cmd)
 }
 
 // GetCmdQueryDelegation the query delegation command.
@@ -185,34 +194,41 @@ func GetCmdQueryDelegations(storeName string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			key := stake.GetDelegationsKey(delegatorAddr)
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			resKVs, err := cliCtx.QuerySubspace(key, storeName)
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(stake.QueryDelegatorPageParams{DelegatorAddr: delegatorAddr, Pagination: page})
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
-			var delegations []stake.Delegation
-			for _, kv := range resKVs {
-				delegation := types.MustUnmarshalDelegation(cdc, kv.Key, kv.Value)
-				delegations = append(delegations, delegation)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, stake.QueryDelegatorDelegations), bz)
+			if err != nil {
+				return err
 			}
 
-			output, err := codec.MarshalJSONIndent(cdc, delegations)
+			var queryRes stake.QueryDelegationsResponse
+			if err := cdc.UnmarshalJSON(res, &queryRes); err != nil {
+				return err
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, queryRes.Delegations)
 			if err != nil {
 				return err
 			}
 
 			fmt.Println(string(output))
+			utils.PrintNextPageHint(queryRes.Pagination)
 
 			// TODO: output with proofs / machine parseable etc.
 			return nil
 		},
 	}
 
-	return cmd
+	return client.PaginationFlags(cmd)
 }
 
 // GetCmdQueryUnbondingDelegation implements the command to query a single
@@ -284,34 +300,41 @@ func GetCmdQueryUnbondingDelegations(storeName string, cdc *codec.Codec) *cobra.
 				return err
 			}
 
-			key := stake.GetUBDsKey(delegatorAddr)
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			resKVs, err := cliCtx.QuerySubspace(key, storeName)
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(stake.QueryDelegatorPageParams{DelegatorAddr: delegatorAddr, Pagination: page})
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
-			var ubds []stake.UnbondingDelegation
-			for _, kv := range resKVs {
-				ubd := types.MustUnmarshalUBD(cdc, kv.Key, kv.Value)
-				ubds = append(ubds, ubd)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, stake.QueryDelegatorUnbondingDelegations), bz)
+			if err != nil {
+				return err
 			}
 
-			output, err := codec.MarshalJSONIndent(cdc, ubds)
+			var queryRes stake.QueryUnbondingDelegationsResponse
+			if err := cdc.UnmarshalJSON(res, &queryRes); err != nil {
+				return err
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, queryRes.UnbondingDelegations)
 			if err != nil {
 				return err
 			}
 
 			fmt.Println(string(output))
+			utils.PrintNextPageHint(queryRes.Pagination)
 
 			// TODO: output with proofs / machine parseable etc.
 			return nil
 		},
 	}
 
-	return cmd
+	return client.PaginationFlags(cmd)
 }
 
 // GetCmdQueryRedelegation implements the command to query a single
@@ -388,34 +411,41 @@ func GetCmdQueryRedelegations(storeName string, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 
-			key := stake.GetREDsKey(delegatorAddr)
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			resKVs, err := cliCtx.QuerySubspace(key, storeName)
+			page, err := utils.ReadPageRequestFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(stake.QueryDelegatorPageParams{DelegatorAddr: delegatorAddr, Pagination: page})
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
-			var reds []stake.Redelegation
-			for _, kv := range resKVs {
-				red := types.MustUnmarshalRED(cdc, kv.Key, kv.Value)
-				reds = append(reds, red)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, stake.QueryDelegatorRedelegations), bz)
+			if err != nil {
+				return err
 			}
 
-			output, err := codec.MarshalJSONIndent(cdc, reds)
+			var queryRes stake.QueryRedelegationsResponse
+			if err := cdc.UnmarshalJSON(res, &queryRes); err != nil {
+				return err
+			}
+
+			output, err := codec.MarshalJSONIndent(cdc, queryRes.Redelegations)
 			if err != nil {
 				return err
 			}
 
 			fmt.Println(string(output))
+			utils.PrintNextPageHint(queryRes.Pagination)
 
 			// TODO: output with proofs / machine parseable etc.
 			return nil
 		},
 	}
 
-	return cmd
+	return client.PaginationFlags(cmd)
 }
 
 // GetCmdQueryPool implements the pool query command.
@@ -71,6 +71,15 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			minSelfDelegation := sdk.OneInt()
+			if minSelfDelegationStr := viper.GetString(FlagMinSelfDelegation); minSelfDelegationStr != "" {
+				var ok bool
+				minSelfDelegation, ok = sdk.NewIntFromString(minSelfDelegationStr)
+				if !ok {
+					return fmt.Errorf("invalid minimum self delegation: %s", minSelfDelegationStr)
+				}
+			}
+
 			var msg sdk.Msg
 			if viper.GetString(FlagAddressDelegator) != "" {
 				delAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressDelegator))
@@ -79,11 +88,11 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 				}
 
 				msg = stake.NewMsgCreateValidatorOnBehalfOf(
-					delAddr, sdk.ValAddress(valAddr), pk, amount, description, commissionMsg,
+					delAddr, sdk.ValAddress(valAddr), pk, amount, description, commissionMsg, minSelfDelegation,
 				)
 			} else {
 				msg = stake.NewMsgCreateValidator(
-					sdk.ValAddress(valAddr), pk, amount, description, commissionMsg,
+					sdk.ValAddress(valAddr), pk, amount, description, commissionMsg, minSelfDelegation,
 				)
 			}
 
@@ -100,6 +109,7 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsAmount)
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
 	cmd.Flags().AddFlagSet(fsCommissionCreate)
+	cmd.Flags().AddFlagSet(fsMinSelfDelegation)
 	cmd.Flags().AddFlagSet(fsDelegator)
 
 	return cmd
@@ -140,7 +150,19 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 				newRate = &rate
 			}
 
-			msg := stake.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate)
+			var newMinSelfDelegation *sdk.Int
+
+			minSelfDelegationStr := viper.GetString(FlagMinSelfDelegation)
+			if minSelfDelegationStr != "" {
+				minSelfDelegation, ok := sdk.NewIntFromString(minSelfDelegationStr)
+				if !ok {
+					return fmt.Errorf("invalid new minimum self delegation: %s", minSelfDelegationStr)
+				}
+
+				newMinSelfDelegation = &minSelfDelegation
+			}
+
+			msg := stake.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate, newMinSelfDelegation)
 
 			if cliCtx.GenerateOnly {
 				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}, false)
@@ -153,6 +175,49 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
 	cmd.Flags().AddFlagSet(fsCommissionUpdate)
+	cmd.Flags().AddFlagSet(fsMinSelfDelegation)
+
+	return cmd
+}
+
+// GetCmdRotateConsPubKey implements the rotate consensus pubkey command.
+func GetCmdRotateConsPubKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey",
+		Short: "replace the consensus pubkey of an existing validator, burning the rotation fee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			valAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			pkStr := viper.GetString(FlagPubKey)
+			if len(pkStr) == 0 {
+				return fmt.Errorf("must use --pubkey flag")
+			}
+
+			pk, err := sdk.GetConsPubKeyBech32(pkStr)
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgRotateConsPubKey(sdk.ValAddress(valAddr), pk)
+
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}, false)
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsPk)
 
 	return cmd
 }
@@ -331,3 +396,102 @@ func GetCmdBeginUnbonding(storeName string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdTransferDelegation implements the transfer delegation command.
+func GetCmdTransferDelegation(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-delegation",
+		Short: "transfer delegation shares to another account without unbonding them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			recipientAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressRecipient))
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			// get the shares amount
+			sharesAmountStr := viper.GetString(FlagSharesAmount)
+			sharesPercentStr := viper.GetString(FlagSharesPercent)
+			sharesAmount, err := getShares(
+				storeName, cdc, sharesAmountStr, sharesPercentStr,
+				delAddr, valAddr,
+			)
+			if err != nil {
+				return err
+			}
+
+			msg := stake.NewMsgTransferDelegation(delAddr, recipientAddr, valAddr, sharesAmount)
+
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}, false)
+			}
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsShares)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsRecipient)
+
+	return cmd
+}
+
+// GetCmdCancelUnbonding implements the cancel unbonding delegation command.
+func GetCmdCancelUnbonding(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unbond",
+		Short: "cancel an unbonding delegation and delegate the tokens back to the validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			creationHeight := viper.GetInt64(FlagCreationHeight)
+
+			msg := stake.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, amount)
+
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}, false)
+			}
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsAmount)
+	cmd.Flags().AddFlagSet(fsValidator)
+	cmd.Flags().AddFlagSet(fsCreationHeight)
+
+	return cmd
+}
@@ -0,0 +1,299 @@
+package grpc
+
+import (
+	"context"
+
+	gogrpc "google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sgrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/querier"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// RegisterQueryService registers the gRPC query service of the staking
+// module, which runs the queries of the staking querier.
+func RegisterQueryService(srv *gogrpc.Server, cdc *codec.Codec, q sgrpc.Querier) {
+	RegisterQueryServer(srv, queryServer{cdc: cdc, querier: q})
+}
+
+type queryServer struct {
+	cdc     *codec.Codec
+	querier sgrpc.Querier
+}
+
+var _ QueryServer = queryServer{}
+
+func (s queryServer) query(ctx context.Context, endpoint string, params, res interface{}) error {
+	return sgrpc.Query(ctx, s.cdc, s.querier, "custom/stake/"+endpoint, params, res)
+}
+
+// Validators implements QueryServer.
+func (s queryServer) Validators(ctx context.Context, req *ValidatorsRequest) (*ValidatorsResponse, error) {
+	params := querier.QueryValidatorsParams{Pagination: req.Pagination.SDKPageRequest()}
+	var res querier.QueryValidatorsResponse
+	if err := s.query(ctx, querier.QueryValidators, params, &res); err != nil {
+		return nil, err
+	}
+
+	validators, err := newValidators(res.Validators)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsResponse{Validators: validators, Pagination: sgrpc.NewPageResponse(res.Pagination)}, nil
+}
+
+// Validator implements QueryServer.
+func (s queryServer) Validator(ctx context.Context, req *ValidatorRequest) (*ValidatorResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, sgrpc.InvalidArgument(err)
+	}
+
+	var validator types.Validator
+	if err := s.query(ctx, querier.QueryValidator, querier.QueryValidatorParams{ValidatorAddr: valAddr}, &validator); err != nil {
+		return nil, err
+	}
+	return newValidatorResponse(validator)
+}
+
+// Delegator implements QueryServer.
+func (s queryServer) Delegator(ctx context.Context, req *DelegatorRequest) (*DelegatorResponse, error) {
+	params, err := delegatorParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary types.DelegationSummary
+	if err := s.query(ctx, querier.QueryDelegator, params, &summary); err != nil {
+		return nil, err
+	}
+
+	res := &DelegatorResponse{}
+	for _, delegation := range summary.Delegations {
+		res.Delegations = append(res.Delegations, newDelegation(delegation))
+	}
+	for _, ubd := range summary.UnbondingDelegations {
+		res.UnbondingDelegations = append(res.UnbondingDelegations, newUnbondingDelegation(ubd))
+	}
+	for _, red := range summary.Redelegations {
+		res.Redelegations = append(res.Redelegations, newRedelegation(red))
+	}
+	return res, nil
+}
+
+// DelegatorValidators implements QueryServer.
+func (s queryServer) DelegatorValidators(ctx context.Context, req *DelegatorRequest) (*DelegatorValidatorsResponse, error) {
+	params, err := delegatorParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []types.Validator
+	if err := s.query(ctx, querier.QueryDelegatorValidators, params, &res); err != nil {
+		return nil, err
+	}
+
+	validators, err := newValidators(res)
+	if err != nil {
+		return nil, err
+	}
+	return &DelegatorValidatorsResponse{Validators: validators}, nil
+}
+
+// Delegation implements QueryServer.
+func (s queryServer) Delegation(ctx context.Context, req *DelegationRequest) (*DelegationResponse, error) {
+	params, err := bondsParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegation types.Delegation
+	if err := s.query(ctx, querier.QueryDelegation, params, &delegation); err != nil {
+		return nil, err
+	}
+	return &DelegationResponse{Delegation: newDelegation(delegation)}, nil
+}
+
+// UnbondingDelegation implements QueryServer.
+func (s queryServer) UnbondingDelegation(ctx context.Context, req *DelegationRequest) (*UnbondingDelegationResponse, error) {
+	params, err := bondsParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var ubd types.UnbondingDelegation
+	if err := s.query(ctx, querier.QueryUnbondingDelegation, params, &ubd); err != nil {
+		return nil, err
+	}
+	return &UnbondingDelegationResponse{UnbondingDelegation: newUnbondingDelegation(ubd)}, nil
+}
+
+// DelegatorValidator implements QueryServer.
+func (s queryServer) DelegatorValidator(ctx context.Context, req *DelegationRequest) (*ValidatorResponse, error) {
+	params, err := bondsParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var validator types.Validator
+	if err := s.query(ctx, querier.QueryDelegatorValidator, params, &validator); err != nil {
+		return nil, err
+	}
+	return newValidatorResponse(validator)
+}
+
+// Pool implements QueryServer.
+func (s queryServer) Pool(ctx context.Context, req *sgrpc.Empty) (*PoolResponse, error) {
+	var pool types.Pool
+	if err := s.query(ctx, querier.QueryPool, nil, &pool); err != nil {
+		return nil, err
+	}
+	return &PoolResponse{Pool: &Pool{
+		LooseTokens:             pool.LooseTokens.String(),
+		BondedTokens:            pool.BondedTokens.String(),
+		InflationLastTime:       sgrpc.NewTimestamp(pool.InflationLastTime),
+		Inflation:               pool.Inflation.String(),
+		DateLastCommissionReset: pool.DateLastCommissionReset,
+		PrevBondedShares:        pool.PrevBondedShares.String(),
+	}}, nil
+}
+
+// Parameters implements QueryServer.
+func (s queryServer) Parameters(ctx context.Context, req *sgrpc.Empty) (*ParametersResponse, error) {
+	var params types.Params
+	if err := s.query(ctx, querier.QueryParameters, nil, &params); err != nil {
+		return nil, err
+	}
+	return &ParametersResponse{Params: &Params{
+		InflationRateChange:    params.InflationRateChange.String(),
+		InflationMax:           params.InflationMax.String(),
+		InflationMin:           params.InflationMin.String(),
+		GoalBonded:             params.GoalBonded.String(),
+		UnbondingTime:          sgrpc.NewDuration(params.UnbondingTime),
+		MaxValidators:          uint32(params.MaxValidators),
+		MaxEntries:             uint32(params.MaxEntries),
+		MaxVotingPowerFraction: params.MaxVotingPowerFraction.String(),
+		MaxConsPubkeyRotations: uint32(params.MaxConsPubKeyRotations),
+		ConsPubkeyRotationFee:  params.ConsPubKeyRotationFee.String(),
+		BondDenom:              params.BondDenom,
+	}}, nil
+}
+
+func delegatorParams(req *DelegatorRequest) (querier.QueryDelegatorParams, error) {
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return querier.QueryDelegatorParams{}, sgrpc.InvalidArgument(err)
+	}
+	return querier.QueryDelegatorParams{DelegatorAddr: delAddr}, nil
+}
+
+func bondsParams(req *DelegationRequest) (querier.QueryBondsParams, error) {
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return querier.QueryBondsParams{}, sgrpc.InvalidArgument(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return querier.QueryBondsParams{}, sgrpc.InvalidArgument(err)
+	}
+	return querier.QueryBondsParams{DelegatorAddr: delAddr, ValidatorAddr: valAddr}, nil
+}
+
+func newValidatorResponse(validator types.Validator) (*ValidatorResponse, error) {
+	v, err := newValidator(validator)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorResponse{Validator: v}, nil
+}
+
+func newValidators(validators []types.Validator) ([]*Validator, error) {
+	res := make([]*Validator, len(validators))
+	for i, validator := range validators {
+		v, err := newValidator(validator)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+// newValidator converts a validator to its protobuf message.
+func newValidator(v types.Validator) (*Validator, error) {
+	consPubKey, err := sdk.Bech32ifyConsPub(v.ConsPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Validator{
+		OperatorAddr:    v.OperatorAddr.String(),
+		ConsPubkey:      consPubKey,
+		Jailed:          v.Jailed,
+		Status:          BondStatus(v.Status),
+		Tokens:          v.Tokens.String(),
+		DelegatorShares: v.DelegatorShares.String(),
+		Description: &Description{
+			Moniker:  v.Description.Moniker,
+			Identity: v.Description.Identity,
+			Website:  v.Description.Website,
+			Details:  v.Description.Details,
+		},
+		BondHeight:         v.BondHeight,
+		BondIntraTxCounter: int32(v.BondIntraTxCounter),
+		UnbondingHeight:    v.UnbondingHeight,
+		UnbondingTime:      sgrpc.NewTimestamp(v.UnbondingMinTime),
+		Commission: &Commission{
+			Rate:          v.Commission.Rate.String(),
+			MaxRate:       v.Commission.MaxRate.String(),
+			MaxChangeRate: v.Commission.MaxChangeRate.String(),
+			UpdateTime:    sgrpc.NewTimestamp(v.Commission.UpdateTime),
+		},
+		MinSelfDelegation: v.MinSelfDelegation.String(),
+	}, nil
+}
+
+func newDelegation(d types.Delegation) *Delegation {
+	return &Delegation{
+		DelegatorAddr: d.DelegatorAddr.String(),
+		ValidatorAddr: d.ValidatorAddr.String(),
+		Shares:        d.Shares.String(),
+		Height:        d.Height,
+	}
+}
+
+func newUnbondingDelegation(ubd types.UnbondingDelegation) *UnbondingDelegation {
+	res := &UnbondingDelegation{
+		DelegatorAddr: ubd.DelegatorAddr.String(),
+		ValidatorAddr: ubd.ValidatorAddr.String(),
+	}
+	for _, entry := range ubd.Entries {
+		res.Entries = append(res.Entries, &UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: sgrpc.NewTimestamp(entry.CompletionTime),
+			InitialBalance: sgrpc.NewCoin(entry.InitialBalance),
+			Balance:        sgrpc.NewCoin(entry.Balance),
+		})
+	}
+	return res
+}
+
+func newRedelegation(red types.Redelegation) *Redelegation {
+	res := &Redelegation{
+		DelegatorAddr:    red.DelegatorAddr.String(),
+		ValidatorSrcAddr: red.ValidatorSrcAddr.String(),
+		ValidatorDstAddr: red.ValidatorDstAddr.String(),
+	}
+	for _, entry := range red.Entries {
+		res.Entries = append(res.Entries, &RedelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: sgrpc.NewTimestamp(entry.CompletionTime),
+			InitialBalance: sgrpc.NewCoin(entry.InitialBalance),
+			Balance:        sgrpc.NewCoin(entry.Balance),
+			SharesSrc:      entry.SharesSrc.String(),
+			SharesDst:      entry.SharesDst.String(),
+		})
+	}
+	return res
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/querier"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestValidatorQuery(t *testing.T) {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+
+	pk := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pk.Address())
+	validator := types.NewValidator(valAddr, pk, types.NewDescription("moniker", "", "", ""))
+	validator.Status = sdk.Bonded
+	validator.Tokens = sdk.NewDec(10)
+
+	s := queryServer{cdc: cdc, querier: func(path string, data []byte, height int64) ([]byte, int64, error) {
+		require.Equal(t, "custom/stake/"+querier.QueryValidator, path)
+		var params querier.QueryValidatorParams
+		require.NoError(t, cdc.UnmarshalJSON(data, &params))
+		require.Equal(t, valAddr, params.ValidatorAddr)
+		return cdc.MustMarshalJSON(validator), 1, nil
+	}}
+
+	res, err := s.Validator(context.Background(), &ValidatorRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	consPubKey, err := sdk.Bech32ifyConsPub(pk)
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), res.Validator.OperatorAddr)
+	require.Equal(t, consPubKey, res.Validator.ConsPubkey)
+	require.Equal(t, BondStatus_BONDED, res.Validator.Status)
+	require.Equal(t, "10.0000000000", res.Validator.Tokens)
+	require.Equal(t, "moniker", res.Validator.Description.Moniker)
+
+	// malformed addresses are rejected before querying
+	_, err = s.Validator(context.Background(), &ValidatorRequest{ValidatorAddr: sdk.AccAddress(pk.Address()).String()})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBondsParams(t *testing.T) {
+	delAddr := sdk.AccAddress([]byte("delegator___________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+
+	params, err := bondsParams(&DelegationRequest{DelegatorAddr: delAddr.String(), ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, querier.QueryBondsParams{DelegatorAddr: delAddr, ValidatorAddr: valAddr}, params)
+
+	_, err = bondsParams(&DelegationRequest{DelegatorAddr: delAddr.String(), ValidatorAddr: delAddr.String()})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -43,6 +44,24 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		delegatorValidatorHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// Query the delegations of a delegator
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/delegations",
+		delegatorDelegationsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Query the unbonding delegations of a delegator
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/unbonding_delegations",
+		delegatorUnbondingDelegationsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Query the redelegations of a delegator
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/redelegations",
+		delegatorRedelegationsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// Query a delegation between a delegator and a validator
 	r.HandleFunc(
 		"/stake/delegators/{delegatorAddr}/delegations/{validatorAddr}",
@@ -58,7 +77,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	// Get all validators
 	r.HandleFunc(
 		"/stake/validators",
-		validatorsHandlerFn(cliCtx),
+		validatorsHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
 	// Get a single validator info
@@ -120,6 +139,95 @@ func delegatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 	}
 }
 
+// queryDelegatorPage queries a page of a list of the delegator in the path of
+// the request, reporting any error to w
+func queryDelegatorPage(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, cdc *codec.Codec,
+	endpoint string) (page sdk.PageRequest, res []byte, ok bool) {
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return page, nil, false
+	}
+
+	page, err = utils.ParsePageRequest(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return page, nil, false
+	}
+
+	bz, err := cdc.MarshalJSON(stake.QueryDelegatorPageParams{DelegatorAddr: delegatorAddr, Pagination: page})
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return page, nil, false
+	}
+
+	res, err = cliCtx.QueryWithData("custom/stake/"+endpoint, bz)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return page, nil, false
+	}
+	return page, res, true
+}
+
+// HTTP request handler to query a page of the delegations of a delegator
+func delegatorDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, res, ok := queryDelegatorPage(w, r, cliCtx, cdc, stake.QueryDelegatorDelegations)
+		if !ok {
+			return
+		}
+
+		var delegations stake.QueryDelegationsResponse
+		if err := cdc.UnmarshalJSON(res, &delegations); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.WritePageHeaders(w, page, delegations.Pagination)
+		utils.PostProcessResponse(w, cdc, delegations.Delegations, cliCtx.Indent)
+	}
+}
+
+// HTTP request handler to query a page of the unbonding delegations of a
+// delegator
+func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, res, ok := queryDelegatorPage(w, r, cliCtx, cdc, stake.QueryDelegatorUnbondingDelegations)
+		if !ok {
+			return
+		}
+
+		var ubds stake.QueryUnbondingDelegationsResponse
+		if err := cdc.UnmarshalJSON(res, &ubds); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.WritePageHeaders(w, page, ubds.Pagination)
+		utils.PostProcessResponse(w, cdc, ubds.UnbondingDelegations, cliCtx.Indent)
+	}
+}
+
+// HTTP request handler to query a page of the redelegations of a delegator
+func delegatorRedelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, res, ok := queryDelegatorPage(w, r, cliCtx, cdc, stake.QueryDelegatorRedelegations)
+		if !ok {
+			return
+		}
+
+		var reds stake.QueryRedelegationsResponse
+		if err := cdc.UnmarshalJSON(res, &reds); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.WritePageHeaders(w, page, reds.Pagination)
+		utils.PostProcessResponse(w, cdc, reds.Redelegations, cliCtx.Indent)
+	}
+}
+
 // HTTP request handler to query all staking txs (msgs) from a delegator
 func delegatorTxsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -375,21 +483,34 @@ func delegatorValidatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) ht
 }
 
 // HTTP request handler to query list of validators
-func validatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+func validatorsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		page, err := utils.ParsePageRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		w.Header().Set("Content-Type", "application/json")
+		bz, err := cdc.MarshalJSON(stake.QueryValidatorsParams{Pagination: page})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		res, err := cliCtx.QueryWithData("custom/stake/validators", nil)
+		res, err := cliCtx.QueryWithData("custom/stake/validators", bz)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(err.Error()))
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 
+		var validators stake.QueryValidatorsResponse
+		if err := cdc.UnmarshalJSON(res, &validators); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(res)
+		utils.WritePageHeaders(w, page, validators.Pagination)
+		utils.PostProcessResponse(w, cdc, validators.Validators, cliCtx.Indent)
 	}
 }
 
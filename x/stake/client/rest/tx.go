@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -47,12 +48,28 @@ type (
 		SharesAmount  string `json:"shares"`
 	}
 
+	msgTransferDelegationInput struct {
+		DelegatorAddr string `json:"delegator_addr"` // in bech32
+		RecipientAddr string `json:"recipient_addr"` // in bech32
+		ValidatorAddr string `json:"validator_addr"` // in bech32
+		SharesAmount  string `json:"shares"`
+	}
+
+	msgCancelUnbondingInput struct {
+		DelegatorAddr  string   `json:"delegator_addr"` // in bech32
+		ValidatorAddr  string   `json:"validator_addr"` // in bech32
+		CreationHeight string   `json:"creation_height"`
+		Amount         sdk.Coin `json:"amount"`
+	}
+
 	// the request body for edit delegations
 	EditDelegationsReq struct {
-		BaseReq          utils.BaseReq             `json:"base_req"`
-		Delegations      []msgDelegationsInput     `json:"delegations"`
-		BeginUnbondings  []msgBeginUnbondingInput  `json:"begin_unbondings"`
-		BeginRedelegates []msgBeginRedelegateInput `json:"begin_redelegates"`
+		BaseReq             utils.BaseReq                `json:"base_req"`
+		Delegations         []msgDelegationsInput        `json:"delegations"`
+		BeginUnbondings     []msgBeginUnbondingInput     `json:"begin_unbondings"`
+		BeginRedelegates    []msgBeginRedelegateInput    `json:"begin_redelegates"`
+		TransferDelegations []msgTransferDelegationInput `json:"transfer_delegations"`
+		CancelUnbondings    []msgCancelUnbondingInput    `json:"cancel_unbondings"`
 	}
 )
 
@@ -93,7 +110,9 @@ func delegationsRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx conte
 		// build messages
 		messages := make([]sdk.Msg, len(req.Delegations)+
 			len(req.BeginRedelegates)+
-			len(req.BeginUnbondings))
+			len(req.BeginUnbondings)+
+			len(req.TransferDelegations)+
+			len(req.CancelUnbondings))
 
 		i := 0
 		for _, msg := range req.Delegations {
@@ -195,6 +214,70 @@ func delegationsRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx conte
 			i++
 		}
 
+		for _, msg := range req.TransferDelegations {
+			delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Couldn't decode delegator. Error: %s", err.Error()))
+				return
+			}
+
+			if !bytes.Equal(info.GetPubKey().Address(), delAddr) {
+				utils.WriteErrorResponse(w, http.StatusUnauthorized, "Must use own delegator address")
+				return
+			}
+
+			recipientAddr, err := sdk.AccAddressFromBech32(msg.RecipientAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Couldn't decode recipient. Error: %s", err.Error()))
+				return
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error()))
+				return
+			}
+
+			shares, err := sdk.NewDecFromStr(msg.SharesAmount)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Couldn't decode shares amount. Error: %s", err.Error()))
+				return
+			}
+
+			messages[i] = stake.NewMsgTransferDelegation(delAddr, recipientAddr, valAddr, shares)
+
+			i++
+		}
+
+		for _, msg := range req.CancelUnbondings {
+			delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Couldn't decode delegator. Error: %s", err.Error()))
+				return
+			}
+
+			if !bytes.Equal(info.GetPubKey().Address(), delAddr) {
+				utils.WriteErrorResponse(w, http.StatusUnauthorized, "Must use own delegator address")
+				return
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error()))
+				return
+			}
+
+			creationHeight, err := strconv.ParseInt(msg.CreationHeight, 10, 64)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Couldn't decode creation height. Error: %s", err.Error()))
+				return
+			}
+
+			messages[i] = stake.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, msg.Amount)
+
+			i++
+		}
+
 		simulateGas, gas, err := client.ReadGasFlag(baseReq.Gas)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -212,6 +295,7 @@ func delegationsRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx conte
 			GasAdjustment: adjustment,
 			SimulateGas:   simulateGas,
 			ChainID:       baseReq.ChainID,
+			TimeoutHeight: baseReq.TimeoutHeight,
 		}
 
 		// sign messages
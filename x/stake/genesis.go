@@ -14,8 +14,9 @@ import (
 // InitGenesis sets the pool and parameters for the provided keeper and
 // initializes the IntraTxCounter. For each validator in data, it sets that
 // validator in the keeper along with manually setting the indexes. In
-// addition, it also sets any delegations found in data. Finally, it updates
-// the bonded validators.
+// addition, it also sets any delegations found in data, and the consensus
+// pubkey rotations of the validators along with the index of their previous
+// consensus addresses. Finally, it updates the bonded validators.
 // Returns final validator set after applying all declaration and delegations
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res []abci.ValidatorUpdate, err error) {
 
@@ -30,6 +31,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 
 	for i, validator := range data.Validators {
 		validator.BondIntraTxCounter = int16(i) // set the intra-tx counter to the order the validators are presented
+
+		// genesis files exported before the minimum self delegation was
+		// introduced have no such field, default it to the lowest minimum
+		if validator.MinSelfDelegation.IsNil() || validator.MinSelfDelegation.IsZero() {
+			validator.MinSelfDelegation = sdk.OneInt()
+		}
 		keeper.SetValidator(ctx, validator)
 
 		if validator.Tokens.IsZero() {
@@ -43,12 +50,36 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 		keeper.SetValidatorByConsAddr(ctx, validator)
 		keeper.SetValidatorByPowerIndex(ctx, validator, data.Pool)
 
-		keeper.OnValidatorCreated(ctx, validator.OperatorAddr)
+		// the hooks of an exported state are not called, as the receiving
+		// modules export their own state for the validators and delegations
+		if !data.Exported {
+			keeper.OnValidatorCreated(ctx, validator.OperatorAddr)
+		}
 	}
 
 	for _, delegation := range data.Bonds {
+		if !data.Exported {
+			keeper.OnDelegationCreated(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		}
 		keeper.SetDelegation(ctx, delegation)
-		keeper.OnDelegationCreated(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		if !data.Exported {
+			keeper.OnDelegationModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		}
+	}
+
+	for _, rotation := range data.ConsPubKeyRotations {
+		validator, found := keeper.GetValidator(ctx, rotation.OperatorAddr)
+		if !found {
+			return res, errors.Errorf("consensus pubkey rotation of unknown validator %s", rotation.OperatorAddr)
+		}
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+
+		// evidence signed with a previous consensus pubkey remains attributable
+		validator.ConsPubKey = rotation.OldConsPubKey
+		keeper.SetValidatorByConsAddr(ctx, validator)
+	}
+	for _, pending := range data.PendingConsPubKeyRotations {
+		keeper.SetPendingConsPubKeyRotation(ctx, pending)
 	}
 
 	res = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -56,8 +87,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 }
 
 // WriteGenesis returns a GenesisState for a given context and keeper. The
-// GenesisState will contain the pool, params, validators, and bonds found in
-// the keeper.
+// GenesisState will contain the pool, params, validators, bonds and consensus
+// pubkey rotations found in the keeper.
 func WriteGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	pool := keeper.GetPool(ctx)
 	params := keeper.GetParams(ctx)
@@ -65,10 +96,13 @@ func WriteGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	bonds := keeper.GetAllDelegations(ctx)
 
 	return types.GenesisState{
-		Pool:       pool,
-		Params:     params,
-		Validators: validators,
-		Bonds:      bonds,
+		Pool:                       pool,
+		Params:                     params,
+		Validators:                 validators,
+		Bonds:                      bonds,
+		ConsPubKeyRotations:        keeper.GetAllConsPubKeyRotations(ctx),
+		PendingConsPubKeyRotations: keeper.GetAllPendingConsPubKeyRotations(ctx),
+		Exported:                   true,
 	}
 }
 
@@ -111,6 +145,22 @@ func validateParams(params types.Params) error {
 		bondedPercent := params.GoalBonded.MulInt(sdk.NewInt(100)).String()
 		return fmt.Errorf("staking parameter GoalBonded should be less than 100 percent, instead got %s percent", bondedPercent)
 	}
+	if params.MaxValidators == 0 {
+		return fmt.Errorf("staking parameter MaxValidators must be positive")
+	}
+	if params.MaxVotingPowerFraction.IsNil() {
+		return fmt.Errorf("staking parameter MaxVotingPowerFraction must be set")
+	}
+	if params.MaxVotingPowerFraction.LTE(sdk.ZeroDec()) || params.MaxVotingPowerFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MaxVotingPowerFraction must be positive and at most one, instead got %s",
+			params.MaxVotingPowerFraction)
+	}
+	if params.MaxEntries == 0 {
+		return fmt.Errorf("staking parameter MaxEntries must be positive")
+	}
+	if params.ConsPubKeyRotationFee.IsNil() || params.ConsPubKeyRotationFee.Sign() < 0 {
+		return fmt.Errorf("staking parameter ConsPubKeyRotationFee must be set and non-negative")
+	}
 	if params.BondDenom == "" {
 		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
 	}
@@ -137,6 +187,10 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 		if val.DelegatorShares.IsZero() {
 			return fmt.Errorf("genesis validator cannot have zero delegator shares, validator: %v", val)
 		}
+		// a missing minimum self delegation is defaulted to one on import
+		if !val.MinSelfDelegation.IsNil() && val.MinSelfDelegation.Sign() < 0 {
+			return fmt.Errorf("genesis validator cannot have a negative minimum self delegation, validator: %v", val)
+		}
 		addrMap[strKey] = true
 	}
 	return
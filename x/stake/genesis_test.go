@@ -3,8 +3,10 @@ package stake
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/stretchr/testify/assert"
 
@@ -40,6 +42,9 @@ func TestInitGenesis(t *testing.T) {
 	validators[1].Tokens = sdk.OneDec()
 	validators[1].DelegatorShares = sdk.OneDec()
 
+	// genesis files exported without a minimum self delegation still import
+	validators[1].MinSelfDelegation = sdk.Int{}
+
 	genesisState = types.NewGenesisState(pool, params, validators, delegations)
 	vals, err := InitGenesis(ctx, keeper, genesisState)
 	require.NoError(t, err)
@@ -48,6 +53,7 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Pool, actualGenesis.Pool)
 	require.Equal(t, genesisState.Params, actualGenesis.Params)
 	require.Equal(t, genesisState.Bonds, actualGenesis.Bonds)
+	require.True(t, actualGenesis.Exported)
 	require.EqualValues(t, keeper.GetAllValidators(ctx), actualGenesis.Validators)
 
 	// now make sure the validators are bonded and intra-tx counters are correct
@@ -60,6 +66,7 @@ func TestInitGenesis(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, sdk.Bonded, resVal.Status)
 	require.Equal(t, int16(1), resVal.BondIntraTxCounter)
+	require.Equal(t, sdk.OneInt(), resVal.MinSelfDelegation)
 
 	abcivals := make([]abci.ValidatorUpdate, len(vals))
 	for i, val := range validators {
@@ -108,6 +115,48 @@ func TestInitGenesisLargeValidatorSet(t *testing.T) {
 	require.Equal(t, abcivals, vals)
 }
 
+func TestGenesisConsPubKeyRotations(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+
+	pool := keeper.GetPool(ctx)
+	pool.BondedTokens = sdk.OneDec()
+	validator := NewValidator(sdk.ValAddress(keep.Addrs[0]), keep.PKs[0], Description{Moniker: "hoop"})
+	validator.Status = sdk.Bonded
+	validator.Tokens = sdk.OneDec()
+	validator.DelegatorShares = sdk.OneDec()
+	params := keeper.GetParams(ctx)
+	params.MaxConsPubKeyRotations = 2
+	_, err := InitGenesis(ctx, keeper, types.NewGenesisState(pool, params, []Validator{validator}, nil))
+	require.NoError(t, err)
+
+	// rotate twice, the second rotation is not yet reported to Tendermint
+	require.Nil(t, keeper.RotateConsPubKey(ctx, validator.OperatorAddr, keep.PKs[1]))
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	ctx = ctx.WithBlockHeight(1)
+	require.Nil(t, keeper.RotateConsPubKey(ctx, validator.OperatorAddr, keep.PKs[2]))
+
+	genesisState := WriteGenesis(ctx, keeper)
+	require.Len(t, genesisState.ConsPubKeyRotations, 2)
+	pending := []PendingConsPubKeyRotation{{OperatorAddr: validator.OperatorAddr, ConsPubKey: keep.PKs[1]}}
+	require.Equal(t, pending, genesisState.PendingConsPubKeyRotations)
+
+	// the rotations and the previous consensus addresses are restored
+	ctx, _, keeper = keep.CreateTestInput(t, false, 1000)
+	updates, err := InitGenesis(ctx, keeper, genesisState)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.ConsPubKeyRotations, keeper.GetAllConsPubKeyRotations(ctx))
+	for _, pk := range keep.PKs[:3] {
+		resVal, found := keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(pk.Address()))
+		require.True(t, found)
+		require.Equal(t, validator.OperatorAddr, resVal.OperatorAddr)
+	}
+
+	// the pending rotation is reported to Tendermint
+	require.Contains(t, updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(keep.PKs[1]), Power: 0})
+	require.Empty(t, keeper.GetAllPendingConsPubKeyRotations(ctx))
+}
+
 func TestValidateGenesis(t *testing.T) {
 	genValidators1 := make([]types.Validator, 1, 5)
 	pk := ed25519.GenPrivKey().PubKey()
@@ -125,6 +174,16 @@ func TestValidateGenesis(t *testing.T) {
 		{"200% goalbonded", func(data *types.GenesisState) { (*data).Params.GoalBonded = sdk.OneDec().Add(sdk.OneDec()) }, true},
 		{"-67% goalbonded", func(data *types.GenesisState) { (*data).Params.GoalBonded = sdk.OneDec().Neg() }, true},
 		{"no bond denom", func(data *types.GenesisState) { (*data).Params.BondDenom = "" }, true},
+		{"no max validators", func(data *types.GenesisState) { (*data).Params.MaxValidators = 0 }, true},
+		{"zero max voting power fraction", func(data *types.GenesisState) {
+			(*data).Params.MaxVotingPowerFraction = sdk.ZeroDec()
+		}, true},
+		{"200% max voting power fraction", func(data *types.GenesisState) {
+			(*data).Params.MaxVotingPowerFraction = sdk.NewDec(2)
+		}, true},
+		{"33% max voting power fraction", func(data *types.GenesisState) {
+			(*data).Params.MaxVotingPowerFraction = sdk.NewDecWithPrec(33, 2)
+		}, false},
 		{"min inflation > max inflation", func(data *types.GenesisState) {
 			(*data).Params.InflationMin = (*data).Params.InflationMax.Add(sdk.OneDec())
 		}, true},
@@ -144,6 +203,18 @@ func TestValidateGenesis(t *testing.T) {
 			(*data).Validators = genValidators1
 			(*data).Validators[0].DelegatorShares = sdk.ZeroDec()
 		}, true},
+		{"no minimum self delegation", func(data *types.GenesisState) {
+			(*data).Validators = genValidators1
+			(*data).Validators[0].MinSelfDelegation = sdk.Int{}
+		}, false},
+		{"zero minimum self delegation", func(data *types.GenesisState) {
+			(*data).Validators = genValidators1
+			(*data).Validators[0].MinSelfDelegation = sdk.ZeroInt()
+		}, false},
+		{"negative minimum self delegation", func(data *types.GenesisState) {
+			(*data).Validators = genValidators1
+			(*data).Validators[0].MinSelfDelegation = sdk.NewInt(-1)
+		}, true},
 		{"jailed and bonded validator", func(data *types.GenesisState) {
 			(*data).Validators = genValidators1
 			(*data).Validators[0].Jailed = true
@@ -19,12 +19,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCreateValidator(ctx, msg, k)
 		case types.MsgEditValidator:
 			return handleMsgEditValidator(ctx, msg, k)
+		case types.MsgRotateConsPubKey:
+			return handleMsgRotateConsPubKey(ctx, msg, k)
 		case types.MsgDelegate:
 			return handleMsgDelegate(ctx, msg, k)
 		case types.MsgBeginRedelegate:
 			return handleMsgBeginRedelegate(ctx, msg, k)
 		case types.MsgBeginUnbonding:
 			return handleMsgBeginUnbonding(ctx, msg, k)
+		case types.MsgTransferDelegation:
+			return handleMsgTransferDelegation(ctx, msg, k)
+		case types.MsgCancelUnbondingDelegation:
+			return handleMsgCancelUnbondingDelegation(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -105,6 +111,7 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	}
 
 	validator := NewValidator(msg.ValidatorAddr, msg.PubKey, msg.Description)
+	validator.MinSelfDelegation = msg.MinSelfDelegation
 	commission := NewCommissionWithTime(
 		msg.Commission.Rate, msg.Commission.MaxRate,
 		msg.Commission.MaxChangeRate, ctx.BlockHeader().Time,
@@ -117,6 +124,7 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
 	k.SetNewValidatorByPowerIndex(ctx, validator)
+	k.OnValidatorCreated(ctx, validator.OperatorAddr)
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
@@ -125,10 +133,6 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		return err.Result()
 	}
 
-	k.OnValidatorCreated(ctx, validator.OperatorAddr)
-	accAddr := sdk.AccAddress(validator.OperatorAddr)
-	k.OnDelegationCreated(ctx, accAddr, validator.OperatorAddr)
-
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionCreateValidator,
 		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
@@ -148,13 +152,16 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 		return ErrNoValidatorFound(k.Codespace()).Result()
 	}
 
-	// replace all editable fields (clients should autofill existing values)
-	description, err := validator.Description.UpdateDescription(msg.Description)
-	if err != nil {
-		return err.Result()
+	// replace all editable fields (clients should autofill existing values),
+	// an empty description leaves it untouched
+	if msg.Description != (Description{}) {
+		description, err := validator.Description.UpdateDescription(msg.Description)
+		if err != nil {
+			return err.Result()
+		}
+		validator.Description = description
 	}
-
-	validator.Description = description
+	description := validator.Description
 
 	if msg.CommissionRate != nil {
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
@@ -164,6 +171,24 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 		validator.Commission = commission
 	}
 
+	if msg.MinSelfDelegation != nil {
+		if !msg.MinSelfDelegation.GT(validator.MinSelfDelegation) {
+			return ErrMinSelfDelegationDecreased(k.Codespace()).Result()
+		}
+
+		// the operator must already self-delegate the new minimum
+		selfDelegation := sdk.ZeroInt()
+		delegation, found := k.GetDelegation(ctx, sdk.AccAddress(msg.ValidatorAddr), msg.ValidatorAddr)
+		if found {
+			selfDelegation = validator.DelegatorShareExRate().Mul(delegation.Shares).TruncateInt()
+		}
+		if msg.MinSelfDelegation.GT(selfDelegation) {
+			return ErrSelfDelegationBelowMinimum(k.Codespace()).Result()
+		}
+
+		validator.MinSelfDelegation = *msg.MinSelfDelegation
+	}
+
 	k.SetValidator(ctx, validator)
 
 	tags := sdk.NewTags(
@@ -178,6 +203,22 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	}
 }
 
+func handleMsgRotateConsPubKey(ctx sdk.Context, msg types.MsgRotateConsPubKey, k keeper.Keeper) sdk.Result {
+	err := k.RotateConsPubKey(ctx, msg.ValidatorAddr, msg.NewPubKey)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionRotateConsPubKey,
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+	)
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
 func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
 	validator, found := k.GetValidator(ctx, msg.ValidatorAddr)
 	if !found {
@@ -197,9 +238,6 @@ func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper)
 		return err.Result()
 	}
 
-	// call the hook if present
-	k.OnDelegationCreated(ctx, msg.DelegatorAddr, validator.OperatorAddr)
-
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionDelegate,
 		tags.Delegator, []byte(msg.DelegatorAddr.String()),
@@ -212,12 +250,12 @@ func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper)
 }
 
 func handleMsgBeginUnbonding(ctx sdk.Context, msg types.MsgBeginUnbonding, k keeper.Keeper) sdk.Result {
-	ubd, err := k.BeginUnbonding(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.SharesAmount)
+	completionTime, err := k.BeginUnbonding(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.SharesAmount)
 	if err != nil {
 		return err.Result()
 	}
 
-	finishTime := types.MsgCdc.MustMarshalBinary(ubd.MinTime)
+	finishTime := types.MsgCdc.MustMarshalBinary(completionTime)
 
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionBeginUnbonding,
@@ -229,13 +267,13 @@ func handleMsgBeginUnbonding(ctx sdk.Context, msg types.MsgBeginUnbonding, k kee
 }
 
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) sdk.Result {
-	red, err := k.BeginRedelegation(ctx, msg.DelegatorAddr, msg.ValidatorSrcAddr,
+	completionTime, err := k.BeginRedelegation(ctx, msg.DelegatorAddr, msg.ValidatorSrcAddr,
 		msg.ValidatorDstAddr, msg.SharesAmount)
 	if err != nil {
 		return err.Result()
 	}
 
-	finishTime := types.MsgCdc.MustMarshalBinary(red.MinTime)
+	finishTime := types.MsgCdc.MustMarshalBinary(completionTime)
 
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionBeginRedelegation,
@@ -246,3 +284,32 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 	)
 	return sdk.Result{Data: finishTime, Tags: tags}
 }
+
+func handleMsgTransferDelegation(ctx sdk.Context, msg types.MsgTransferDelegation, k keeper.Keeper) sdk.Result {
+	_, err := k.TransferDelegation(ctx, msg.DelegatorAddr, msg.RecipientAddr, msg.ValidatorAddr, msg.SharesAmount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionTransferDelegation,
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.Recipient, []byte(msg.RecipientAddr.String()),
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
+
+func handleMsgCancelUnbondingDelegation(ctx sdk.Context, msg types.MsgCancelUnbondingDelegation, k keeper.Keeper) sdk.Result {
+	_, err := k.CancelUnbondingDelegation(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.CreationHeight, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionCancelUnbonding,
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{Tags: tags}
+}
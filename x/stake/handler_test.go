@@ -602,6 +602,67 @@ func TestJailValidator(t *testing.T) {
 	require.True(t, got.IsOK(), "expected ok, got %v", got)
 }
 
+func TestMinSelfDelegation(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
+	_ = setInstantUnbondPeriod(keeper, ctx)
+
+	// cannot create a validator with a self-delegation below the minimum
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], 10)
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(11)
+	require.NotNil(t, msgCreateValidator.ValidateBasic())
+
+	// create the validators
+	msgCreateValidator.MinSelfDelegation = sdk.NewInt(5)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(5), validator.MinSelfDelegation)
+
+	got = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], 10), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+
+	// the minimum cannot be decreased nor raised above the self-delegation
+	description := Description{Moniker: "moniker"}
+	for _, amt := range []int64{4, 5, 11} {
+		minSelfDelegation := sdk.NewInt(amt)
+		got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, description, nil, &minSelfDelegation), keeper)
+		require.False(t, got.IsOK(), "expected error when setting the minimum to %d", amt)
+	}
+
+	// the minimum can be raised alone, leaving the description untouched
+	minSelfDelegation := sdk.NewInt(6)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, Description{}, nil, &minSelfDelegation), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.Equal(t, minSelfDelegation, validator.MinSelfDelegation)
+	require.Equal(t, msgCreateValidator.Description, validator.Description)
+
+	minSelfDelegation = sdk.NewInt(8)
+	got = handleMsgEditValidator(ctx, NewMsgEditValidator(validatorAddr, description, nil, &minSelfDelegation), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.Equal(t, minSelfDelegation, validator.MinSelfDelegation)
+
+	// unbonding down to the minimum does not jail the validator
+	msgBeginUnbonding := NewMsgBeginUnbonding(sdk.AccAddress(validatorAddr), validatorAddr, sdk.NewDec(1))
+	got = handleMsgBeginUnbonding(ctx, msgBeginUnbonding, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+	msgBeginRedelegate := NewMsgBeginRedelegate(sdk.AccAddress(validatorAddr), validatorAddr, validatorAddr2, sdk.NewDec(1))
+	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+	EndBlocker(ctx, keeper)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.False(t, validator.Jailed)
+
+	// redelegating below the minimum jails the validator
+	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+	validator, _ = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, validator.Jailed)
+}
+
 func TestValidatorQueue(t *testing.T) {
 	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
@@ -844,6 +905,7 @@ func TestConflictingRedelegation(t *testing.T) {
 	// set the unbonding time
 	params := keeper.GetParams(ctx)
 	params.UnbondingTime = 1
+	params.MaxEntries = 1
 	keeper.SetParams(ctx, params)
 
 	// create the validators
@@ -863,7 +925,7 @@ func TestConflictingRedelegation(t *testing.T) {
 	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
 	require.True(t, got.IsOK(), "expected no error, %v", got)
 
-	// cannot redelegate again while first redelegation still exists
+	// cannot redelegate again while the first redelegation holds the only entry
 	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
 	require.True(t, !got.IsOK(), "expected an error, msg: %v", msgBeginRedelegate)
 
@@ -980,12 +1042,12 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	// unbonding delegation should have been slashed by half
 	unbonding, found := keeper.GetUnbondingDelegation(ctx, del, valA)
 	require.True(t, found)
-	require.Equal(t, int64(2), unbonding.Balance.Amount.Int64())
+	require.Equal(t, int64(2), unbonding.Entries[0].Balance.Amount.Int64())
 
 	// redelegation should have been slashed by half
 	redelegation, found := keeper.GetRedelegation(ctx, del, valA, valB)
 	require.True(t, found)
-	require.Equal(t, int64(3), redelegation.Balance.Amount.Int64())
+	require.Equal(t, int64(3), redelegation.Entries[0].Balance.Amount.Int64())
 
 	// destination delegation should have been slashed by half
 	delegation, found = keeper.GetDelegation(ctx, del, valB)
@@ -1004,12 +1066,12 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	// unbonding delegation should be unchanged
 	unbonding, found = keeper.GetUnbondingDelegation(ctx, del, valA)
 	require.True(t, found)
-	require.Equal(t, int64(2), unbonding.Balance.Amount.Int64())
+	require.Equal(t, int64(2), unbonding.Entries[0].Balance.Amount.Int64())
 
 	// redelegation should be unchanged
 	redelegation, found = keeper.GetRedelegation(ctx, del, valA, valB)
 	require.True(t, found)
-	require.Equal(t, int64(3), redelegation.Balance.Amount.Int64())
+	require.Equal(t, int64(3), redelegation.Entries[0].Balance.Amount.Int64())
 
 	// destination delegation should be unchanged
 	delegation, found = keeper.GetDelegation(ctx, del, valB)
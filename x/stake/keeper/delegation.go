@@ -155,6 +155,36 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 	store.Delete(GetUBDByValIndexKey(ubd.DelegatorAddr, ubd.ValidatorAddr))
 }
 
+// check if the unbonding delegation has the maximum number of entries
+func (k Keeper) HasMaxUnbondingDelegationEntries(ctx sdk.Context,
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return false
+	}
+	return len(ubd.Entries) >= int(k.MaxEntries(ctx))
+}
+
+// add an entry to the unbonding delegation at the given addresses, creating
+// the unbonding delegation if it does not exist. The balance is added to the
+// entry with the same creation height and completion time if any, in which
+// case newEntry is false.
+func (k Keeper) SetUnbondingDelegationEntry(ctx sdk.Context,
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	creationHeight int64, minTime time.Time, balance sdk.Coin) (ubd types.UnbondingDelegation, newEntry bool) {
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if found {
+		newEntry = ubd.AddEntry(creationHeight, minTime, balance)
+	} else {
+		ubd = types.NewUnbondingDelegation(delAddr, valAddr, creationHeight, minTime, balance)
+		newEntry = true
+	}
+	k.SetUnbondingDelegation(ctx, ubd)
+	return ubd, newEntry
+}
+
 // gets a specific unbonding queue timeslice. A timeslice is a slice of DVPairs corresponding to unbonding delegations
 // that expire at a certain time.
 func (k Keeper) GetUnbondingQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvPairs []types.DVPair) {
@@ -174,33 +204,65 @@ func (k Keeper) SetUnbondingQueueTimeSlice(ctx sdk.Context, timestamp time.Time,
 	store.Set(GetUnbondingDelegationTimeKey(timestamp), bz)
 }
 
-// Insert an unbonding delegation to the appropriate timeslice in the unbonding queue
-func (k Keeper) InsertUnbondingQueue(ctx sdk.Context, ubd types.UnbondingDelegation) {
-	timeSlice := k.GetUnbondingQueueTimeSlice(ctx, ubd.MinTime)
+// Insert an unbonding delegation entry to the appropriate timeslice in the unbonding queue
+func (k Keeper) InsertUnbondingQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetUnbondingQueueTimeSlice(ctx, completionTime)
 	dvPair := types.DVPair{ubd.DelegatorAddr, ubd.ValidatorAddr}
 	if len(timeSlice) == 0 {
-		k.SetUnbondingQueueTimeSlice(ctx, ubd.MinTime, []types.DVPair{dvPair})
+		k.SetUnbondingQueueTimeSlice(ctx, completionTime, []types.DVPair{dvPair})
 	} else {
 		timeSlice = append(timeSlice, dvPair)
-		k.SetUnbondingQueueTimeSlice(ctx, ubd.MinTime, timeSlice)
+		k.SetUnbondingQueueTimeSlice(ctx, completionTime, timeSlice)
 	}
 }
 
+// Remove an unbonding delegation entry from its timeslice in the unbonding queue
+func (k Keeper) RemoveUnbondingQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetUnbondingQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if bytes.Equal(dvPair.DelegatorAddr, ubd.DelegatorAddr) &&
+			bytes.Equal(dvPair.ValidatorAddr, ubd.ValidatorAddr) {
+			// the timeslice references each entry once, so only remove one reference
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(GetUnbondingDelegationTimeKey(completionTime))
+		return
+	}
+	k.SetUnbondingQueueTimeSlice(ctx, completionTime, timeSlice)
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UnbondingQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return store.Iterator(UnbondingQueueKey, sdk.InclusiveEndBytes(GetUnbondingDelegationTimeKey(endTime)))
 }
 
-// Returns a concatenated list of all the timeslices before currTime, and deletes the timeslices from the queue
+// Returns a concatenated list of all the timeslices before currTime, and deletes the timeslices from the queue.
+// The queue references every entry, each pair is returned once as completing it completes all its mature entries.
 func (k Keeper) DequeueAllMatureUnbondingQueue(ctx sdk.Context, currTime time.Time) (matureUnbonds []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
+	seen := make(map[string]bool)
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	unbondingTimesliceIterator := k.UnbondingQueueIterator(ctx, ctx.BlockHeader().Time)
 	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
 		timeslice := []types.DVPair{}
 		k.cdc.MustUnmarshalBinary(unbondingTimesliceIterator.Value(), &timeslice)
-		matureUnbonds = append(matureUnbonds, timeslice...)
+		for _, dvPair := range timeslice {
+			key := string(GetUBDKey(dvPair.DelegatorAddr, dvPair.ValidatorAddr))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			matureUnbonds = append(matureUnbonds, dvPair)
+		}
 		store.Delete(unbondingTimesliceIterator.Key())
 	}
 	return matureUnbonds
@@ -292,6 +354,34 @@ func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
 	store.Delete(GetREDByValDstIndexKey(red.DelegatorAddr, red.ValidatorSrcAddr, red.ValidatorDstAddr))
 }
 
+// check if the redelegation has the maximum number of entries
+func (k Keeper) HasMaxRedelegationEntries(ctx sdk.Context, delAddr sdk.AccAddress,
+	valSrcAddr, valDstAddr sdk.ValAddress) bool {
+
+	red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if !found {
+		return false
+	}
+	return len(red.Entries) >= int(k.MaxEntries(ctx))
+}
+
+// add an entry to the redelegation at the given addresses, creating the
+// redelegation if it does not exist
+func (k Keeper) SetRedelegationEntry(ctx sdk.Context, delAddr sdk.AccAddress,
+	valSrcAddr, valDstAddr sdk.ValAddress, creationHeight int64,
+	minTime time.Time, balance sdk.Coin, sharesSrc, sharesDst sdk.Dec) types.Redelegation {
+
+	red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if found {
+		red.AddEntry(creationHeight, minTime, balance, sharesSrc, sharesDst)
+	} else {
+		red = types.NewRedelegation(delAddr, valSrcAddr, valDstAddr,
+			creationHeight, minTime, balance, sharesSrc, sharesDst)
+	}
+	k.SetRedelegation(ctx, red)
+	return red
+}
+
 // Gets a specific redelegation queue timeslice. A timeslice is a slice of DVVTriplets corresponding to redelegations
 // that expire at a certain time.
 func (k Keeper) GetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvvTriplets []types.DVVTriplet) {
@@ -311,15 +401,17 @@ func (k Keeper) SetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Ti
 	store.Set(GetRedelegationTimeKey(timestamp), bz)
 }
 
-// Insert an redelegation delegation to the appropriate timeslice in the redelegation queue
-func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation) {
-	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, red.MinTime)
+// Insert an redelegation entry to the appropriate timeslice in the redelegation queue
+func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, completionTime)
 	dvvTriplet := types.DVVTriplet{red.DelegatorAddr, red.ValidatorSrcAddr, red.ValidatorDstAddr}
 	if len(timeSlice) == 0 {
-		k.SetRedelegationQueueTimeSlice(ctx, red.MinTime, []types.DVVTriplet{dvvTriplet})
+		k.SetRedelegationQueueTimeSlice(ctx, completionTime, []types.DVVTriplet{dvvTriplet})
 	} else {
 		timeSlice = append(timeSlice, dvvTriplet)
-		k.SetRedelegationQueueTimeSlice(ctx, red.MinTime, timeSlice)
+		k.SetRedelegationQueueTimeSlice(ctx, completionTime, timeSlice)
 	}
 }
 
@@ -329,15 +421,24 @@ func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) sd
 	return store.Iterator(RedelegationQueueKey, sdk.InclusiveEndBytes(GetRedelegationTimeKey(endTime)))
 }
 
-// Returns a concatenated list of all the timeslices before currTime, and deletes the timeslices from the queue
+// Returns a concatenated list of all the timeslices before currTime, and deletes the timeslices from the queue.
+// The queue references every entry, each triplet is returned once as completing it completes all its mature entries.
 func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time.Time) (matureRedelegations []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
+	seen := make(map[string]bool)
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
 	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
 		timeslice := []types.DVVTriplet{}
 		k.cdc.MustUnmarshalBinary(redelegationTimesliceIterator.Value(), &timeslice)
-		matureRedelegations = append(matureRedelegations, timeslice...)
+		for _, dvvTriplet := range timeslice {
+			key := string(GetREDKey(dvvTriplet.DelegatorAddr, dvvTriplet.ValidatorSrcAddr, dvvTriplet.ValidatorDstAddr))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			matureRedelegations = append(matureRedelegations, dvvTriplet)
+		}
 		store.Delete(redelegationTimesliceIterator.Key())
 	}
 	return matureRedelegations
@@ -367,12 +468,20 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Co
 		}
 	}
 
+	// call the appropriate hook before the validator tokens change
+	if found {
+		k.OnDelegationSharesModified(ctx, delAddr, validator.OperatorAddr)
+	} else {
+		k.OnDelegationCreated(ctx, delAddr, validator.OperatorAddr)
+	}
+
 	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt.Amount)
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	delegation.Height = ctx.BlockHeight()
 	k.SetDelegation(ctx, delegation)
+	k.OnDelegationModified(ctx, delAddr, validator.OperatorAddr)
 	return newShares, nil
 }
 
@@ -387,8 +496,6 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 		return
 	}
 
-	k.OnDelegationSharesModified(ctx, delAddr, valAddr)
-
 	// retrieve the amount to remove
 	if delegation.Shares.LT(shares) {
 		err = types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
@@ -402,19 +509,16 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 		return
 	}
 
+	k.OnDelegationSharesModified(ctx, delAddr, valAddr)
+
 	// subtract shares from delegator
 	delegation.Shares = delegation.Shares.Sub(shares)
 
-	// remove the delegation
-	if delegation.Shares.IsZero() {
-
-		// if the delegation is the operator of the validator then
-		// trigger a jail validator
-		if bytes.Equal(delegation.DelegatorAddr, validator.OperatorAddr) && !validator.Jailed {
-			k.jailValidator(ctx, validator)
-			validator = k.mustGetValidator(ctx, validator.OperatorAddr)
-		}
+	validator = k.checkMinSelfDelegation(ctx, validator, delegation)
 
+	// remove the delegation
+	removed := delegation.Shares.IsZero()
+	if removed {
 		k.RemoveDelegation(ctx, delegation)
 	} else {
 		// Update height
@@ -424,6 +528,9 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 
 	// remove the coins from the validator
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
+	if !removed {
+		k.OnDelegationModified(ctx, delAddr, valAddr)
+	}
 
 	if validator.DelegatorShares.IsZero() && validator.Status != sdk.Bonded {
 		// if bonded, we must remove in EndBlocker instead
@@ -433,11 +540,84 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	return amount, nil
 }
 
+// if the delegation is the operator of the validator and its remaining
+// tokens fall below the minimum self-delegation then trigger a jail validator
+func (k Keeper) checkMinSelfDelegation(ctx sdk.Context, validator types.Validator,
+	delegation types.Delegation) types.Validator {
+
+	if bytes.Equal(delegation.DelegatorAddr, validator.OperatorAddr) && !validator.Jailed &&
+		validator.DelegatorShareExRate().Mul(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+
+		k.jailValidator(ctx, validator)
+		validator = k.mustGetValidator(ctx, validator.OperatorAddr)
+	}
+	return validator
+}
+
+// transfer shares of a delegation to another account, without unbonding them
+func (k Keeper) TransferDelegation(ctx sdk.Context, delAddr, recipientAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, shares sdk.Dec) (types.Delegation, sdk.Error) {
+
+	if bytes.Equal(delAddr, recipientAddr) {
+		return types.Delegation{}, types.ErrSelfTransferDelegation(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.Delegation{}, types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+	if delegation.Shares.LT(shares) {
+		return types.Delegation{}, types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
+	}
+
+	// shares received through a redelegation must remain with the delegator
+	// until it completes, so that they can be slashed for the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.Delegation{}, types.ErrTransferReceivingRedelegation(k.Codespace())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.Delegation{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// withdraw the rewards of both delegations before their shares change
+	k.OnDelegationSharesModified(ctx, delAddr, valAddr)
+	recipient, found := k.GetDelegation(ctx, recipientAddr, valAddr)
+	if found {
+		k.OnDelegationSharesModified(ctx, recipientAddr, valAddr)
+	} else {
+		k.OnDelegationCreated(ctx, recipientAddr, valAddr)
+		recipient = types.Delegation{
+			DelegatorAddr: recipientAddr,
+			ValidatorAddr: valAddr,
+			Shares:        sdk.ZeroDec(),
+		}
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	k.checkMinSelfDelegation(ctx, validator, delegation)
+	if delegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delegation)
+	} else {
+		delegation.Height = ctx.BlockHeight()
+		k.SetDelegation(ctx, delegation)
+		k.OnDelegationModified(ctx, delAddr, valAddr)
+	}
+
+	recipient.Shares = recipient.Shares.Add(shares)
+	recipient.Height = ctx.BlockHeight()
+	k.SetDelegation(ctx, recipient)
+	k.OnDelegationModified(ctx, recipientAddr, valAddr)
+
+	return recipient, nil
+}
+
 //______________________________________________________________________________________________________
 
-// get info for begin functions: MinTime and CreationHeight
+// get info for begin functions: completionTime and CreationHeight
 func (k Keeper) getBeginInfo(ctx sdk.Context, valSrcAddr sdk.ValAddress) (
-	minTime time.Time, height int64, completeNow bool) {
+	completionTime time.Time, height int64, completeNow bool) {
 
 	validator, found := k.GetValidator(ctx, valSrcAddr)
 
@@ -445,39 +625,37 @@ func (k Keeper) getBeginInfo(ctx sdk.Context, valSrcAddr sdk.ValAddress) (
 	case !found || validator.Status == sdk.Bonded:
 
 		// the longest wait - just unbonding period from now
-		minTime = ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+		completionTime = ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
 		height = ctx.BlockHeight()
-		return minTime, height, false
+		return completionTime, height, false
 
 	case validator.Status == sdk.Unbonded:
-		return minTime, height, true
+		return completionTime, height, true
 
 	case validator.Status == sdk.Unbonding:
-		minTime = validator.UnbondingMinTime
+		completionTime = validator.UnbondingMinTime
 		height = validator.UnbondingHeight
-		return minTime, height, false
+		return completionTime, height, false
 
 	default:
 		panic("unknown validator status")
 	}
 }
 
-// begin unbonding an unbonding record
-func (k Keeper) BeginUnbonding(ctx sdk.Context,
-	delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (types.UnbondingDelegation, sdk.Error) {
+// begin unbonding part or all of a delegation
+func (k Keeper) BeginUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, sharesAmount sdk.Dec) (completionTime time.Time, errSdk sdk.Error) {
 
-	// TODO quick fix, instead we should use an index, see https://github.com/cosmos/cosmos-sdk/issues/1402
-	_, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
-	if found {
-		return types.UnbondingDelegation{}, types.ErrExistingUnbondingDelegation(k.Codespace())
+	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+		return time.Time{}, types.ErrMaxUnbondingDelegationEntries(k.Codespace())
 	}
 
 	// create the unbonding delegation
-	minTime, height, completeNow := k.getBeginInfo(ctx, valAddr)
+	completionTime, height, completeNow := k.getBeginInfo(ctx, valAddr)
 
 	returnAmount, err := k.unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
-		return types.UnbondingDelegation{}, err
+		return completionTime, err
 	}
 
 	balance := sdk.NewCoin(k.BondDenom(ctx), returnAmount.RoundInt())
@@ -486,26 +664,19 @@ func (k Keeper) BeginUnbonding(ctx sdk.Context,
 	if completeNow {
 		_, _, err := k.bankKeeper.AddCoins(ctx, delAddr, sdk.Coins{balance})
 		if err != nil {
-			return types.UnbondingDelegation{}, err
+			return completionTime, err
 		}
-		return types.UnbondingDelegation{MinTime: minTime}, nil
+		return completionTime, nil
 	}
 
-	ubd := types.UnbondingDelegation{
-		DelegatorAddr:  delAddr,
-		ValidatorAddr:  valAddr,
-		CreationHeight: height,
-		MinTime:        minTime,
-		Balance:        balance,
-		InitialBalance: balance,
+	ubd, newEntry := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, height, completionTime, balance)
+	if newEntry {
+		k.InsertUnbondingQueue(ctx, ubd, completionTime)
 	}
-	k.SetUnbondingDelegation(ctx, ubd)
-	k.InsertUnbondingQueue(ctx, ubd)
-	return ubd, nil
+	return completionTime, nil
 }
 
-// complete unbonding an unbonding record
-// CONTRACT: Expects unbonding passed in has finished the unbonding period
+// complete unbonding all the mature entries of an unbonding delegation
 func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Error {
 
 	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
@@ -513,69 +684,147 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 		return types.ErrNoUnbondingDelegation(k.Codespace())
 	}
 
-	_, _, err := k.bankKeeper.AddCoins(ctx, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
-	if err != nil {
-		return err
+	ctxTime := ctx.BlockHeader().Time
+
+	// loop through all the entries and complete unbonding mature entries
+	for i := 0; i < len(ubd.Entries); i++ {
+		entry := ubd.Entries[i]
+		if !entry.IsMature(ctxTime) {
+			continue
+		}
+		ubd.RemoveEntry(int64(i))
+		i--
+
+		_, _, err := k.bankKeeper.AddCoins(ctx, ubd.DelegatorAddr, sdk.Coins{entry.Balance})
+		if err != nil {
+			return err
+		}
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
 	}
-	k.RemoveUnbondingDelegation(ctx, ubd)
 	return nil
 }
 
-// complete unbonding an unbonding record
-func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
-	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (types.Redelegation, sdk.Error) {
+// cancel an unbonding delegation entry which has not yet matured, delegating
+// the amount back to the validator it is unbonding from. The balance of the
+// entry already reflects any slashes which occurred since it was created.
+func (k Keeper) CancelUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin) (types.UnbondingDelegation, sdk.Error) {
 
-	// check if there is already a redelgation in progress from src to dst
-	// TODO quick fix, instead we should use an index, see https://github.com/cosmos/cosmos-sdk/issues/1402
-	_, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
-	if found {
-		return types.Redelegation{}, types.ErrConflictingRedelegation(k.Codespace())
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.UnbondingDelegation{}, types.ErrNoUnbondingDelegation(k.Codespace())
 	}
 
+	// entries of the same height are merged as they are created, several
+	// matches can only come from entries with different completion times
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight != creationHeight {
+			continue
+		}
+		if entryIndex != -1 {
+			return types.UnbondingDelegation{}, types.ErrAmbiguousUnbondingDelegationEntry(k.Codespace(), creationHeight)
+		}
+		entryIndex = i
+	}
+	if entryIndex == -1 {
+		return types.UnbondingDelegation{}, types.ErrNoUnbondingDelegationAtHeight(k.Codespace(), creationHeight)
+	}
+	entry := ubd.Entries[entryIndex]
+
+	// the entry is completed in the EndBlocker of this block
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return types.UnbondingDelegation{}, types.ErrUnbondingDelegationMature(k.Codespace())
+	}
+
+	if amount.Denom != k.BondDenom(ctx) {
+		return types.UnbondingDelegation{}, types.ErrBadDenom(k.Codespace())
+	}
+	if entry.Balance.Amount.LT(amount.Amount) {
+		return types.UnbondingDelegation{}, types.ErrNotEnoughUnbondingBalance(k.Codespace(), entry.Balance.String())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.UnbondingDelegation{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+	if validator.Jailed && !bytes.Equal(validator.OperatorAddr, delAddr) {
+		return types.UnbondingDelegation{}, types.ErrValidatorJailed(k.Codespace())
+	}
+
+	// the unbonding tokens are still loose tokens, so they are not subtracted
+	// from the delegator account
+	_, err := k.Delegate(ctx, delAddr, amount, validator, false)
+	if err != nil {
+		return types.UnbondingDelegation{}, err
+	}
+
+	entry.Balance = entry.Balance.Minus(amount)
+	entry.InitialBalance = entry.InitialBalance.Minus(sdk.NewCoin(amount.Denom,
+		sdk.MinInt(amount.Amount, entry.InitialBalance.Amount)))
+	if entry.Balance.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.RemoveUnbondingQueueEntry(ctx, ubd, entry.CompletionTime)
+	} else {
+		ubd.Entries[entryIndex] = entry
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+	return ubd, nil
+}
+
+// begin redelegating part or all of a delegation to another validator
+func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (completionTime time.Time, errSdk sdk.Error) {
+
 	// check if this is a transitive redelegation
 	if k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr) {
-		return types.Redelegation{}, types.ErrTransitiveRedelegation(k.Codespace())
+		return completionTime, types.ErrTransitiveRedelegation(k.Codespace())
+	}
+
+	if k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
+		return completionTime, types.ErrMaxRedelegationEntries(k.Codespace())
 	}
 
 	returnAmount, err := k.unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
-		return types.Redelegation{}, err
+		return completionTime, err
 	}
 
 	returnCoin := sdk.Coin{k.BondDenom(ctx), returnAmount.RoundInt()}
 	dstValidator, found := k.GetValidator(ctx, valDstAddr)
 	if !found {
-		return types.Redelegation{}, types.ErrBadRedelegationDst(k.Codespace())
+		return completionTime, types.ErrBadRedelegationDst(k.Codespace())
 	}
 	sharesCreated, err := k.Delegate(ctx, delAddr, returnCoin, dstValidator, false)
 	if err != nil {
-		return types.Redelegation{}, err
+		return completionTime, err
 	}
 
 	// create the unbonding delegation
-	minTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
+	completionTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
 
 	if completeNow { // no need to create the redelegation object
-		return types.Redelegation{MinTime: minTime}, nil
+		return completionTime, nil
 	}
 
-	red := types.Redelegation{
-		DelegatorAddr:    delAddr,
-		ValidatorSrcAddr: valSrcAddr,
-		ValidatorDstAddr: valDstAddr,
-		CreationHeight:   height,
-		MinTime:          minTime,
-		SharesDst:        sharesCreated,
-		SharesSrc:        sharesAmount,
-		Balance:          returnCoin,
-		InitialBalance:   returnCoin,
-	}
-	k.SetRedelegation(ctx, red)
-	k.InsertRedelegationQueue(ctx, red)
-	return red, nil
+	red := k.SetRedelegationEntry(ctx, delAddr, valSrcAddr, valDstAddr,
+		height, completionTime, returnCoin, sharesAmount, sharesCreated)
+	k.InsertRedelegationQueue(ctx, red, completionTime)
+	return completionTime, nil
 }
 
-// complete unbonding an ongoing redelegation
+// complete all the mature entries of an ongoing redelegation
 func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress) sdk.Error {
 
@@ -584,12 +833,22 @@ func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 		return types.ErrNoRedelegation(k.Codespace())
 	}
 
-	// ensure that enough time has passed
 	ctxTime := ctx.BlockHeader().Time
-	if red.MinTime.After(ctxTime) {
-		return types.ErrNotMature(k.Codespace(), "redelegation", "unit-time", red.MinTime, ctxTime)
+
+	// loop through all the entries and complete mature redelegation entries
+	for i := 0; i < len(red.Entries); i++ {
+		entry := red.Entries[i]
+		if entry.IsMature(ctxTime) {
+			red.RemoveEntry(int64(i))
+			i--
+		}
 	}
 
-	k.RemoveRedelegation(ctx, red)
+	// set the redelegation or remove it if there are no more entries
+	if len(red.Entries) == 0 {
+		k.RemoveRedelegation(ctx, red)
+	} else {
+		k.SetRedelegation(ctx, red)
+	}
 	return nil
 }
@@ -133,13 +133,8 @@ func TestDelegation(t *testing.T) {
 func TestUnbondingDelegation(t *testing.T) {
 	ctx, _, keeper := CreateTestInput(t, false, 0)
 
-	ubd := types.UnbondingDelegation{
-		DelegatorAddr:  addrDels[0],
-		ValidatorAddr:  addrVals[0],
-		CreationHeight: 0,
-		MinTime:        time.Unix(0, 0),
-		Balance:        sdk.NewInt64Coin("steak", 5),
-	}
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 5))
 
 	// set and retrieve a record
 	keeper.SetUnbondingDelegation(ctx, ubd)
@@ -148,7 +143,7 @@ func TestUnbondingDelegation(t *testing.T) {
 	require.True(t, ubd.Equal(resUnbond))
 
 	// modify a records, save, and retrieve
-	ubd.Balance = sdk.NewInt64Coin("steak", 21)
+	ubd.Entries[0].Balance = sdk.NewInt64Coin("steak", 21)
 	keeper.SetUnbondingDelegation(ctx, ubd)
 
 	resUnbonds := keeper.GetUnbondingDelegations(ctx, addrDels[0], 5)
@@ -334,9 +329,10 @@ func TestUndelegateFromUnbondingValidator(t *testing.T) {
 	// retrieve the unbonding delegation
 	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
-	require.True(t, ubd.Balance.IsEqual(sdk.NewInt64Coin(params.BondDenom, 6)))
-	assert.Equal(t, blockHeight, ubd.CreationHeight)
-	assert.True(t, blockTime.Add(params.UnbondingTime).Equal(ubd.MinTime))
+	require.Len(t, ubd.Entries, 1)
+	require.True(t, ubd.Entries[0].Balance.IsEqual(sdk.NewInt64Coin(params.BondDenom, 6)))
+	assert.Equal(t, blockHeight, ubd.Entries[0].CreationHeight)
+	assert.True(t, blockTime.Add(params.UnbondingTime).Equal(ubd.Entries[0].CompletionTime))
 }
 
 func TestUndelegateFromUnbondedValidator(t *testing.T) {
@@ -407,15 +403,9 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 func TestGetRedelegationsFromValidator(t *testing.T) {
 	ctx, _, keeper := CreateTestInput(t, false, 0)
 
-	rd := types.Redelegation{
-		DelegatorAddr:    addrDels[0],
-		ValidatorSrcAddr: addrVals[0],
-		ValidatorDstAddr: addrVals[1],
-		CreationHeight:   0,
-		MinTime:          time.Unix(0, 0),
-		SharesSrc:        sdk.NewDec(5),
-		SharesDst:        sdk.NewDec(5),
-	}
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 5),
+		sdk.NewDec(5), sdk.NewDec(5))
 
 	// set and retrieve a record
 	keeper.SetRedelegation(ctx, rd)
@@ -437,15 +427,9 @@ func TestGetRedelegationsFromValidator(t *testing.T) {
 func TestRedelegation(t *testing.T) {
 	ctx, _, keeper := CreateTestInput(t, false, 0)
 
-	rd := types.Redelegation{
-		DelegatorAddr:    addrDels[0],
-		ValidatorSrcAddr: addrVals[0],
-		ValidatorDstAddr: addrVals[1],
-		CreationHeight:   0,
-		MinTime:          time.Unix(0, 0),
-		SharesSrc:        sdk.NewDec(5),
-		SharesDst:        sdk.NewDec(5),
-	}
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 5),
+		sdk.NewDec(5), sdk.NewDec(5))
 
 	// test shouldn't have and redelegations
 	has := keeper.HasReceivingRedelegation(ctx, addrDels[0], addrVals[1])
@@ -473,8 +457,8 @@ func TestRedelegation(t *testing.T) {
 	require.True(t, has)
 
 	// modify a records, save, and retrieve
-	rd.SharesSrc = sdk.NewDec(21)
-	rd.SharesDst = sdk.NewDec(21)
+	rd.Entries[0].SharesSrc = sdk.NewDec(21)
+	rd.Entries[0].SharesDst = sdk.NewDec(21)
 	keeper.SetRedelegation(ctx, rd)
 
 	resRed, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -637,9 +621,10 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	// retrieve the unbonding delegation
 	ubd, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found)
-	require.True(t, ubd.Balance.IsEqual(sdk.NewInt64Coin(params.BondDenom, 6)))
-	assert.Equal(t, blockHeight, ubd.CreationHeight)
-	assert.True(t, blockTime.Add(params.UnbondingTime).Equal(ubd.MinTime))
+	require.Len(t, ubd.Entries, 1)
+	require.True(t, ubd.Entries[0].Balance.IsEqual(sdk.NewInt64Coin(params.BondDenom, 6)))
+	assert.Equal(t, blockHeight, ubd.Entries[0].CreationHeight)
+	assert.True(t, blockTime.Add(params.UnbondingTime).Equal(ubd.Entries[0].CompletionTime))
 }
 
 func TestRedelegateFromUnbondedValidator(t *testing.T) {
@@ -715,3 +700,287 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	pool := keeper.GetPool(ctx)
+	pool.LooseTokens = sdk.NewDec(20)
+
+	//create a validator with a self-delegation
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator.MinSelfDelegation = sdk.NewInt(5)
+	validator, pool, issuedShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: val0AccAddr,
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares,
+	})
+
+	// invalid transfers
+	_, err := keeper.TransferDelegation(ctx, val0AccAddr, val0AccAddr, addrVals[0], sdk.NewDec(1))
+	require.Error(t, err)
+	_, err = keeper.TransferDelegation(ctx, val0AccAddr, addrDels[0], addrVals[0], sdk.NewDec(11))
+	require.Error(t, err)
+	_, err = keeper.TransferDelegation(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewDec(1))
+	require.Error(t, err)
+
+	// a partial transfer keeps the operator above its minimum self-delegation
+	recipient, err := keeper.TransferDelegation(ctx, val0AccAddr, addrDels[0], addrVals[0], sdk.NewDec(5))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(5), recipient.Shares)
+	delegation, found := keeper.GetDelegation(ctx, val0AccAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(5), delegation.Shares)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.False(t, validator.Jailed)
+	require.Equal(t, sdk.NewDec(10), validator.Tokens)
+
+	// transferring to an existing delegation adds to its shares
+	recipient, err = keeper.TransferDelegation(ctx, val0AccAddr, addrDels[0], addrVals[0], sdk.NewDec(1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(6), recipient.Shares)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, validator.Jailed)
+
+	// transferring all the shares removes the delegation
+	_, err = keeper.TransferDelegation(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewDec(6))
+	require.NoError(t, err)
+	_, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	// shares received through a redelegation cannot be transferred
+	keeper.SetRedelegation(ctx, types.NewRedelegation(addrDels[1], addrVals[1], addrVals[0], 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 5), sdk.NewDec(5), sdk.NewDec(5)))
+	_, err = keeper.TransferDelegation(ctx, addrDels[1], addrDels[0], addrVals[0], sdk.NewDec(1))
+	require.Error(t, err)
+}
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	pool := keeper.GetPool(ctx)
+	pool.LooseTokens = sdk.NewDec(10)
+
+	//create a validator and a delegator to that validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, issuedShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: addrDels[0],
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares,
+	})
+
+	completionTime, err := keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDec(6))
+	require.NoError(t, err)
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	entry := ubd.Entries[0]
+	require.Equal(t, int64(6), entry.Balance.Amount.Int64())
+
+	// invalid cancellations
+	bondDenom := keeper.BondDenom(ctx)
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], entry.CreationHeight, sdk.NewInt64Coin(bondDenom, 1))
+	require.Error(t, err)
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], entry.CreationHeight+1, sdk.NewInt64Coin(bondDenom, 1))
+	require.Error(t, err)
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], entry.CreationHeight, sdk.NewInt64Coin("foo", 1))
+	require.Error(t, err)
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], entry.CreationHeight, sdk.NewInt64Coin(bondDenom, 7))
+	require.Error(t, err)
+
+	// a partial cancellation delegates the tokens back to the validator
+	ubd, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], entry.CreationHeight, sdk.NewInt64Coin(bondDenom, 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), ubd.Entries[0].Balance.Amount.Int64())
+	require.Equal(t, int64(4), ubd.Entries[0].InitialBalance.Amount.Int64())
+	resUnbond, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.True(t, ubd.Equal(resUnbond))
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, int64(6), delegation.Shares.RoundInt64())
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, int64(6), validator.BondedTokens().RoundInt64())
+	pool = keeper.GetPool(ctx)
+	require.Equal(t, int64(4), pool.LooseTokens.RoundInt64())
+	require.Equal(t, int64(6), pool.BondedTokens.RoundInt64())
+
+	// cancelling the remaining balance removes the unbonding delegation from the queue
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], entry.CreationHeight, sdk.NewInt64Coin(bondDenom, 4))
+	require.NoError(t, err)
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	require.Empty(t, keeper.GetUnbondingQueueTimeSlice(ctx, completionTime))
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, int64(10), delegation.Shares.RoundInt64())
+
+	// a mature unbonding delegation cannot be cancelled
+	completionTime, err = keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDec(6))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], entry.CreationHeight, sdk.NewInt64Coin(bondDenom, 1))
+	require.Error(t, err)
+}
+
+func TestCancelUnbondingDelegationSameHeight(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	pool := keeper.GetPool(ctx)
+	pool.LooseTokens = sdk.NewDec(10)
+
+	//create a validator and a delegator to that validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, issuedShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: addrDels[0],
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares,
+	})
+
+	// two unbondings of the same block share a single entry
+	completionTime, err := keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDec(2))
+	require.NoError(t, err)
+	_, err = keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDec(3))
+	require.NoError(t, err)
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, int64(5), ubd.Entries[0].Balance.Amount.Int64())
+	require.Len(t, keeper.GetUnbondingQueueTimeSlice(ctx, completionTime), 1)
+
+	// the whole balance of the block can be cancelled at once
+	bondDenom := keeper.BondDenom(ctx)
+	height := ubd.Entries[0].CreationHeight
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], height, sdk.NewInt64Coin(bondDenom, 4))
+	require.NoError(t, err)
+	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, int64(1), ubd.Entries[0].Balance.Amount.Int64())
+
+	// entries of the same height with different completion times cannot be
+	// told apart, the cancellation is rejected
+	ubd.AddEntry(height, completionTime.Add(time.Hour), sdk.NewInt64Coin(bondDenom, 1))
+	require.Len(t, ubd.Entries, 2)
+	keeper.SetUnbondingDelegation(ctx, ubd)
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], height, sdk.NewInt64Coin(bondDenom, 1))
+	require.Error(t, err)
+	resUnbond, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.True(t, ubd.Equal(resUnbond))
+}
+
+func TestUnbondingDelegationMaxEntries(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	pool := keeper.GetPool(ctx)
+	pool.LooseTokens = sdk.NewDec(10)
+
+	//create a validator and a delegator to that validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, issuedShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: addrDels[0],
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares,
+	})
+
+	maxEntries := keeper.MaxEntries(ctx)
+
+	// should all pass
+	var completionTime time.Time
+	for i := uint16(0); i < maxEntries; i++ {
+		var err sdk.Error
+		ctx = ctx.WithBlockHeight(int64(i))
+		completionTime, err = keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
+		require.NoError(t, err)
+	}
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, int(maxEntries), len(ubd.Entries))
+
+	// the queue references every entry
+	require.Equal(t, int(maxEntries), len(keeper.GetUnbondingQueueTimeSlice(ctx, completionTime)))
+
+	// an additional unbond should fail due to max entries
+	_, err := keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
+	require.Error(t, err)
+
+	// mature unbonding delegations, the pair is dequeued once
+	ctx = ctx.WithBlockTime(completionTime)
+	matureUnbonds := keeper.DequeueAllMatureUnbondingQueue(ctx, completionTime)
+	require.Equal(t, []types.DVPair{{DelegatorAddr: addrDels[0], ValidatorAddr: addrVals[0]}}, matureUnbonds)
+	err = keeper.CompleteUnbonding(ctx, addrDels[0], addrVals[0])
+	require.NoError(t, err)
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	// unbonding should work again
+	_, err = keeper.BeginUnbonding(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
+	require.NoError(t, err)
+}
+
+func TestRedelegationMaxEntries(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	pool := keeper.GetPool(ctx)
+	pool.LooseTokens = sdk.NewDec(30)
+
+	//create two validators and a delegation to the first one
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, issuedShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+	keeper.SetDelegation(ctx, types.Delegation{
+		DelegatorAddr: addrDels[0],
+		ValidatorAddr: addrVals[0],
+		Shares:        issuedShares,
+	})
+	validator2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+	validator2, pool, _ = validator2.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator2 = TestingUpdateValidator(keeper, ctx, validator2)
+	require.Equal(t, sdk.Bonded, validator2.Status)
+
+	maxEntries := keeper.MaxEntries(ctx)
+
+	// redelegations should pass
+	var completionTime time.Time
+	for i := uint16(0); i < maxEntries; i++ {
+		var err sdk.Error
+		ctx = ctx.WithBlockHeight(int64(i))
+		completionTime, err = keeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], sdk.NewDec(1))
+		require.NoError(t, err)
+	}
+	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.True(t, found)
+	require.Equal(t, int(maxEntries), len(red.Entries))
+
+	// an additional redelegation should fail due to max entries
+	_, err := keeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], sdk.NewDec(1))
+	require.Error(t, err)
+
+	// mature redelegations, the triplet is dequeued once
+	ctx = ctx.WithBlockTime(completionTime)
+	matureRedelegations := keeper.DequeueAllMatureRedelegationQueue(ctx, completionTime)
+	require.Equal(t, []types.DVVTriplet{{
+		DelegatorAddr:    addrDels[0],
+		ValidatorSrcAddr: addrVals[0],
+		ValidatorDstAddr: addrVals[1],
+	}}, matureRedelegations)
+	err = keeper.CompleteRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.NoError(t, err)
+	_, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.False(t, found)
+
+	// redelegation should work again
+	_, err = keeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], sdk.NewDec(1))
+	require.NoError(t, err)
+}
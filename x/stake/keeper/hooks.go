@@ -35,6 +35,18 @@ func (k Keeper) OnValidatorBeginUnbonding(ctx sdk.Context, address sdk.ConsAddre
 	}
 }
 
+func (k Keeper) OnValidatorConsPubKeyRotated(ctx sdk.Context, address sdk.ValAddress, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	if k.hooks != nil {
+		k.hooks.OnValidatorConsPubKeyRotated(ctx, address, oldConsAddr, newConsAddr)
+	}
+}
+
+func (k Keeper) OnValidatorSlashed(ctx sdk.Context, address sdk.ValAddress, fraction sdk.Dec) {
+	if k.hooks != nil {
+		k.hooks.OnValidatorSlashed(ctx, address, fraction)
+	}
+}
+
 func (k Keeper) OnDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	if k.hooks != nil {
 		k.hooks.OnDelegationCreated(ctx, delAddr, valAddr)
@@ -47,6 +59,12 @@ func (k Keeper) OnDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddre
 	}
 }
 
+func (k Keeper) OnDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.OnDelegationModified(ctx, delAddr, valAddr)
+	}
+}
+
 func (k Keeper) OnDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	if k.hooks != nil {
 		k.hooks.OnDelegationRemoved(ctx, delAddr, valAddr)
@@ -30,6 +30,9 @@ var (
 	UnbondingQueueKey                = []byte{0x0D} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey             = []byte{0x0E} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey                = []byte{0x0F} // prefix for the timestamps in validator queue
+	ConsPubKeyRotationKey            = []byte{0x10} // prefix for the consensus pubkey rotations of each validator
+	PendingConsPubKeyRotationKey     = []byte{0x11} // prefix for the consensus pubkey rotations to be reported to Tendermint
+	ValidatorPowerHistoryKey         = []byte{0x12} // prefix for the history of the bonded tokens of each validator
 )
 
 const maxDigitsForAccount = 12 // ~220,000,000 atoms created at launch
@@ -249,3 +252,39 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		GetREDsToValDstIndexKey(valDstAddr),
 		delAddr.Bytes()...)
 }
+
+//________________________________________________________________________________
+
+// gets the key for a consensus pubkey rotation of a validator
+// VALUE: stake/types.ConsPubKeyRotation
+func GetConsPubKeyRotationKey(valAddr sdk.ValAddress, height int64, newConsAddr sdk.ConsAddress) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	key := append(GetConsPubKeyRotationsKey(valAddr), heightBytes...)
+	return append(key, newConsAddr.Bytes()...)
+}
+
+// gets the prefix for all the consensus pubkey rotations of a validator
+func GetConsPubKeyRotationsKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// gets the key for the consensus pubkey rotation of a validator which has not
+// yet been reported to Tendermint
+// VALUE: crypto.PubKey known to Tendermint
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// gets the key for the bonded tokens of a validator from a height on
+// VALUE: stake/keeper.validatorPowerRecord
+func GetValidatorPowerRecordKey(valAddr sdk.ValAddress, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(GetValidatorPowerHistoryKey(valAddr), heightBytes...)
+}
+
+// gets the prefix for the history of the bonded tokens of a validator
+func GetValidatorPowerHistoryKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorPowerHistoryKey, valAddr.Bytes()...)
+}
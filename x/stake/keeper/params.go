@@ -54,6 +54,34 @@ func (k Keeper) MaxValidators(ctx sdk.Context) (res uint16) {
 	return
 }
 
+// MaxEntries - Maximum number of simultaneous unbonding
+// delegations or redelegations (per pair/trio)
+func (k Keeper) MaxEntries(ctx sdk.Context) (res uint16) {
+	k.paramstore.Get(ctx, types.KeyMaxEntries, &res)
+	return
+}
+
+// MaxVotingPowerFraction - Maximum fraction of the total bonded power
+// reported to Tendermint for a single validator
+func (k Keeper) MaxVotingPowerFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxVotingPowerFraction, &res)
+	return
+}
+
+// MaxConsPubKeyRotations - Maximum number of consensus pubkey rotations of a
+// validator within the unbonding time
+func (k Keeper) MaxConsPubKeyRotations(ctx sdk.Context) (res uint16) {
+	k.paramstore.Get(ctx, types.KeyMaxConsPubKeyRotations, &res)
+	return
+}
+
+// ConsPubKeyRotationFee - Amount of bond denom tokens burned for a consensus
+// pubkey rotation
+func (k Keeper) ConsPubKeyRotationFee(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyConsPubKeyRotationFee, &res)
+	return
+}
+
 // BondDenom - Bondable coin denomination
 func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
@@ -68,6 +96,10 @@ func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.GoalBonded = k.GoalBonded(ctx)
 	res.UnbondingTime = k.UnbondingTime(ctx)
 	res.MaxValidators = k.MaxValidators(ctx)
+	res.MaxEntries = k.MaxEntries(ctx)
+	res.MaxVotingPowerFraction = k.MaxVotingPowerFraction(ctx)
+	res.MaxConsPubKeyRotations = k.MaxConsPubKeyRotations(ctx)
+	res.ConsPubKeyRotationFee = k.ConsPubKeyRotationFee(ctx)
 	res.BondDenom = k.BondDenom(ctx)
 	return
 }
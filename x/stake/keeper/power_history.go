@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the bonded tokens of a validator from the end of a block on, which can be
+// above the power reported to Tendermint as it is capped by the
+// MaxVotingPowerFraction
+type validatorPowerRecord struct {
+	Power int64     `json:"power"`
+	Time  time.Time `json:"time"`
+}
+
+// get the bonded tokens of a validator at the end of a block, rounded like
+// the power reported to Tendermint. Not found if the validator was not
+// bonded since its history was last pruned.
+func (k Keeper) ValidatorPowerAt(ctx sdk.Context, consAddr sdk.ConsAddress, height int64) (power int64, found bool) {
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found || height < 0 {
+		return 0, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorPowerHistoryKey(validator.OperatorAddr)
+	iterator := store.ReverseIterator(prefix, GetValidatorPowerRecordKey(validator.OperatorAddr, height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+	var record validatorPowerRecord
+	k.cdc.MustUnmarshalBinary(iterator.Value(), &record)
+	return record.Power, true
+}
+
+// record the bonded tokens of a validator at the end of the current block if
+// they changed, pruning the records older than an unbonding period as no
+// infraction can be slashed that far back
+func (k Keeper) updateValidatorPowerHistory(ctx sdk.Context, valAddr sdk.ValAddress, power int64) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorPowerHistoryKey(valAddr)
+	now := ctx.BlockHeader().Time
+
+	// a record is superseded once the next record is older than the cutoff,
+	// the latest record older than the cutoff is still in effect
+	cutoff := now.Add(-k.UnbondingTime(ctx))
+	var prunable [][]byte
+	var previousKey []byte
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var record validatorPowerRecord
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &record)
+		if !record.Time.Before(cutoff) {
+			break
+		}
+		if previousKey != nil {
+			prunable = append(prunable, previousKey)
+		}
+		previousKey = append([]byte{}, iterator.Key()...)
+	}
+	iterator.Close()
+	for _, key := range prunable {
+		store.Delete(key)
+	}
+
+	// only the changes are recorded
+	iterator = sdk.KVStoreReversePrefixIterator(store, prefix)
+	if iterator.Valid() {
+		var last validatorPowerRecord
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &last)
+		if last.Power == power {
+			iterator.Close()
+			return
+		}
+	}
+	iterator.Close()
+
+	// the validators of the genesis file are recorded from height zero
+	height := ctx.BlockHeight()
+	if height < 0 {
+		height = 0
+	}
+	bz := k.cdc.MustMarshalBinary(validatorPowerRecord{Power: power, Time: now})
+	store.Set(GetValidatorPowerRecordKey(valAddr, height), bz)
+}
+
+// remove the power history of a validator
+func (k Keeper) removeValidatorPowerHistory(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorPowerHistoryKey(valAddr))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestValidatorPowerAt(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 1, Time: time.Unix(0, 0)})
+
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerFraction = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	amts := []int64{10, 20, 70}
+	var validators [3]types.Validator
+	for i, amt := range amts {
+		pool := keeper.GetPool(ctx)
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, sdk.NewInt(amt))
+		keeper.SetPool(ctx, pool)
+		keeper.SetValidator(ctx, validators[i])
+		keeper.SetValidatorByPowerIndex(ctx, validators[i], pool)
+	}
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	consAddr := sdk.ConsAddress(PKs[2].Address())
+
+	// the uncapped power is recorded from the height the validator bonded
+	_, found := keeper.ValidatorPowerAt(ctx, consAddr, 0)
+	require.False(t, found)
+	power, found := keeper.ValidatorPowerAt(ctx, consAddr, 1)
+	require.True(t, found)
+	require.Equal(t, int64(70), power)
+
+	// a change of the bonded tokens is recorded from its height
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 5, Time: time.Unix(10, 0)})
+	validator, _ := keeper.GetValidator(ctx, validators[2].OperatorAddr)
+	keeper.AddValidatorTokensAndShares(ctx, validator, sdk.NewInt(10))
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	power, _ = keeper.ValidatorPowerAt(ctx, consAddr, 4)
+	require.Equal(t, int64(70), power)
+	power, _ = keeper.ValidatorPowerAt(ctx, consAddr, 5)
+	require.Equal(t, int64(80), power)
+	power, _ = keeper.ValidatorPowerAt(ctx, consAddr, 100)
+	require.Equal(t, int64(80), power)
+
+	// the records superseded for longer than an unbonding period are pruned
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 10, Time: time.Unix(20, 0).Add(keeper.UnbondingTime(ctx))})
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	_, found = keeper.ValidatorPowerAt(ctx, consAddr, 4)
+	require.False(t, found)
+	power, _ = keeper.ValidatorPowerAt(ctx, consAddr, 5)
+	require.Equal(t, int64(80), power)
+}
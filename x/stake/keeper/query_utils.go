@@ -99,3 +99,45 @@ func (k Keeper) GetAllRedelegations(ctx sdk.Context, delegator sdk.AccAddress) (
 	}
 	return redelegations
 }
+
+// return a page of the delegations of a delegator, ordered by validator
+// address
+func (k Keeper) GetDelegatorDelegationsPaginated(ctx sdk.Context, delegator sdk.AccAddress,
+	page sdk.PageRequest) (delegations []types.Delegation, res sdk.PageResponse, err error) {
+
+	store := ctx.KVStore(k.storeKey)
+	delegations = []types.Delegation{}
+	res, err = sdk.Paginate(store, GetDelegationsKey(delegator), page, func(key, value []byte) error {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, key, value))
+		return nil
+	})
+	return delegations, res, err
+}
+
+// return a page of the unbonding-delegations of a delegator, ordered by
+// validator address
+func (k Keeper) GetDelegatorUnbondingDelegationsPaginated(ctx sdk.Context, delegator sdk.AccAddress,
+	page sdk.PageRequest) (ubds []types.UnbondingDelegation, res sdk.PageResponse, err error) {
+
+	store := ctx.KVStore(k.storeKey)
+	ubds = []types.UnbondingDelegation{}
+	res, err = sdk.Paginate(store, GetUBDsKey(delegator), page, func(key, value []byte) error {
+		ubds = append(ubds, types.MustUnmarshalUBD(k.cdc, key, value))
+		return nil
+	})
+	return ubds, res, err
+}
+
+// return a page of the redelegations of a delegator, ordered by source and
+// destination validator addresses
+func (k Keeper) GetDelegatorRedelegationsPaginated(ctx sdk.Context, delegator sdk.AccAddress,
+	page sdk.PageRequest) (reds []types.Redelegation, res sdk.PageResponse, err error) {
+
+	store := ctx.KVStore(k.storeKey)
+	reds = []types.Redelegation{}
+	res, err = sdk.Paginate(store, GetREDsKey(delegator), page, func(key, value []byte) error {
+		reds = append(reds, types.MustUnmarshalRED(k.cdc, key, value))
+		return nil
+	})
+	return reds, res, err
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// return all the consensus pubkey rotations of a validator, oldest first
+func (k Keeper) GetConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetConsPubKeyRotationsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.ConsPubKeyRotation
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+	return rotations
+}
+
+// set a consensus pubkey rotation of a validator
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinary(rotation)
+	store.Set(GetConsPubKeyRotationKey(rotation.OperatorAddr, rotation.Height, sdk.GetConsAddress(rotation.NewConsPubKey)), bz)
+}
+
+// return the consensus pubkey rotations of all validators
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.ConsPubKeyRotation
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+	return rotations
+}
+
+// return the consensus pubkey rotations which have not yet been reported to
+// Tendermint
+func (k Keeper) GetAllPendingConsPubKeyRotations(ctx sdk.Context) (pending []types.PendingConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pubKey crypto.PubKey
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &pubKey)
+		pending = append(pending, types.PendingConsPubKeyRotation{
+			OperatorAddr: sdk.ValAddress(iterator.Key()[1:]),
+			ConsPubKey:   pubKey,
+		})
+	}
+	return pending
+}
+
+// set the consensus pubkey known to Tendermint of a rotated validator
+func (k Keeper) SetPendingConsPubKeyRotation(ctx sdk.Context, pending types.PendingConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetPendingConsPubKeyRotationKey(pending.OperatorAddr), k.cdc.MustMarshalBinary(pending.ConsPubKey))
+}
+
+// remove the consensus pubkey rotations of a validator along with the
+// consensus address index of every previous consensus pubkey
+func (k Keeper) removeConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, rotation := range k.GetConsPubKeyRotations(ctx, valAddr) {
+		store.Delete(GetValidatorByConsAddrKey(sdk.GetConsAddress(rotation.OldConsPubKey)))
+		store.Delete(GetConsPubKeyRotationKey(valAddr, rotation.Height, sdk.GetConsAddress(rotation.NewConsPubKey)))
+	}
+	store.Delete(GetPendingConsPubKeyRotationKey(valAddr))
+}
+
+// rotate the consensus pubkey of a validator
+//
+// The validator keeps signing with its previous consensus pubkey until the
+// validator set updates of this block take effect, and evidence against the
+// previous pubkey must still be attributable, so the previous consensus
+// address stays indexed until the validator is removed.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, newPubKey crypto.PubKey) sdk.Error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+	if bytes.Equal(validator.ConsPubKey.Bytes(), newPubKey.Bytes()) {
+		return types.ErrConsPubKeyUnchanged(k.Codespace())
+	}
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists(k.Codespace())
+	}
+
+	// rate limit the rotations within the unbonding time
+	blockTime := ctx.BlockHeader().Time
+	windowStart := blockTime.Add(-k.UnbondingTime(ctx))
+	recentRotations := 0
+	for _, rotation := range k.GetConsPubKeyRotations(ctx, valAddr) {
+		if rotation.Time.After(windowStart) {
+			recentRotations++
+		}
+	}
+	if recentRotations >= int(k.MaxConsPubKeyRotations(ctx)) {
+		return types.ErrMaxConsPubKeyRotations(k.Codespace())
+	}
+
+	// burn the rotation fee from the operator account
+	fee := k.ConsPubKeyRotationFee(ctx)
+	if fee.Sign() > 0 {
+		_, _, err := k.bankKeeper.SubtractCoins(ctx, sdk.AccAddress(valAddr), sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), fee)})
+		if err != nil {
+			return err
+		}
+		pool := k.GetPool(ctx)
+		pool.LooseTokens = pool.LooseTokens.Sub(sdk.NewDecFromInt(fee))
+		k.SetPool(ctx, pool)
+	}
+
+	oldPubKey := validator.ConsPubKey
+	validator.ConsPubKey = newPubKey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetConsPubKeyRotation(ctx, types.NewConsPubKeyRotation(valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), blockTime))
+
+	// a bonded validator must replace the pubkey known to Tendermint at the
+	// end of the block, which is the first rotated pubkey if rotated twice
+	store := ctx.KVStore(k.storeKey)
+	pendingKey := GetPendingConsPubKeyRotationKey(valAddr)
+	if store.Has(GetBondedValidatorIndexKey(valAddr)) && !store.Has(pendingKey) {
+		store.Set(pendingKey, k.cdc.MustMarshalBinary(oldPubKey))
+	}
+
+	k.OnValidatorConsPubKeyRotated(ctx, valAddr, sdk.GetConsAddress(oldPubKey), newConsAddr)
+	return nil
+}
+
+// map of operator addresses to the consensus pubkey known to Tendermint
+type pubKeysByAddr map[[sdk.AddrLen]byte]crypto.PubKey
+
+// retrieve and clear the consensus pubkey rotations which have not yet been
+// reported to Tendermint
+func (k Keeper) dequeuePendingConsPubKeyRotations(ctx sdk.Context) pubKeysByAddr {
+	pending := make(pubKeysByAddr)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var operator [sdk.AddrLen]byte
+		copy(operator[:], iterator.Key()[1:])
+		var pubKey crypto.PubKey
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &pubKey)
+		pending[operator] = pubKey
+		store.Delete(iterator.Key())
+	}
+	return pending
+}
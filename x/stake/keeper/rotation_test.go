@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	ctx, am, keeper := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+	pool := keeper.GetPool(ctx)
+
+	// create a bonded validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, _ = validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	// cannot rotate to the same pubkey or to a pubkey in use
+	err := keeper.RotateConsPubKey(ctx, addrVals[0], PKs[0])
+	require.NotNil(t, err)
+	other := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+	keeper.SetValidator(ctx, other)
+	keeper.SetValidatorByConsAddr(ctx, other)
+	err = keeper.RotateConsPubKey(ctx, addrVals[0], PKs[1])
+	require.NotNil(t, err)
+
+	// cannot rotate a non-existent validator
+	err = keeper.RotateConsPubKey(ctx, addrVals[2], PKs[2])
+	require.NotNil(t, err)
+
+	// rotate the pubkey
+	looseTokens := keeper.GetPool(ctx).LooseTokens
+	err = keeper.RotateConsPubKey(ctx, addrVals[0], PKs[2])
+	require.Nil(t, err)
+
+	// both pubkeys resolve to the validator
+	validator, found := keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(PKs[0].Address()))
+	require.True(t, found)
+	require.Equal(t, PKs[2], validator.ConsPubKey)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(PKs[2].Address()))
+	require.True(t, found)
+	require.Equal(t, addrVals[0], validator.OperatorAddr)
+
+	rotations := keeper.GetConsPubKeyRotations(ctx, addrVals[0])
+	require.Equal(t, 1, len(rotations))
+	require.Equal(t, PKs[0], rotations[0].OldConsPubKey)
+	require.Equal(t, PKs[2], rotations[0].NewConsPubKey)
+
+	// the fee is burned
+	fee := keeper.ConsPubKeyRotationFee(ctx)
+	coins := am.GetAccount(ctx, sdk.AccAddress(addrVals[0])).GetCoins()
+	require.Equal(t, sdk.NewInt(1000).Sub(fee), coins.AmountOf(keeper.BondDenom(ctx)))
+	require.True(sdk.DecEq(t, looseTokens.Sub(sdk.NewDecFromInt(fee)), keeper.GetPool(ctx).LooseTokens))
+
+	// tendermint removes the previous pubkey and adds the new one
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+	require.Equal(t, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(PKs[0]), Power: 0}, updates[0])
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+
+	// only one rotation is allowed within the unbonding time
+	err = keeper.RotateConsPubKey(ctx, addrVals[0], PKs[3])
+	require.NotNil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0).Add(keeper.UnbondingTime(ctx))})
+	err = keeper.RotateConsPubKey(ctx, addrVals[0], PKs[3])
+	require.Nil(t, err)
+	require.Equal(t, 2, len(keeper.GetConsPubKeyRotations(ctx, addrVals[0])))
+}
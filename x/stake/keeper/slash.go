@@ -98,6 +98,13 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// cannot decrease balance below zero
 	tokensToBurn := sdk.MinDec(remainingSlashAmount, validator.Tokens)
 
+	// notify the hooks of the fraction of the current tokens being slashed,
+	// rounded up so that the remaining tokens are never overestimated
+	if tokensToBurn.GT(sdk.ZeroDec()) {
+		remainingFraction := validator.Tokens.Sub(tokensToBurn).QuoTruncate(validator.Tokens)
+		k.OnValidatorSlashed(ctx, operatorAddress, sdk.OneDec().Sub(remainingFraction))
+	}
+
 	// burn validator's tokens and update the validator
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 	pool := k.GetPool(ctx)
@@ -145,43 +152,50 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 // (the amount actually slashed may be less if there's
 // insufficient stake remaining)
 func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
-	infractionHeight int64, slashFactor sdk.Dec) (slashAmount sdk.Dec) {
+	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount sdk.Dec) {
 
 	now := ctx.BlockHeader().Time
+	totalSlashAmount = sdk.ZeroDec()
 
-	// If unbonding started before this height, stake didn't contribute to infraction
-	if unbondingDelegation.CreationHeight < infractionHeight {
-		return sdk.ZeroDec()
-	}
+	// perform slashing on all entries within the unbonding delegation
+	for i, entry := range unbondingDelegation.Entries {
 
-	if unbondingDelegation.MinTime.Before(now) {
-		// Unbonding delegation no longer eligible for slashing, skip it
-		// TODO Settle and delete it automatically?
-		return sdk.ZeroDec()
-	}
+		// If unbonding started before this height, stake didn't contribute to infraction
+		if entry.CreationHeight < infractionHeight {
+			continue
+		}
 
-	// Calculate slash amount proportional to stake contributing to infraction
-	slashAmount = slashFactor.MulInt(unbondingDelegation.InitialBalance.Amount)
+		if entry.CompletionTime.Before(now) {
+			// Unbonding delegation no longer eligible for slashing, skip it
+			continue
+		}
+
+		// Calculate slash amount proportional to stake contributing to infraction
+		slashAmount := slashFactor.MulInt(entry.InitialBalance.Amount)
+		totalSlashAmount = totalSlashAmount.Add(slashAmount)
 
-	// Don't slash more tokens than held
-	// Possible since the unbonding delegation may already
-	// have been slashed, and slash amounts are calculated
-	// according to stake held at time of infraction
-	unbondingSlashAmount := sdk.MinInt(slashAmount.RoundInt(), unbondingDelegation.Balance.Amount)
+		// Don't slash more tokens than held
+		// Possible since the unbonding delegation may already
+		// have been slashed, and slash amounts are calculated
+		// according to stake held at time of infraction
+		unbondingSlashAmount := sdk.MinInt(slashAmount.RoundInt(), entry.Balance.Amount)
 
-	// Update unbonding delegation if necessary
-	if !unbondingSlashAmount.IsZero() {
-		unbondingDelegation.Balance.Amount = unbondingDelegation.Balance.Amount.Sub(unbondingSlashAmount)
+		// Update unbonding delegation if necessary
+		if unbondingSlashAmount.IsZero() {
+			continue
+		}
+		entry.Balance.Amount = entry.Balance.Amount.Sub(unbondingSlashAmount)
+		unbondingDelegation.Entries[i] = entry
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
 		pool := k.GetPool(ctx)
 
 		// Burn loose tokens
 		// Ref https://github.com/cosmos/cosmos-sdk/pull/1278#discussion_r198657760
-		pool.LooseTokens = pool.LooseTokens.Sub(slashAmount)
+		pool.LooseTokens = pool.LooseTokens.Sub(sdk.NewDecFromInt(unbondingSlashAmount))
 		k.SetPool(ctx, pool)
 	}
 
-	return
+	return totalSlashAmount
 }
 
 // slash a redelegation and update the pool
@@ -191,43 +205,50 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 // insufficient stake remaining)
 // nolint: unparam
 func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, redelegation types.Redelegation,
-	infractionHeight int64, slashFactor sdk.Dec) (slashAmount sdk.Dec) {
+	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount sdk.Dec) {
 
 	now := ctx.BlockHeader().Time
+	totalSlashAmount = sdk.ZeroDec()
 
-	// If redelegation started before this height, stake didn't contribute to infraction
-	if redelegation.CreationHeight < infractionHeight {
-		return sdk.ZeroDec()
-	}
-
-	if redelegation.MinTime.Before(now) {
-		// Redelegation no longer eligible for slashing, skip it
-		// TODO Delete it automatically?
-		return sdk.ZeroDec()
-	}
+	// perform slashing on all entries within the redelegation
+	for i, entry := range redelegation.Entries {
 
-	// Calculate slash amount proportional to stake contributing to infraction
-	slashAmount = slashFactor.MulInt(redelegation.InitialBalance.Amount)
+		// If redelegation started before this height, stake didn't contribute to infraction
+		if entry.CreationHeight < infractionHeight {
+			continue
+		}
 
-	// Don't slash more tokens than held
-	// Possible since the redelegation may already
-	// have been slashed, and slash amounts are calculated
-	// according to stake held at time of infraction
-	redelegationSlashAmount := sdk.MinInt(slashAmount.RoundInt(), redelegation.Balance.Amount)
+		if entry.CompletionTime.Before(now) {
+			// Redelegation no longer eligible for slashing, skip it
+			continue
+		}
 
-	// Update redelegation if necessary
-	if !redelegationSlashAmount.IsZero() {
-		redelegation.Balance.Amount = redelegation.Balance.Amount.Sub(redelegationSlashAmount)
-		k.SetRedelegation(ctx, redelegation)
-	}
+		// Calculate slash amount proportional to stake contributing to infraction
+		slashAmount := slashFactor.MulInt(entry.InitialBalance.Amount)
+		totalSlashAmount = totalSlashAmount.Add(slashAmount)
+
+		// Don't slash more tokens than held
+		// Possible since the redelegation may already
+		// have been slashed, and slash amounts are calculated
+		// according to stake held at time of infraction
+		redelegationSlashAmount := sdk.MinInt(slashAmount.RoundInt(), entry.Balance.Amount)
+
+		// Update redelegation if necessary
+		if !redelegationSlashAmount.IsZero() {
+			entry.Balance.Amount = entry.Balance.Amount.Sub(redelegationSlashAmount)
+			redelegation.Entries[i] = entry
+			k.SetRedelegation(ctx, redelegation)
+		}
 
-	// Unbond from target validator
-	sharesToUnbond := slashFactor.Mul(redelegation.SharesDst)
-	if !sharesToUnbond.IsZero() {
+		// Unbond from target validator
+		sharesToUnbond := slashFactor.Mul(entry.SharesDst)
+		if sharesToUnbond.IsZero() {
+			continue
+		}
 		delegation, found := k.GetDelegation(ctx, redelegation.DelegatorAddr, redelegation.ValidatorDstAddr)
 		if !found {
 			// If deleted, delegation has zero shares, and we can't unbond any more
-			return slashAmount
+			continue
 		}
 		if sharesToUnbond.GT(delegation.Shares) {
 			sharesToUnbond = delegation.Shares
@@ -243,5 +264,5 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		k.SetPool(ctx, pool)
 	}
 
-	return slashAmount
+	return totalSlashAmount
 }
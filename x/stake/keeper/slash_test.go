@@ -70,16 +70,10 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
 	fraction := sdk.NewDecWithPrec(5, 1)
 
-	// set an unbonding delegation
-	ubd := types.UnbondingDelegation{
-		DelegatorAddr:  addrDels[0],
-		ValidatorAddr:  addrVals[0],
-		CreationHeight: 0,
-		// expiration timestamp (beyond which the unbonding delegation shouldn't be slashed)
-		MinTime:        time.Unix(0, 0),
-		InitialBalance: sdk.NewInt64Coin(params.BondDenom, 10),
-		Balance:        sdk.NewInt64Coin(params.BondDenom, 10),
-	}
+	// set an unbonding delegation with expiration timestamp (beyond which the
+	// unbonding delegation shouldn't be slashed)
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0,
+		time.Unix(0, 0), sdk.NewInt64Coin(params.BondDenom, 10))
 	keeper.SetUnbondingDelegation(ctx, ubd)
 
 	// unbonding started prior to the infraction height, stake didn't contribute
@@ -102,10 +96,10 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 	require.True(t, found)
 
 	// initialbalance unchanged
-	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 10), ubd.InitialBalance)
+	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 10), ubd.Entries[0].InitialBalance)
 
 	// balance decreased
-	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 5), ubd.Balance)
+	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 5), ubd.Entries[0].Balance)
 	newPool := keeper.GetPool(ctx)
 	require.Equal(t, int64(5), oldPool.LooseTokens.Sub(newPool.LooseTokens).RoundInt64())
 }
@@ -115,19 +109,11 @@ func TestSlashRedelegation(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
 	fraction := sdk.NewDecWithPrec(5, 1)
 
-	// set a redelegation
-	rd := types.Redelegation{
-		DelegatorAddr:    addrDels[0],
-		ValidatorSrcAddr: addrVals[0],
-		ValidatorDstAddr: addrVals[1],
-		CreationHeight:   0,
-		// expiration timestamp (beyond which the redelegation shouldn't be slashed)
-		MinTime:        time.Unix(0, 0),
-		SharesSrc:      sdk.NewDec(10),
-		SharesDst:      sdk.NewDec(10),
-		InitialBalance: sdk.NewInt64Coin(params.BondDenom, 10),
-		Balance:        sdk.NewInt64Coin(params.BondDenom, 10),
-	}
+	// set a redelegation with an expiration timestamp beyond which the
+	// redelegation shouldn't be slashed
+	rd := types.NewRedelegation(addrDels[0], addrVals[0],
+		addrVals[1], 0, time.Unix(0, 0),
+		sdk.NewInt64Coin(params.BondDenom, 10), sdk.NewDec(10), sdk.NewDec(10))
 	keeper.SetRedelegation(ctx, rd)
 
 	// set the associated delegation
@@ -168,10 +154,10 @@ func TestSlashRedelegation(t *testing.T) {
 	require.Equal(t, 1, len(updates))
 
 	// initialbalance unchanged
-	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 10), rd.InitialBalance)
+	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 10), rd.Entries[0].InitialBalance)
 
 	// balance decreased
-	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 5), rd.Balance)
+	require.Equal(t, sdk.NewInt64Coin(params.BondDenom, 5), rd.Entries[0].Balance)
 
 	// shares decreased
 	del, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[1])
@@ -252,16 +238,10 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	consAddr := sdk.ConsAddress(PKs[0].Address())
 	fraction := sdk.NewDecWithPrec(5, 1)
 
-	// set an unbonding delegation
-	ubd := types.UnbondingDelegation{
-		DelegatorAddr:  addrDels[0],
-		ValidatorAddr:  addrVals[0],
-		CreationHeight: 11,
-		// expiration timestamp (beyond which the unbonding delegation shouldn't be slashed)
-		MinTime:        time.Unix(0, 0),
-		InitialBalance: sdk.NewInt64Coin(params.BondDenom, 4),
-		Balance:        sdk.NewInt64Coin(params.BondDenom, 4),
-	}
+	// set an unbonding delegation with expiration timestamp (beyond which the
+	// unbonding delegation shouldn't be slashed)
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11,
+		time.Unix(0, 0), sdk.NewInt64Coin(params.BondDenom, 4))
 	keeper.SetUnbondingDelegation(ctx, ubd)
 
 	// slash validator for the first time
@@ -279,7 +259,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance decreased
-	require.Equal(t, sdk.NewInt(2), ubd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(2), ubd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool := keeper.GetPool(ctx)
 	// bonded tokens burned
@@ -299,7 +279,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance decreased again
-	require.Equal(t, sdk.NewInt(0), ubd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(0), ubd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool = keeper.GetPool(ctx)
 	// bonded tokens burned again
@@ -319,7 +299,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance unchanged
-	require.Equal(t, sdk.NewInt(0), ubd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(0), ubd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool = keeper.GetPool(ctx)
 	// bonded tokens burned again
@@ -339,7 +319,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance unchanged
-	require.Equal(t, sdk.NewInt(0), ubd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(0), ubd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool = keeper.GetPool(ctx)
 	// just 1 bonded token burned again since that's all the validator now has
@@ -359,18 +339,11 @@ func TestSlashWithRedelegation(t *testing.T) {
 	consAddr := sdk.ConsAddress(PKs[0].Address())
 	fraction := sdk.NewDecWithPrec(5, 1)
 
-	// set a redelegation
-	rd := types.Redelegation{
-		DelegatorAddr:    addrDels[0],
-		ValidatorSrcAddr: addrVals[0],
-		ValidatorDstAddr: addrVals[1],
-		CreationHeight:   11,
-		MinTime:          time.Unix(0, 0),
-		SharesSrc:        sdk.NewDec(6),
-		SharesDst:        sdk.NewDec(6),
-		InitialBalance:   sdk.NewInt64Coin(params.BondDenom, 6),
-		Balance:          sdk.NewInt64Coin(params.BondDenom, 6),
-	}
+	// set a redelegation with an expiration timestamp beyond which the
+	// redelegation shouldn't be slashed
+	rd := types.NewRedelegation(addrDels[0], addrVals[0],
+		addrVals[1], 11, time.Unix(0, 0),
+		sdk.NewInt64Coin(params.BondDenom, 6), sdk.NewDec(6), sdk.NewDec(6))
 	keeper.SetRedelegation(ctx, rd)
 
 	// set the associated delegation
@@ -397,7 +370,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found)
 	// balance decreased
-	require.Equal(t, sdk.NewInt(3), rd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(3), rd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool := keeper.GetPool(ctx)
 	// bonded tokens burned
@@ -421,7 +394,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found)
 	// balance decreased, now zero
-	require.Equal(t, sdk.NewInt(0), rd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(0), rd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool = keeper.GetPool(ctx)
 	// seven bonded tokens burned
@@ -442,7 +415,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found)
 	// balance still zero
-	require.Equal(t, sdk.NewInt(0), rd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(0), rd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool = keeper.GetPool(ctx)
 	// four more bonded tokens burned
@@ -466,7 +439,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found)
 	// balance still zero
-	require.Equal(t, sdk.NewInt(0), rd.Balance.Amount)
+	require.Equal(t, sdk.NewInt(0), rd.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool = keeper.GetPool(ctx)
 	// no more bonded tokens burned
@@ -482,19 +455,11 @@ func TestSlashBoth(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
 	fraction := sdk.NewDecWithPrec(5, 1)
 
-	// set a redelegation
-	rdA := types.Redelegation{
-		DelegatorAddr:    addrDels[0],
-		ValidatorSrcAddr: addrVals[0],
-		ValidatorDstAddr: addrVals[1],
-		CreationHeight:   11,
-		// expiration timestamp (beyond which the redelegation shouldn't be slashed)
-		MinTime:        time.Unix(0, 0),
-		SharesSrc:      sdk.NewDec(6),
-		SharesDst:      sdk.NewDec(6),
-		InitialBalance: sdk.NewInt64Coin(params.BondDenom, 6),
-		Balance:        sdk.NewInt64Coin(params.BondDenom, 6),
-	}
+	// set a redelegation with an expiration timestamp beyond which the
+	// redelegation shouldn't be slashed
+	rdA := types.NewRedelegation(addrDels[0], addrVals[0],
+		addrVals[1], 11, time.Unix(0, 0),
+		sdk.NewInt64Coin(params.BondDenom, 6), sdk.NewDec(6), sdk.NewDec(6))
 	keeper.SetRedelegation(ctx, rdA)
 
 	// set the associated delegation
@@ -505,16 +470,10 @@ func TestSlashBoth(t *testing.T) {
 	}
 	keeper.SetDelegation(ctx, delA)
 
-	// set an unbonding delegation
-	ubdA := types.UnbondingDelegation{
-		DelegatorAddr:  addrDels[0],
-		ValidatorAddr:  addrVals[0],
-		CreationHeight: 11,
-		// expiration timestamp (beyond which the unbonding delegation shouldn't be slashed)
-		MinTime:        time.Unix(0, 0),
-		InitialBalance: sdk.NewInt64Coin(params.BondDenom, 4),
-		Balance:        sdk.NewInt64Coin(params.BondDenom, 4),
-	}
+	// set an unbonding delegation with expiration timestamp (beyond which the
+	// unbonding delegation shouldn't be slashed)
+	ubdA := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11,
+		time.Unix(0, 0), sdk.NewInt64Coin(params.BondDenom, 4))
 	keeper.SetUnbondingDelegation(ctx, ubdA)
 
 	// slash validator
@@ -529,7 +488,7 @@ func TestSlashBoth(t *testing.T) {
 	rdA, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found)
 	// balance decreased
-	require.Equal(t, sdk.NewInt(3), rdA.Balance.Amount)
+	require.Equal(t, sdk.NewInt(3), rdA.Entries[0].Balance.Amount)
 	// read updated pool
 	newPool := keeper.GetPool(ctx)
 	// loose tokens burned
@@ -62,8 +62,11 @@ func MakeTestCodec() *codec.Codec {
 	cdc.RegisterConcrete(bank.MsgIssue{}, "test/stake/Issue", nil)
 	cdc.RegisterConcrete(types.MsgCreateValidator{}, "test/stake/CreateValidator", nil)
 	cdc.RegisterConcrete(types.MsgEditValidator{}, "test/stake/EditValidator", nil)
+	cdc.RegisterConcrete(types.MsgRotateConsPubKey{}, "test/stake/RotateConsPubKey", nil)
 	cdc.RegisterConcrete(types.MsgBeginUnbonding{}, "test/stake/BeginUnbonding", nil)
 	cdc.RegisterConcrete(types.MsgBeginRedelegate{}, "test/stake/BeginRedelegate", nil)
+	cdc.RegisterConcrete(types.MsgTransferDelegation{}, "test/stake/TransferDelegation", nil)
+	cdc.RegisterConcrete(types.MsgCancelUnbondingDelegation{}, "test/stake/CancelUnbondingDelegation", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
@@ -76,12 +79,16 @@ func MakeTestCodec() *codec.Codec {
 // default params without inflation
 func ParamsNoInflation() types.Params {
 	return types.Params{
-		InflationRateChange: sdk.ZeroDec(),
-		InflationMax:        sdk.ZeroDec(),
-		InflationMin:        sdk.ZeroDec(),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		MaxValidators:       100,
-		BondDenom:           "steak",
+		InflationRateChange:    sdk.ZeroDec(),
+		InflationMax:           sdk.ZeroDec(),
+		InflationMin:           sdk.ZeroDec(),
+		GoalBonded:             sdk.NewDecWithPrec(67, 2),
+		MaxValidators:          100,
+		MaxEntries:             7,
+		MaxVotingPowerFraction: sdk.OneDec(),
+		MaxConsPubKeyRotations: 1,
+		ConsPubKeyRotationFee:  sdk.NewInt(10),
+		BondDenom:              "steak",
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
@@ -13,6 +14,10 @@ import (
 
 // Apply and return accumulated updates to the bonded validator set
 //
+// The power reported to Tendermint for each validator is capped at the
+// MaxVotingPowerFraction of the total capped power of the new bonded validator
+// set.
+//
 // CONTRACT: Only validators with non-zero power or zero-power that were bonded
 // at the previous block height or were removed from the validator set entirely
 // are returned to Tendermint.
@@ -24,10 +29,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// retrieve last validator set
 	last := k.retrieveLastValidatorSet(ctx)
 
+	// retrieve the consensus pubkeys known to Tendermint of rotated validators
+	rotated := k.dequeuePendingConsPubKeyRotations(ctx)
+
 	// iterate over validators, highest power to lowest
 	iterator := sdk.KVStoreReversePrefixIterator(store, ValidatorsByPowerIndexKey)
-	count := 0
-	for ; iterator.Valid() && count < int(maxValidators); iterator.Next() {
+	bonded := make([]types.Validator, 0, maxValidators)
+	powers := make([]int64, 0, maxValidators)
+	for ; iterator.Valid() && len(bonded) < int(maxValidators); iterator.Next() {
 
 		// fetch the validator
 		operator := sdk.ValAddress(iterator.Value())
@@ -56,17 +65,33 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			panic("unexpected validator status")
 		}
 
+		bonded = append(bonded, validator)
+		powers = append(powers, validator.BondedTokens().RoundInt64())
+	}
+	iterator.Close()
+
+	// the power of a single validator reported to Tendermint is capped
+	maxPower := maxValidatorPower(k.MaxVotingPowerFraction(ctx), powers)
+
+	for _, validator := range bonded {
+		operator := validator.OperatorAddr
+
 		// fetch the old power bytes
 		var operatorBytes [sdk.AddrLen]byte
 		copy(operatorBytes[:], operator[:])
 		oldPowerBytes, found := last[operatorBytes]
 
 		// calculate the new power bytes
-		newPowerBytes := validator.ABCIValidatorPowerBytes(k.cdc)
-
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate())
+		newPowerBytes := validator.ABCIValidatorPowerBytes(k.cdc, maxPower)
+
+		// replace a rotated consensus pubkey, otherwise update the validator
+		// set if power has changed
+		oldPubKey, rotatedPubKey := rotated[operatorBytes]
+		if found && rotatedPubKey {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+			updates = append(updates, validator.ABCIValidatorUpdateWithPower(validator.EffectivePower(maxPower)))
+		} else if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+			updates = append(updates, validator.ABCIValidatorUpdateWithPower(validator.EffectivePower(maxPower)))
 		}
 
 		// validator still in the validator set, so delete from the copy
@@ -75,9 +100,8 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		// set the bonded validator index
 		store.Set(GetBondedValidatorIndexKey(operator), newPowerBytes)
 
-		// keep count
-		count++
-
+		// record the uncapped power to slash the validator on
+		k.updateValidatorPowerHistory(ctx, operator, validator.BondedTokens().RoundInt64())
 	}
 
 	// sort the no-longer-bonded validators
@@ -91,6 +115,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 
 		// bonded to unbonding
 		k.bondedToUnbonding(ctx, validator)
+		k.updateValidatorPowerHistory(ctx, validator.OperatorAddr, 0)
 
 		// remove validator if it has no more tokens
 		if validator.Tokens.IsZero() {
@@ -100,14 +125,64 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		// delete from the bonded validator index
 		store.Delete(GetBondedValidatorIndexKey(operator))
 
-		// update the validator set
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+		// update the validator set, Tendermint only knows the consensus
+		// pubkey from before a rotation in this block
+		var operatorBytes [sdk.AddrLen]byte
+		copy(operatorBytes[:], operator[:])
+		if oldPubKey, rotatedPubKey := rotated[operatorBytes]; rotatedPubKey {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 
 	}
 
 	return updates
 }
 
+// maximum power reported to Tendermint for a single validator given the powers
+// of the bonded validator set, such that no validator reports more than the
+// fraction of the total capped power. The largest validators are capped one at
+// a time: with k validators capped at c and the rest summing to r, the cap
+// must satisfy c <= fraction * (k*c + r). If there are too few validators for
+// any cap to satisfy the fraction they are all capped at the smallest power.
+// The cap never drops a bonded validator to zero power.
+func maxValidatorPower(fraction sdk.Dec, powers []int64) int64 {
+	sorted := make([]int64, len(powers))
+	copy(sorted, powers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	rest := int64(0)
+	for _, power := range sorted {
+		rest += power
+	}
+
+	maxPower := int64(0)
+	for capped, power := range sorted {
+		if fraction.MulInt(sdk.NewInt(int64(capped))).GTE(sdk.OneDec()) {
+			// any cap satisfies the fraction for the capped validators, so
+			// cap them at the power of the smallest of them
+			maxPower = sorted[capped-1]
+			break
+		}
+		limit := fraction.MulInt(sdk.NewInt(rest)).
+			Quo(sdk.OneDec().Sub(fraction.MulInt(sdk.NewInt(int64(capped))))).
+			TruncateInt64()
+		if power <= limit {
+			maxPower = limit
+			break
+		}
+		// the smallest validator always ends up capped at its own power
+		maxPower = power
+		rest -= power
+	}
+
+	if maxPower < 1 {
+		return 1
+	}
+	return maxPower
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) types.Validator {
@@ -196,7 +196,10 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(GetValidatorKey(address))
 	store.Delete(GetValidatorByConsAddrKey(sdk.ConsAddress(validator.ConsPubKey.Address())))
 	store.Delete(GetValidatorsByPowerIndexKey(validator, pool))
+	k.removeConsPubKeyRotations(ctx, address)
+	k.removeValidatorPowerHistory(ctx, address)
 
+	k.OnValidatorRemoved(ctx, address)
 }
 
 //___________________________________________________________________________
@@ -234,31 +237,35 @@ func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint16) (validators [
 	return validators[:i] // trim if the array length < maxRetrieve
 }
 
+// GetValidatorsPaginated returns a page of the validators, ordered by
+// operator address.
+func (k Keeper) GetValidatorsPaginated(ctx sdk.Context, page sdk.PageRequest) (validators []types.Validator, res sdk.PageResponse, err error) {
+	store := ctx.KVStore(k.storeKey)
+	validators = []types.Validator{}
+	res, err = sdk.Paginate(store, ValidatorsKey, page, func(key, value []byte) error {
+		validators = append(validators, types.MustUnmarshalValidator(k.cdc, key[1:], value))
+		return nil
+	})
+	return validators, res, err
+}
+
 // get the group of the bonded validators
+//
+// NOTE: after a decrease of MaxValidators the bonded validator set may hold
+// more than MaxValidators validators until the end of the block
 func (k Keeper) GetValidatorsBonded(ctx sdk.Context) (validators []types.Validator) {
 	store := ctx.KVStore(k.storeKey)
-
-	// add the actual validator power sorted store
-	maxValidators := k.MaxValidators(ctx)
-	validators = make([]types.Validator, maxValidators)
+	validators = make([]types.Validator, 0, k.MaxValidators(ctx))
 
 	iterator := sdk.KVStorePrefixIterator(store, ValidatorsBondedIndexKey)
 	defer iterator.Close()
 
-	i := 0
 	for ; iterator.Valid(); iterator.Next() {
-
-		// sanity check
-		if i > int(maxValidators-1) {
-			panic("maxValidators is less than the number of records in ValidatorsBonded Store, store should have been updated")
-		}
 		address := GetAddressFromValBondedIndexKey(iterator.Key())
 		validator := k.mustGetValidator(ctx, address)
-
-		validators[i] = validator
-		i++
+		validators = append(validators, validator)
 	}
-	return validators[:i] // trim
+	return validators
 }
 
 // get the group of bonded validators sorted by power-rank
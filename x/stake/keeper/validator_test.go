@@ -1063,3 +1063,144 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyAndReturnValidatorSetUpdatesMaxValidatorsChange(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+
+	amts := []int64{10, 20, 30, 40, 50}
+	var validators [5]types.Validator
+	for i, amt := range amts {
+		pool := keeper.GetPool(ctx)
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, sdk.NewInt(amt))
+		keeper.SetPool(ctx, pool)
+		keeper.SetValidator(ctx, validators[i])
+		keeper.SetValidatorByPowerIndex(ctx, validators[i], pool)
+	}
+	require.Equal(t, 5, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+
+	// decrease the maximum number of validators
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 3
+	keeper.SetParams(ctx, params)
+
+	// the bonded set is only updated at the end of the block
+	require.Equal(t, 5, len(keeper.GetValidatorsBonded(ctx)))
+
+	// the two lowest power validators are removed from the set
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+	for i := 0; i < 2; i++ {
+		validator, found := keeper.GetValidator(ctx, validators[i].OperatorAddr)
+		require.True(t, found)
+		require.Equal(t, sdk.Unbonding, validator.Status)
+		require.Contains(t, updates, validator.ABCIValidatorUpdateZero())
+	}
+	require.Equal(t, 3, len(keeper.GetValidatorsBonded(ctx)))
+
+	// increase the maximum number of validators
+	params.MaxValidators = 4
+	keeper.SetParams(ctx, params)
+
+	// the highest power validator outside of the set is bonded again
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	validator, found := keeper.GetValidator(ctx, validators[1].OperatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.Bonded, validator.Status)
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, 4, len(keeper.GetValidatorsBonded(ctx)))
+}
+
+func TestApplyAndReturnValidatorSetUpdatesMaxVotingPowerFraction(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerFraction = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	amts := []int64{10, 20, 70}
+	var validators [3]types.Validator
+	for i, amt := range amts {
+		pool := keeper.GetPool(ctx)
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, sdk.NewInt(amt))
+		keeper.SetPool(ctx, pool)
+		keeper.SetValidator(ctx, validators[i])
+		keeper.SetValidatorByPowerIndex(ctx, validators[i], pool)
+	}
+
+	// the power of the largest validator is capped at half of the total
+	// capped power, 30 out of 30 + 20 + 10
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 3, len(updates))
+	for i := range validators {
+		validators[i], _ = keeper.GetValidator(ctx, validators[i].OperatorAddr)
+	}
+	require.Equal(t, validators[2].ABCIValidatorUpdateWithPower(30), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[2])
+
+	// the actual bonded tokens of the validator are unaffected
+	require.True(sdk.DecEq(t, sdk.NewDec(70), validators[2].GetPower()))
+
+	// no further updates while the capped power is unchanged
+	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+
+	// removing the cap reports the full power
+	params.MaxVotingPowerFraction = sdk.OneDec()
+	keeper.SetParams(ctx, params)
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
+}
+
+func TestApplyAndReturnValidatorSetUpdatesMaxVotingPowerFractionOfCappedTotal(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+
+	fraction := sdk.NewDecWithPrec(3, 1)
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerFraction = fraction
+	keeper.SetParams(ctx, params)
+
+	amts := []int64{50, 30, 10, 5, 5}
+	for i, amt := range amts {
+		pool := keeper.GetPool(ctx)
+		validator := types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		validator, pool, _ = validator.AddTokensFromDel(pool, sdk.NewInt(amt))
+		keeper.SetPool(ctx, pool)
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator, pool)
+	}
+
+	// the two largest validators are capped at 15 out of 15 + 15 + 10 + 5 + 5
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, len(amts), len(updates))
+	totalPower := int64(0)
+	for _, update := range updates {
+		totalPower += update.Power
+	}
+	require.Equal(t, int64(50), totalPower)
+	for _, update := range updates {
+		require.True(t, sdk.NewDec(update.Power).LTE(fraction.MulInt(sdk.NewInt(totalPower))),
+			"power %d exceeds %v of the total power %d", update.Power, fraction, totalPower)
+	}
+}
+
+func TestMaxValidatorPower(t *testing.T) {
+	tests := []struct {
+		fraction sdk.Dec
+		powers   []int64
+		expected int64
+	}{
+		{sdk.OneDec(), []int64{10, 20, 70}, 100},
+		{sdk.NewDecWithPrec(5, 1), []int64{10, 20, 70}, 30},
+		{sdk.NewDecWithPrec(3, 1), []int64{50, 30, 10, 5, 5}, 15},
+		{sdk.NewDecWithPrec(5, 1), []int64{20, 10}, 10},     // too few validators, all capped equally
+		{sdk.NewDecWithPrec(1, 1), []int64{30, 20, 10}, 10}, // too few validators, all capped equally
+		{sdk.NewDecWithPrec(5, 1), []int64{}, 1},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.expected, maxValidatorPower(tc.fraction, tc.powers), "test case %d", i)
+	}
+}
@@ -21,6 +21,10 @@ const (
 	QueryDelegatorValidator  = "delegatorValidator"
 	QueryPool                = "pool"
 	QueryParameters          = "parameters"
+
+	QueryDelegatorDelegations          = "delegatorDelegations"
+	QueryDelegatorUnbondingDelegations = "delegatorUnbondingDelegations"
+	QueryDelegatorRedelegations        = "delegatorRedelegations"
 )
 
 // creates a querier for staking REST endpoints
@@ -28,7 +32,7 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case QueryValidators:
-			return queryValidators(ctx, cdc, k)
+			return queryValidators(ctx, cdc, req, k)
 		case QueryValidator:
 			return queryValidator(ctx, cdc, req, k)
 		case QueryDelegator:
@@ -45,12 +49,31 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 			return queryPool(ctx, cdc, k)
 		case QueryParameters:
 			return queryParameters(ctx, cdc, k)
+		case QueryDelegatorDelegations:
+			return queryDelegatorDelegations(ctx, cdc, req, k)
+		case QueryDelegatorUnbondingDelegations:
+			return queryDelegatorUnbondingDelegations(ctx, cdc, req, k)
+		case QueryDelegatorRedelegations:
+			return queryDelegatorRedelegations(ctx, cdc, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown stake query endpoint")
 		}
 	}
 }
 
+// defines the params for the following queries:
+// - 'custom/stake/validators'
+type QueryValidatorsParams struct {
+	Pagination sdk.PageRequest
+}
+
+// defines the response of the following queries:
+// - 'custom/stake/validators'
+type QueryValidatorsResponse struct {
+	Validators []types.Validator `json:"validators"`
+	Pagination sdk.PageResponse  `json:"pagination"`
+}
+
 // defines the params for the following queries:
 // - 'custom/stake/delegator'
 // - 'custom/stake/delegatorValidators'
@@ -58,6 +81,36 @@ type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
 }
 
+// defines the params for the following queries:
+// - 'custom/stake/delegatorDelegations'
+// - 'custom/stake/delegatorUnbondingDelegations'
+// - 'custom/stake/delegatorRedelegations'
+type QueryDelegatorPageParams struct {
+	DelegatorAddr sdk.AccAddress
+	Pagination    sdk.PageRequest
+}
+
+// defines the response of the following queries:
+// - 'custom/stake/delegatorDelegations'
+type QueryDelegationsResponse struct {
+	Delegations []types.Delegation `json:"delegations"`
+	Pagination  sdk.PageResponse   `json:"pagination"`
+}
+
+// defines the response of the following queries:
+// - 'custom/stake/delegatorUnbondingDelegations'
+type QueryUnbondingDelegationsResponse struct {
+	UnbondingDelegations []types.UnbondingDelegation `json:"unbonding_delegations"`
+	Pagination           sdk.PageResponse            `json:"pagination"`
+}
+
+// defines the response of the following queries:
+// - 'custom/stake/delegatorRedelegations'
+type QueryRedelegationsResponse struct {
+	Redelegations []types.Redelegation `json:"redelegations"`
+	Pagination    sdk.PageResponse     `json:"pagination"`
+}
+
 // defines the params for the following queries:
 // - 'custom/stake/validator'
 type QueryValidatorParams struct {
@@ -73,12 +126,22 @@ type QueryBondsParams struct {
 	ValidatorAddr sdk.ValAddress
 }
 
-func queryValidators(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
-	stakeParams := k.GetParams(ctx)
-	validators := k.GetValidators(ctx, stakeParams.MaxValidators)
+func queryValidators(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryValidatorsParams
+	if len(req.Data) > 0 {
+		errRes := cdc.UnmarshalJSON(req.Data, &params)
+		if errRes != nil {
+			return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+		}
+	}
 
-	res, errRes := codec.MarshalJSONIndent(cdc, validators)
-	if err != nil {
+	validators, page, errRes := k.GetValidatorsPaginated(ctx, params.Pagination)
+	if errRes != nil {
+		return []byte{}, sdk.ErrUnknownRequest(errRes.Error())
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, QueryValidatorsResponse{Validators: validators, Pagination: page})
+	if errRes != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", errRes.Error()))
 	}
 	return res, nil
@@ -206,6 +269,68 @@ func queryUnbondingDelegation(ctx sdk.Context, cdc *codec.Codec, req abci.Reques
 	return res, nil
 }
 
+func readDelegatorPageParams(cdc *codec.Codec, req abci.RequestQuery) (params QueryDelegatorPageParams, err sdk.Error) {
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return params, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data: %s", errRes.Error()))
+	}
+	return params, nil
+}
+
+func queryDelegatorDelegations(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	params, err := readDelegatorPageParams(cdc, req)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, page, errRes := k.GetDelegatorDelegationsPaginated(ctx, params.DelegatorAddr, params.Pagination)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(errRes.Error())
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, QueryDelegationsResponse{Delegations: delegations, Pagination: page})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryDelegatorUnbondingDelegations(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	params, err := readDelegatorPageParams(cdc, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ubds, page, errRes := k.GetDelegatorUnbondingDelegationsPaginated(ctx, params.DelegatorAddr, params.Pagination)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(errRes.Error())
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, QueryUnbondingDelegationsResponse{UnbondingDelegations: ubds, Pagination: page})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryDelegatorRedelegations(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	params, err := readDelegatorPageParams(cdc, req)
+	if err != nil {
+		return nil, err
+	}
+
+	reds, page, errRes := k.GetDelegatorRedelegationsPaginated(ctx, params.DelegatorAddr, params.Pagination)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(errRes.Error())
+	}
+
+	res, errRes = codec.MarshalJSONIndent(cdc, QueryRedelegationsResponse{Redelegations: reds, Pagination: page})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", errRes.Error()))
+	}
+	return res, nil
+}
+
 func queryPool(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
 	pool := k.GetPool(ctx)
 
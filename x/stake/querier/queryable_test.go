@@ -77,19 +77,40 @@ func TestQueryValidators(t *testing.T) {
 	// Query Validators
 	queriedValidators := keeper.GetValidators(ctx, params.MaxValidators)
 
-	res, err := queryValidators(ctx, cdc, keeper)
+	res, err := queryValidators(ctx, cdc, abci.RequestQuery{}, keeper)
 	require.Nil(t, err)
 
-	var validatorsResp []types.Validator
+	var validatorsResp QueryValidatorsResponse
 	errRes := cdc.UnmarshalJSON(res, &validatorsResp)
 	require.Nil(t, errRes)
 
-	require.Equal(t, len(queriedValidators), len(validatorsResp))
-	require.ElementsMatch(t, queriedValidators, validatorsResp)
+	require.Equal(t, len(queriedValidators), len(validatorsResp.Validators))
+	require.ElementsMatch(t, queriedValidators, validatorsResp.Validators)
+	require.Nil(t, validatorsResp.Pagination.NextKey)
+
+	// Query the validators page by page
+	bz, errRes := cdc.MarshalJSON(QueryValidatorsParams{Pagination: sdk.PageRequest{Limit: 1, CountTotal: true}})
+	require.Nil(t, errRes)
+	res, err = queryValidators(ctx, cdc, abci.RequestQuery{Data: bz}, keeper)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(res, &validatorsResp))
+	require.Len(t, validatorsResp.Validators, 1)
+	require.Equal(t, int64(2), validatorsResp.Pagination.Total)
+	require.NotNil(t, validatorsResp.Pagination.NextKey)
+
+	bz, errRes = cdc.MarshalJSON(QueryValidatorsParams{Pagination: sdk.PageRequest{Key: validatorsResp.Pagination.NextKey, Limit: 1}})
+	require.Nil(t, errRes)
+	firstValidator := validatorsResp.Validators[0]
+	res, err = queryValidators(ctx, cdc, abci.RequestQuery{Data: bz}, keeper)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(res, &validatorsResp))
+	require.Len(t, validatorsResp.Validators, 1)
+	require.NotEqual(t, firstValidator.OperatorAddr, validatorsResp.Validators[0].OperatorAddr)
+	require.Nil(t, validatorsResp.Pagination.NextKey)
 
 	// Query each validator
 	queryParams := newTestValidatorQuery(addrVal1)
-	bz, errRes := cdc.MarshalJSON(queryParams)
+	bz, errRes = cdc.MarshalJSON(queryParams)
 	require.Nil(t, errRes)
 
 	query := abci.RequestQuery{
@@ -218,3 +239,55 @@ func TestQueryDelegation(t *testing.T) {
 
 	require.Equal(t, unbond, summary.UnbondingDelegations[0])
 }
+
+func TestQueryDelegatorLists(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper := keep.CreateTestInput(t, false, 10000)
+	delAddr := keep.Addrs[3]
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1]), sdk.ValAddress(keep.Addrs[2])}
+
+	for i, valAddr := range valAddrs {
+		keeper.SetDelegation(ctx, types.Delegation{DelegatorAddr: delAddr, ValidatorAddr: valAddr, Shares: sdk.NewDec(10)})
+		keeper.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, 1, ctx.BlockHeader().Time, sdk.NewInt64Coin("steak", 5))
+		keeper.SetRedelegationEntry(ctx, delAddr, valAddr, valAddrs[(i+1)%3], 1, ctx.BlockHeader().Time,
+			sdk.NewInt64Coin("steak", 5), sdk.NewDec(5), sdk.NewDec(5))
+	}
+	// delegations of other delegators are not listed
+	keeper.SetDelegation(ctx, types.Delegation{DelegatorAddr: keep.Addrs[4], ValidatorAddr: valAddrs[0], Shares: sdk.NewDec(10)})
+
+	query := func(path string, page sdk.PageRequest, queryFn func(sdk.Context, *codec.Codec, abci.RequestQuery, keep.Keeper) ([]byte, sdk.Error), res interface{}) {
+		bz, errRes := cdc.MarshalJSON(QueryDelegatorPageParams{DelegatorAddr: delAddr, Pagination: page})
+		require.Nil(t, errRes)
+		bz, err := queryFn(ctx, cdc, abci.RequestQuery{Path: path, Data: bz}, keeper)
+		require.Nil(t, err)
+		require.Nil(t, cdc.UnmarshalJSON(bz, res))
+	}
+
+	// delegations are listed by validator address, in both orders
+	all := keeper.GetAllDelegatorDelegations(ctx, delAddr)
+	require.Len(t, all, 3)
+
+	var delegations QueryDelegationsResponse
+	query("/custom/stake/delegatorDelegations", sdk.PageRequest{Limit: 2, CountTotal: true}, queryDelegatorDelegations, &delegations)
+	require.Equal(t, all[:2], delegations.Delegations)
+	require.Equal(t, int64(3), delegations.Pagination.Total)
+	require.NotNil(t, delegations.Pagination.NextKey)
+
+	query("/custom/stake/delegatorDelegations", sdk.PageRequest{Key: delegations.Pagination.NextKey, Limit: 2}, queryDelegatorDelegations, &delegations)
+	require.Equal(t, all[2:], delegations.Delegations)
+	require.Nil(t, delegations.Pagination.NextKey)
+
+	query("/custom/stake/delegatorDelegations", sdk.PageRequest{Limit: 1, Descending: true}, queryDelegatorDelegations, &delegations)
+	require.Equal(t, all[2:], delegations.Delegations)
+	require.NotNil(t, delegations.Pagination.NextKey)
+
+	var ubds QueryUnbondingDelegationsResponse
+	query("/custom/stake/delegatorUnbondingDelegations", sdk.PageRequest{Offset: 1, CountTotal: true}, queryDelegatorUnbondingDelegations, &ubds)
+	require.Equal(t, keeper.GetAllUnbondingDelegations(ctx, delAddr)[1:], ubds.UnbondingDelegations)
+	require.Equal(t, int64(3), ubds.Pagination.Total)
+
+	var reds QueryRedelegationsResponse
+	query("/custom/stake/delegatorRedelegations", sdk.PageRequest{Limit: 2}, queryDelegatorRedelegations, &reds)
+	require.Equal(t, keeper.GetAllRedelegations(ctx, delAddr)[:2], reds.Redelegations)
+	require.NotNil(t, reds.Pagination.NextKey)
+}
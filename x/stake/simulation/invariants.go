@@ -43,7 +43,9 @@ func SupplyInvariants(ck bank.Keeper, k stake.Keeper, am auth.AccountMapper) sim
 			return false
 		})
 		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd stake.UnbondingDelegation) bool {
-			loose = loose.Add(ubd.Balance.Amount)
+			for _, entry := range ubd.Entries {
+				loose = loose.Add(entry.Balance.Amount)
+			}
 			return false
 		})
 		k.IterateValidators(ctx, func(_ int64, validator sdk.Validator) bool {
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 // SimulateMsgCreateValidator
@@ -44,12 +45,13 @@ func SimulateMsgCreateValidator(m auth.AccountMapper, k stake.Keeper) simulation
 		}
 
 		msg := stake.MsgCreateValidator{
-			Description:   description,
-			Commission:    commission,
-			ValidatorAddr: address,
-			DelegatorAddr: acc.Address,
-			PubKey:        acc.PubKey,
-			Delegation:    sdk.NewCoin(denom, amount),
+			Description:       description,
+			Commission:        commission,
+			ValidatorAddr:     address,
+			DelegatorAddr:     acc.Address,
+			PubKey:            acc.PubKey,
+			Delegation:        sdk.NewCoin(denom, amount),
+			MinSelfDelegation: sdk.OneInt(),
 		}
 
 		if msg.ValidateBasic() != nil {
@@ -110,6 +112,33 @@ func SimulateMsgEditValidator(k stake.Keeper) simulation.Operation {
 	}
 }
 
+// SimulateMsgRotateConsPubKey
+func SimulateMsgRotateConsPubKey(k stake.Keeper) simulation.Operation {
+	handler := stake.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		acc := simulation.RandomAcc(r, accs)
+		seed := make([]byte, 32)
+		r.Read(seed)
+		msg := stake.NewMsgRotateConsPubKey(sdk.ValAddress(acc.Address), ed25519.GenPrivKeyFromSecret(seed).PubKey())
+
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+		event(fmt.Sprintf("stake/MsgRotateConsPubKey/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgRotateConsPubKey: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
 // SimulateMsgDelegate
 func SimulateMsgDelegate(m auth.AccountMapper, k stake.Keeper) simulation.Operation {
 	handler := stake.NewHandler(k)
@@ -186,6 +215,51 @@ func SimulateMsgBeginUnbonding(m auth.AccountMapper, k stake.Keeper) simulation.
 	}
 }
 
+// SimulateMsgCancelUnbondingDelegation
+func SimulateMsgCancelUnbondingDelegation(m auth.AccountMapper, k stake.Keeper) simulation.Operation {
+	handler := stake.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+		ubds := k.GetUnbondingDelegations(ctx, delegatorAddress, 10)
+		if len(ubds) == 0 {
+			return "no-operation", nil, nil
+		}
+		ubd := ubds[r.Intn(len(ubds))]
+		if len(ubd.Entries) == 0 {
+			return "no-operation", nil, nil
+		}
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if !entry.Balance.Amount.GT(sdk.ZeroInt()) {
+			return "no-operation", nil, nil
+		}
+		amount := simulation.RandomAmount(r, entry.Balance.Amount)
+		if amount.Equal(sdk.ZeroInt()) {
+			return "no-operation", nil, nil
+		}
+		msg := stake.MsgCancelUnbondingDelegation{
+			DelegatorAddr:  delegatorAddress,
+			ValidatorAddr:  ubd.ValidatorAddr,
+			CreationHeight: entry.CreationHeight,
+			Amount:         sdk.NewCoin(entry.Balance.Denom, amount),
+		}
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+		event(fmt.Sprintf("stake/MsgCancelUnbondingDelegation/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgCancelUnbondingDelegation: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
 // SimulateMsgBeginRedelegate
 func SimulateMsgBeginRedelegate(m auth.AccountMapper, k stake.Keeper) simulation.Operation {
 	handler := stake.NewHandler(k)
@@ -228,6 +302,50 @@ func SimulateMsgBeginRedelegate(m auth.AccountMapper, k stake.Keeper) simulation
 	}
 }
 
+// SimulateMsgTransferDelegation
+func SimulateMsgTransferDelegation(m auth.AccountMapper, k stake.Keeper) simulation.Operation {
+	handler := stake.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, event func(string)) (
+		action string, fOp []simulation.FutureOperation, err error) {
+
+		denom := k.GetParams(ctx).BondDenom
+		validatorAcc := simulation.RandomAcc(r, accs)
+		validatorAddress := sdk.ValAddress(validatorAcc.Address)
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+		recipientAcc := simulation.RandomAcc(r, accs)
+		recipientAddress := recipientAcc.Address
+		if delegatorAddress.Equals(recipientAddress) {
+			return "no-operation", nil, nil
+		}
+		amount := m.GetAccount(ctx, delegatorAddress).GetCoins().AmountOf(denom)
+		if amount.GT(sdk.ZeroInt()) {
+			amount = simulation.RandomAmount(r, amount)
+		}
+		if amount.Equal(sdk.ZeroInt()) {
+			return "no-operation", nil, nil
+		}
+		msg := stake.MsgTransferDelegation{
+			DelegatorAddr: delegatorAddress,
+			RecipientAddr: recipientAddress,
+			ValidatorAddr: validatorAddress,
+			SharesAmount:  sdk.NewDecFromInt(amount),
+		}
+		if msg.ValidateBasic() != nil {
+			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+		ctx, write := ctx.CacheContext()
+		result := handler(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+		event(fmt.Sprintf("stake/MsgTransferDelegation/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgTransferDelegation: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
 // Setup
 // nolint: errcheck
 func Setup(mapp *mock.App, k stake.Keeper) simulation.RandSetup {
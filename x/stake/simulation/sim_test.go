@@ -52,9 +52,12 @@ func TestStakeWithRandomMessages(t *testing.T) {
 		[]simulation.WeightedOperation{
 			{10, SimulateMsgCreateValidator(mapper, stakeKeeper)},
 			{5, SimulateMsgEditValidator(stakeKeeper)},
+			{5, SimulateMsgRotateConsPubKey(stakeKeeper)},
 			{15, SimulateMsgDelegate(mapper, stakeKeeper)},
 			{10, SimulateMsgBeginUnbonding(mapper, stakeKeeper)},
 			{10, SimulateMsgBeginRedelegate(mapper, stakeKeeper)},
+			{10, SimulateMsgTransferDelegation(mapper, stakeKeeper)},
+			{10, SimulateMsgCancelUnbondingDelegation(mapper, stakeKeeper)},
 		}, []simulation.RandSetup{
 			Setup(mapp, stakeKeeper),
 		}, []simulation.Invariant{
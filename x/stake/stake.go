@@ -9,25 +9,39 @@ import (
 )
 
 type (
-	Keeper               = keeper.Keeper
-	Validator            = types.Validator
-	Description          = types.Description
-	Commission           = types.Commission
-	Delegation           = types.Delegation
-	DelegationSummary    = types.DelegationSummary
-	UnbondingDelegation  = types.UnbondingDelegation
-	Redelegation         = types.Redelegation
-	Params               = types.Params
-	Pool                 = types.Pool
-	MsgCreateValidator   = types.MsgCreateValidator
-	MsgEditValidator     = types.MsgEditValidator
-	MsgDelegate          = types.MsgDelegate
-	MsgBeginUnbonding    = types.MsgBeginUnbonding
-	MsgBeginRedelegate   = types.MsgBeginRedelegate
-	GenesisState         = types.GenesisState
-	QueryDelegatorParams = querier.QueryDelegatorParams
-	QueryValidatorParams = querier.QueryValidatorParams
-	QueryBondsParams     = querier.QueryBondsParams
+	Keeper                       = keeper.Keeper
+	Validator                    = types.Validator
+	Description                  = types.Description
+	Commission                   = types.Commission
+	Delegation                   = types.Delegation
+	DelegationSummary            = types.DelegationSummary
+	UnbondingDelegation          = types.UnbondingDelegation
+	UnbondingDelegationEntry     = types.UnbondingDelegationEntry
+	Redelegation                 = types.Redelegation
+	RedelegationEntry            = types.RedelegationEntry
+	ConsPubKeyRotation           = types.ConsPubKeyRotation
+	PendingConsPubKeyRotation    = types.PendingConsPubKeyRotation
+	Params                       = types.Params
+	Pool                         = types.Pool
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgRotateConsPubKey          = types.MsgRotateConsPubKey
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginUnbonding            = types.MsgBeginUnbonding
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgTransferDelegation        = types.MsgTransferDelegation
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	GenesisState                 = types.GenesisState
+	QueryDelegatorParams         = querier.QueryDelegatorParams
+	QueryValidatorParams         = querier.QueryValidatorParams
+	QueryValidatorsParams        = querier.QueryValidatorsParams
+	QueryValidatorsResponse      = querier.QueryValidatorsResponse
+	QueryBondsParams             = querier.QueryBondsParams
+
+	QueryDelegatorPageParams          = querier.QueryDelegatorPageParams
+	QueryDelegationsResponse          = querier.QueryDelegationsResponse
+	QueryUnbondingDelegationsResponse = querier.QueryUnbondingDelegationsResponse
+	QueryRedelegationsResponse        = querier.QueryRedelegationsResponse
 )
 
 var (
@@ -58,13 +72,17 @@ var (
 	GetREDsByDelToValDstIndexKey = keeper.GetREDsByDelToValDstIndexKey
 	TestingUpdateValidator       = keeper.TestingUpdateValidator
 
-	DefaultParamspace      = keeper.DefaultParamspace
-	KeyInflationRateChange = types.KeyInflationRateChange
-	KeyInflationMax        = types.KeyInflationMax
-	KeyGoalBonded          = types.KeyGoalBonded
-	KeyUnbondingTime       = types.KeyUnbondingTime
-	KeyMaxValidators       = types.KeyMaxValidators
-	KeyBondDenom           = types.KeyBondDenom
+	DefaultParamspace         = keeper.DefaultParamspace
+	KeyInflationRateChange    = types.KeyInflationRateChange
+	KeyInflationMax           = types.KeyInflationMax
+	KeyGoalBonded             = types.KeyGoalBonded
+	KeyUnbondingTime          = types.KeyUnbondingTime
+	KeyMaxValidators          = types.KeyMaxValidators
+	KeyMaxEntries             = types.KeyMaxEntries
+	KeyMaxVotingPowerFraction = types.KeyMaxVotingPowerFraction
+	KeyMaxConsPubKeyRotations = types.KeyMaxConsPubKeyRotations
+	KeyConsPubKeyRotationFee  = types.KeyConsPubKeyRotationFee
+	KeyBondDenom              = types.KeyBondDenom
 
 	DefaultParams         = types.DefaultParams
 	InitialPool           = types.InitialPool
@@ -80,9 +98,12 @@ var (
 	NewMsgCreateValidator           = types.NewMsgCreateValidator
 	NewMsgCreateValidatorOnBehalfOf = types.NewMsgCreateValidatorOnBehalfOf
 	NewMsgEditValidator             = types.NewMsgEditValidator
+	NewMsgRotateConsPubKey          = types.NewMsgRotateConsPubKey
 	NewMsgDelegate                  = types.NewMsgDelegate
 	NewMsgBeginUnbonding            = types.NewMsgBeginUnbonding
 	NewMsgBeginRedelegate           = types.NewMsgBeginRedelegate
+	NewMsgTransferDelegation        = types.NewMsgTransferDelegation
+	NewMsgCancelUnbondingDelegation = types.NewMsgCancelUnbondingDelegation
 
 	NewQuerier = querier.NewQuerier
 )
@@ -97,6 +118,10 @@ const (
 	QueryDelegatorValidator  = querier.QueryDelegatorValidator
 	QueryPool                = querier.QueryPool
 	QueryParameters          = querier.QueryParameters
+
+	QueryDelegatorDelegations          = querier.QueryDelegatorDelegations
+	QueryDelegatorUnbondingDelegations = querier.QueryDelegatorUnbondingDelegations
+	QueryDelegatorRedelegations        = querier.QueryDelegatorRedelegations
 )
 
 const (
@@ -111,15 +136,21 @@ const (
 )
 
 var (
-	ErrNilValidatorAddr      = types.ErrNilValidatorAddr
-	ErrNoValidatorFound      = types.ErrNoValidatorFound
-	ErrValidatorOwnerExists  = types.ErrValidatorOwnerExists
-	ErrValidatorPubKeyExists = types.ErrValidatorPubKeyExists
-	ErrValidatorJailed       = types.ErrValidatorJailed
-	ErrBadRemoveValidator    = types.ErrBadRemoveValidator
-	ErrDescriptionLength     = types.ErrDescriptionLength
-	ErrCommissionNegative    = types.ErrCommissionNegative
-	ErrCommissionHuge        = types.ErrCommissionHuge
+	ErrNilValidatorAddr           = types.ErrNilValidatorAddr
+	ErrNoValidatorFound           = types.ErrNoValidatorFound
+	ErrValidatorOwnerExists       = types.ErrValidatorOwnerExists
+	ErrValidatorPubKeyExists      = types.ErrValidatorPubKeyExists
+	ErrValidatorJailed            = types.ErrValidatorJailed
+	ErrBadRemoveValidator         = types.ErrBadRemoveValidator
+	ErrDescriptionLength          = types.ErrDescriptionLength
+	ErrCommissionNegative         = types.ErrCommissionNegative
+	ErrCommissionHuge             = types.ErrCommissionHuge
+	ErrMinSelfDelegationInvalid   = types.ErrMinSelfDelegationInvalid
+	ErrMinSelfDelegationDecreased = types.ErrMinSelfDelegationDecreased
+	ErrSelfDelegationBelowMinimum = types.ErrSelfDelegationBelowMinimum
+	ErrNilConsPubKey              = types.ErrNilConsPubKey
+	ErrConsPubKeyUnchanged        = types.ErrConsPubKeyUnchanged
+	ErrMaxConsPubKeyRotations     = types.ErrMaxConsPubKeyRotations
 
 	ErrNilDelegatorAddr          = types.ErrNilDelegatorAddr
 	ErrBadDenom                  = types.ErrBadDenom
@@ -133,10 +164,21 @@ var (
 	ErrBadSharesAmount           = types.ErrBadSharesAmount
 	ErrBadSharesPercent          = types.ErrBadSharesPercent
 
-	ErrNotMature             = types.ErrNotMature
-	ErrNoUnbondingDelegation = types.ErrNoUnbondingDelegation
-	ErrNoRedelegation        = types.ErrNoRedelegation
-	ErrBadRedelegationDst    = types.ErrBadRedelegationDst
+	ErrNotMature                         = types.ErrNotMature
+	ErrNoUnbondingDelegation             = types.ErrNoUnbondingDelegation
+	ErrBadCreationHeight                 = types.ErrBadCreationHeight
+	ErrNoUnbondingDelegationAtHeight     = types.ErrNoUnbondingDelegationAtHeight
+	ErrUnbondingDelegationMature         = types.ErrUnbondingDelegationMature
+	ErrAmbiguousUnbondingDelegationEntry = types.ErrAmbiguousUnbondingDelegationEntry
+	ErrNotEnoughUnbondingBalance         = types.ErrNotEnoughUnbondingBalance
+	ErrNoRedelegation                    = types.ErrNoRedelegation
+	ErrMaxUnbondingDelegationEntries     = types.ErrMaxUnbondingDelegationEntries
+	ErrMaxRedelegationEntries            = types.ErrMaxRedelegationEntries
+	ErrBadRedelegationDst                = types.ErrBadRedelegationDst
+
+	ErrNilRecipientAddr              = types.ErrNilRecipientAddr
+	ErrSelfTransferDelegation        = types.ErrSelfTransferDelegation
+	ErrTransferReceivingRedelegation = types.ErrTransferReceivingRedelegation
 
 	ErrBothShareMsgsGiven    = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven = types.ErrNeitherShareMsgsGiven
@@ -146,16 +188,20 @@ var (
 var (
 	ActionCreateValidator      = tags.ActionCreateValidator
 	ActionEditValidator        = tags.ActionEditValidator
+	ActionRotateConsPubKey     = tags.ActionRotateConsPubKey
 	ActionDelegate             = tags.ActionDelegate
 	ActionBeginUnbonding       = tags.ActionBeginUnbonding
 	ActionCompleteUnbonding    = tags.ActionCompleteUnbonding
 	ActionBeginRedelegation    = tags.ActionBeginRedelegation
 	ActionCompleteRedelegation = tags.ActionCompleteRedelegation
+	ActionTransferDelegation   = tags.ActionTransferDelegation
+	ActionCancelUnbonding      = tags.ActionCancelUnbonding
 
 	TagAction       = tags.Action
 	TagSrcValidator = tags.SrcValidator
 	TagDstValidator = tags.DstValidator
 	TagDelegator    = tags.Delegator
+	TagRecipient    = tags.Recipient
 	TagMoniker      = tags.Moniker
 	TagIdentity     = tags.Identity
 )
@@ -8,16 +8,20 @@ import (
 var (
 	ActionCreateValidator      = []byte("create-validator")
 	ActionEditValidator        = []byte("edit-validator")
+	ActionRotateConsPubKey     = []byte("rotate-cons-pubkey")
 	ActionDelegate             = []byte("delegate")
 	ActionBeginUnbonding       = []byte("begin-unbonding")
 	ActionCompleteUnbonding    = []byte("complete-unbonding")
 	ActionBeginRedelegation    = []byte("begin-redelegation")
 	ActionCompleteRedelegation = []byte("complete-redelegation")
+	ActionTransferDelegation   = []byte("transfer-delegation")
+	ActionCancelUnbonding      = []byte("cancel-unbonding")
 
 	Action       = sdk.TagAction
 	SrcValidator = sdk.TagSrcValidator
 	DstValidator = sdk.TagDstValidator
 	Delegator    = sdk.TagDelegator
+	Recipient    = "recipient"
 	Moniker      = "moniker"
 	Identity     = "identity"
 	EndTime      = "end-time"
@@ -28,7 +28,7 @@ var (
 
 func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt int64) MsgCreateValidator {
 	return types.NewMsgCreateValidator(
-		address, pubKey, sdk.NewCoin("steak", sdk.NewInt(amt)), Description{}, commissionMsg,
+		address, pubKey, sdk.NewCoin("steak", sdk.NewInt(amt)), Description{}, commissionMsg, sdk.OneInt(),
 	)
 }
 
@@ -38,7 +38,7 @@ func NewTestMsgCreateValidatorWithCommission(address sdk.ValAddress, pubKey cryp
 	commission := NewCommissionMsg(commissionRate, sdk.OneDec(), sdk.ZeroDec())
 
 	return types.NewMsgCreateValidator(
-		address, pubKey, sdk.NewCoin("steak", sdk.NewInt(amt)), Description{}, commission,
+		address, pubKey, sdk.NewCoin("steak", sdk.NewInt(amt)), Description{}, commission, sdk.OneInt(),
 	)
 }
 
@@ -52,11 +52,12 @@ func NewTestMsgDelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt int6
 
 func NewTestMsgCreateValidatorOnBehalfOf(delAddr sdk.AccAddress, valAddr sdk.ValAddress, valPubKey crypto.PubKey, amt int64) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   Description{},
-		Commission:    commissionMsg,
-		DelegatorAddr: delAddr,
-		ValidatorAddr: valAddr,
-		PubKey:        valPubKey,
-		Delegation:    sdk.NewCoin("steak", sdk.NewInt(amt)),
+		Description:       Description{},
+		Commission:        commissionMsg,
+		DelegatorAddr:     delAddr,
+		ValidatorAddr:     valAddr,
+		PubKey:            valPubKey,
+		Delegation:        sdk.NewCoin("steak", sdk.NewInt(amt)),
+		MinSelfDelegation: sdk.OneInt(),
 	}
 }
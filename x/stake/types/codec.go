@@ -8,9 +8,12 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateValidator{}, "cosmos-sdk/MsgCreateValidator", nil)
 	cdc.RegisterConcrete(MsgEditValidator{}, "cosmos-sdk/MsgEditValidator", nil)
+	cdc.RegisterConcrete(MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey", nil)
 	cdc.RegisterConcrete(MsgDelegate{}, "cosmos-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(MsgBeginUnbonding{}, "cosmos-sdk/BeginUnbonding", nil)
 	cdc.RegisterConcrete(MsgBeginRedelegate{}, "cosmos-sdk/BeginRedelegate", nil)
+	cdc.RegisterConcrete(MsgTransferDelegation{}, "cosmos-sdk/TransferDelegation", nil)
+	cdc.RegisterConcrete(MsgCancelUnbondingDelegation{}, "cosmos-sdk/CancelUnbondingDelegation", nil)
 }
 
 // generic sealed codec to be used throughout sdk
@@ -122,31 +122,80 @@ func (d Delegation) HumanReadableString() (string, error) {
 }
 
 // UnbondingDelegation reflects a delegation's passive unbonding queue.
+// it may hold multiple entries between the same delegator/validator
 type UnbondingDelegation struct {
-	DelegatorAddr  sdk.AccAddress `json:"delegator_addr"`  // delegator
-	ValidatorAddr  sdk.ValAddress `json:"validator_addr"`  // validator unbonding from operator addr
-	CreationHeight int64          `json:"creation_height"` // height which the unbonding took place
-	MinTime        time.Time      `json:"min_time"`        // unix time for unbonding completion
-	InitialBalance sdk.Coin       `json:"initial_balance"` // atoms initially scheduled to receive at completion
-	Balance        sdk.Coin       `json:"balance"`         // atoms to receive at completion
+	DelegatorAddr sdk.AccAddress             `json:"delegator_addr"` // delegator
+	ValidatorAddr sdk.ValAddress             `json:"validator_addr"` // validator unbonding from operator addr
+	Entries       []UnbondingDelegationEntry `json:"entries"`        // unbonding delegation entries
 }
 
-type ubdValue struct {
-	CreationHeight int64
-	MinTime        time.Time
-	InitialBalance sdk.Coin
-	Balance        sdk.Coin
+// UnbondingDelegationEntry - entry to an UnbondingDelegation
+type UnbondingDelegationEntry struct {
+	CreationHeight int64     `json:"creation_height"` // height which the unbonding took place
+	CompletionTime time.Time `json:"completion_time"` // unix time for unbonding completion
+	InitialBalance sdk.Coin  `json:"initial_balance"` // atoms initially scheduled to receive at completion
+	Balance        sdk.Coin  `json:"balance"`         // atoms to receive at completion
+}
+
+// IsMature - is the current entry mature
+func (e UnbondingDelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
+
+// NewUnbondingDelegation - create a new unbonding delegation object
+func NewUnbondingDelegation(delegatorAddr sdk.AccAddress,
+	validatorAddr sdk.ValAddress, creationHeight int64, minTime time.Time,
+	balance sdk.Coin) UnbondingDelegation {
+
+	entry := NewUnbondingDelegationEntry(creationHeight, minTime, balance)
+	return UnbondingDelegation{
+		DelegatorAddr: delegatorAddr,
+		ValidatorAddr: validatorAddr,
+		Entries:       []UnbondingDelegationEntry{entry},
+	}
+}
+
+// NewUnbondingDelegationEntry - create a new unbonding delegation entry
+func NewUnbondingDelegationEntry(creationHeight int64, completionTime time.Time,
+	balance sdk.Coin) UnbondingDelegationEntry {
+
+	return UnbondingDelegationEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+		Balance:        balance,
+	}
+}
+
+// AddEntry - append entry to the unbonding delegation, or add the balance to
+// the entry created at the same height with the same completion time so that
+// entries are identified by their creation height. Returns whether a new
+// entry was appended.
+func (d *UnbondingDelegation) AddEntry(creationHeight int64,
+	minTime time.Time, balance sdk.Coin) bool {
+
+	for i, entry := range d.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(minTime) {
+			entry.InitialBalance = entry.InitialBalance.Plus(balance)
+			entry.Balance = entry.Balance.Plus(balance)
+			d.Entries[i] = entry
+			return false
+		}
+	}
+
+	entry := NewUnbondingDelegationEntry(creationHeight, minTime, balance)
+	d.Entries = append(d.Entries, entry)
+	return true
+}
+
+// RemoveEntry - remove entry at index i from the unbonding delegation
+func (d *UnbondingDelegation) RemoveEntry(i int64) {
+	d.Entries = append(d.Entries[:i], d.Entries[i+1:]...)
 }
 
 // return the unbonding delegation without fields contained within the key for the store
 func MustMarshalUBD(cdc *codec.Codec, ubd UnbondingDelegation) []byte {
-	val := ubdValue{
-		ubd.CreationHeight,
-		ubd.MinTime,
-		ubd.InitialBalance,
-		ubd.Balance,
-	}
-	return cdc.MustMarshalBinary(val)
+	return cdc.MustMarshalBinary(ubd.Entries)
 }
 
 // unmarshal a unbonding delegation from a store key and value
@@ -160,8 +209,8 @@ func MustUnmarshalUBD(cdc *codec.Codec, key, value []byte) UnbondingDelegation {
 
 // unmarshal a unbonding delegation from a store key and value
 func UnmarshalUBD(cdc *codec.Codec, key, value []byte) (ubd UnbondingDelegation, err error) {
-	var storeValue ubdValue
-	err = cdc.UnmarshalBinary(value, &storeValue)
+	var entries []UnbondingDelegationEntry
+	err = cdc.UnmarshalBinary(value, &entries)
 	if err != nil {
 		return
 	}
@@ -175,12 +224,9 @@ func UnmarshalUBD(cdc *codec.Codec, key, value []byte) (ubd UnbondingDelegation,
 	valAddr := sdk.ValAddress(addrs[sdk.AddrLen:])
 
 	return UnbondingDelegation{
-		DelegatorAddr:  delAddr,
-		ValidatorAddr:  valAddr,
-		CreationHeight: storeValue.CreationHeight,
-		MinTime:        storeValue.MinTime,
-		InitialBalance: storeValue.InitialBalance,
-		Balance:        storeValue.Balance,
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		Entries:       entries,
 	}, nil
 }
 
@@ -198,47 +244,90 @@ func (d UnbondingDelegation) HumanReadableString() (string, error) {
 	resp := "Unbonding Delegation \n"
 	resp += fmt.Sprintf("Delegator: %s\n", d.DelegatorAddr)
 	resp += fmt.Sprintf("Validator: %s\n", d.ValidatorAddr)
-	resp += fmt.Sprintf("Creation height: %v\n", d.CreationHeight)
-	resp += fmt.Sprintf("Min time to unbond (unix): %v\n", d.MinTime)
-	resp += fmt.Sprintf("Expected balance: %s", d.Balance.String())
+	for i, entry := range d.Entries {
+		resp += fmt.Sprintf("Unbonding Delegation %d: \n", i)
+		resp += fmt.Sprintf("  Creation height: %v\n", entry.CreationHeight)
+		resp += fmt.Sprintf("  Min time to unbond (unix): %v\n", entry.CompletionTime)
+		resp += fmt.Sprintf("  Expected balance: %s\n", entry.Balance.String())
+	}
 
 	return resp, nil
 
 }
 
 // Redelegation reflects a delegation's passive re-delegation queue.
+// it may hold multiple entries between the same delegator/validator source/validator destination
 type Redelegation struct {
-	DelegatorAddr    sdk.AccAddress `json:"delegator_addr"`     // delegator
-	ValidatorSrcAddr sdk.ValAddress `json:"validator_src_addr"` // validator redelegation source operator addr
-	ValidatorDstAddr sdk.ValAddress `json:"validator_dst_addr"` // validator redelegation destination operator addr
-	CreationHeight   int64          `json:"creation_height"`    // height which the redelegation took place
-	MinTime          time.Time      `json:"min_time"`           // unix time for redelegation completion
-	InitialBalance   sdk.Coin       `json:"initial_balance"`    // initial balance when redelegation started
-	Balance          sdk.Coin       `json:"balance"`            // current balance
-	SharesSrc        sdk.Dec        `json:"shares_src"`         // amount of source shares redelegating
-	SharesDst        sdk.Dec        `json:"shares_dst"`         // amount of destination shares redelegating
-}
-
-type redValue struct {
-	CreationHeight int64
-	MinTime        time.Time
-	InitialBalance sdk.Coin
-	Balance        sdk.Coin
-	SharesSrc      sdk.Dec
-	SharesDst      sdk.Dec
+	DelegatorAddr    sdk.AccAddress      `json:"delegator_addr"`     // delegator
+	ValidatorSrcAddr sdk.ValAddress      `json:"validator_src_addr"` // validator redelegation source operator addr
+	ValidatorDstAddr sdk.ValAddress      `json:"validator_dst_addr"` // validator redelegation destination operator addr
+	Entries          []RedelegationEntry `json:"entries"`            // redelegation entries
+}
+
+// RedelegationEntry - entry to a Redelegation
+type RedelegationEntry struct {
+	CreationHeight int64     `json:"creation_height"` // height which the redelegation took place
+	CompletionTime time.Time `json:"completion_time"` // unix time for redelegation completion
+	InitialBalance sdk.Coin  `json:"initial_balance"` // initial balance when redelegation started
+	Balance        sdk.Coin  `json:"balance"`         // current balance
+	SharesSrc      sdk.Dec   `json:"shares_src"`      // amount of source shares redelegating
+	SharesDst      sdk.Dec   `json:"shares_dst"`      // amount of destination shares redelegating
+}
+
+// IsMature - is the current entry mature
+func (e RedelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
+
+// NewRedelegation - create a new redelegation object
+func NewRedelegation(delegatorAddr sdk.AccAddress, validatorSrcAddr,
+	validatorDstAddr sdk.ValAddress, creationHeight int64,
+	minTime time.Time, balance sdk.Coin,
+	sharesSrc, sharesDst sdk.Dec) Redelegation {
+
+	entry := NewRedelegationEntry(creationHeight,
+		minTime, balance, sharesSrc, sharesDst)
+
+	return Redelegation{
+		DelegatorAddr:    delegatorAddr,
+		ValidatorSrcAddr: validatorSrcAddr,
+		ValidatorDstAddr: validatorDstAddr,
+		Entries:          []RedelegationEntry{entry},
+	}
+}
+
+// NewRedelegationEntry - create a new redelegation entry
+func NewRedelegationEntry(creationHeight int64,
+	completionTime time.Time, balance sdk.Coin,
+	sharesSrc, sharesDst sdk.Dec) RedelegationEntry {
+
+	return RedelegationEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+		Balance:        balance,
+		SharesSrc:      sharesSrc,
+		SharesDst:      sharesDst,
+	}
+}
+
+// AddEntry - append entry to the redelegation
+func (d *Redelegation) AddEntry(creationHeight int64,
+	minTime time.Time, balance sdk.Coin,
+	sharesSrc, sharesDst sdk.Dec) {
+
+	entry := NewRedelegationEntry(creationHeight, minTime, balance, sharesSrc, sharesDst)
+	d.Entries = append(d.Entries, entry)
+}
+
+// RemoveEntry - remove entry at index i from the redelegation
+func (d *Redelegation) RemoveEntry(i int64) {
+	d.Entries = append(d.Entries[:i], d.Entries[i+1:]...)
 }
 
 // return the redelegation without fields contained within the key for the store
 func MustMarshalRED(cdc *codec.Codec, red Redelegation) []byte {
-	val := redValue{
-		red.CreationHeight,
-		red.MinTime,
-		red.InitialBalance,
-		red.Balance,
-		red.SharesSrc,
-		red.SharesDst,
-	}
-	return cdc.MustMarshalBinary(val)
+	return cdc.MustMarshalBinary(red.Entries)
 }
 
 // unmarshal a redelegation from a store key and value
@@ -252,8 +341,8 @@ func MustUnmarshalRED(cdc *codec.Codec, key, value []byte) Redelegation {
 
 // unmarshal a redelegation from a store key and value
 func UnmarshalRED(cdc *codec.Codec, key, value []byte) (red Redelegation, err error) {
-	var storeValue redValue
-	err = cdc.UnmarshalBinary(value, &storeValue)
+	var entries []RedelegationEntry
+	err = cdc.UnmarshalBinary(value, &entries)
 	if err != nil {
 		return
 	}
@@ -271,12 +360,7 @@ func UnmarshalRED(cdc *codec.Codec, key, value []byte) (red Redelegation, err er
 		DelegatorAddr:    delAddr,
 		ValidatorSrcAddr: valSrcAddr,
 		ValidatorDstAddr: valDstAddr,
-		CreationHeight:   storeValue.CreationHeight,
-		MinTime:          storeValue.MinTime,
-		InitialBalance:   storeValue.InitialBalance,
-		Balance:          storeValue.Balance,
-		SharesSrc:        storeValue.SharesSrc,
-		SharesDst:        storeValue.SharesDst,
+		Entries:          entries,
 	}, nil
 }
 
@@ -295,10 +379,13 @@ func (d Redelegation) HumanReadableString() (string, error) {
 	resp += fmt.Sprintf("Delegator: %s\n", d.DelegatorAddr)
 	resp += fmt.Sprintf("Source Validator: %s\n", d.ValidatorSrcAddr)
 	resp += fmt.Sprintf("Destination Validator: %s\n", d.ValidatorDstAddr)
-	resp += fmt.Sprintf("Creation height: %v\n", d.CreationHeight)
-	resp += fmt.Sprintf("Min time to unbond (unix): %v\n", d.MinTime)
-	resp += fmt.Sprintf("Source shares: %s", d.SharesSrc.String())
-	resp += fmt.Sprintf("Destination shares: %s", d.SharesDst.String())
+	for i, entry := range d.Entries {
+		resp += fmt.Sprintf("Redelegation %d: \n", i)
+		resp += fmt.Sprintf("  Creation height: %v\n", entry.CreationHeight)
+		resp += fmt.Sprintf("  Min time to unbond (unix): %v\n", entry.CompletionTime)
+		resp += fmt.Sprintf("  Source shares: %s\n", entry.SharesSrc.String())
+		resp += fmt.Sprintf("  Destination shares: %s\n", entry.SharesDst.String())
+	}
 
 	return resp, nil
 
@@ -45,30 +45,46 @@ func TestDelegationHumanReadableString(t *testing.T) {
 }
 
 func TestUnbondingDelegationEqual(t *testing.T) {
-	ud1 := UnbondingDelegation{
-		DelegatorAddr: sdk.AccAddress(addr1),
-		ValidatorAddr: addr2,
-	}
-	ud2 := UnbondingDelegation{
-		DelegatorAddr: sdk.AccAddress(addr1),
-		ValidatorAddr: addr2,
-	}
+	ud1 := NewUnbondingDelegation(sdk.AccAddress(addr1), addr2, 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 0))
+	ud2 := NewUnbondingDelegation(sdk.AccAddress(addr1), addr2, 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 0))
 
 	ok := ud1.Equal(ud2)
 	require.True(t, ok)
 
 	ud2.ValidatorAddr = addr3
+	ud2.Entries[0].CompletionTime = time.Unix(20*20*2, 0)
 
-	ud2.MinTime = time.Unix(20*20*2, 0)
 	ok = ud1.Equal(ud2)
 	require.False(t, ok)
 }
 
+func TestUnbondingDelegationEntries(t *testing.T) {
+	ud := NewUnbondingDelegation(sdk.AccAddress(addr1), addr2, 0,
+		time.Unix(10, 0), sdk.NewInt64Coin("steak", 10))
+	require.True(t, ud.AddEntry(1, time.Unix(20, 0), sdk.NewInt64Coin("steak", 20)))
+	require.Len(t, ud.Entries, 2)
+	require.Equal(t, int64(1), ud.Entries[1].CreationHeight)
+	require.Equal(t, sdk.NewInt64Coin("steak", 20), ud.Entries[1].InitialBalance)
+
+	// entries of the same height and completion time are merged
+	require.False(t, ud.AddEntry(1, time.Unix(20, 0), sdk.NewInt64Coin("steak", 5)))
+	require.Len(t, ud.Entries, 2)
+	require.Equal(t, sdk.NewInt64Coin("steak", 25), ud.Entries[1].InitialBalance)
+	require.Equal(t, sdk.NewInt64Coin("steak", 25), ud.Entries[1].Balance)
+
+	require.True(t, ud.Entries[0].IsMature(time.Unix(10, 0)))
+	require.False(t, ud.Entries[1].IsMature(time.Unix(10, 0)))
+
+	ud.RemoveEntry(0)
+	require.Len(t, ud.Entries, 1)
+	require.Equal(t, int64(1), ud.Entries[0].CreationHeight)
+}
+
 func TestUnbondingDelegationHumanReadableString(t *testing.T) {
-	ud := UnbondingDelegation{
-		DelegatorAddr: sdk.AccAddress(addr1),
-		ValidatorAddr: addr2,
-	}
+	ud := NewUnbondingDelegation(sdk.AccAddress(addr1), addr2, 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 0))
 
 	// NOTE: Being that the validator's keypair is random, we cannot test the
 	// actual contents of the string.
@@ -78,36 +94,28 @@ func TestUnbondingDelegationHumanReadableString(t *testing.T) {
 }
 
 func TestRedelegationEqual(t *testing.T) {
-	r1 := Redelegation{
-		DelegatorAddr:    sdk.AccAddress(addr1),
-		ValidatorSrcAddr: addr2,
-		ValidatorDstAddr: addr3,
-	}
-	r2 := Redelegation{
-		DelegatorAddr:    sdk.AccAddress(addr1),
-		ValidatorSrcAddr: addr2,
-		ValidatorDstAddr: addr3,
-	}
+	r1 := NewRedelegation(sdk.AccAddress(addr1), addr2, addr3, 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 0),
+		sdk.NewDec(0), sdk.NewDec(0))
+	r2 := NewRedelegation(sdk.AccAddress(addr1), addr2, addr3, 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 0),
+		sdk.NewDec(0), sdk.NewDec(0))
 
 	ok := r1.Equal(r2)
 	require.True(t, ok)
 
-	r2.SharesDst = sdk.NewDec(10)
-	r2.SharesSrc = sdk.NewDec(20)
-	r2.MinTime = time.Unix(20*20*2, 0)
+	r2.Entries[0].SharesDst = sdk.NewDec(10)
+	r2.Entries[0].SharesSrc = sdk.NewDec(20)
+	r2.Entries[0].CompletionTime = time.Unix(20*20*2, 0)
 
 	ok = r1.Equal(r2)
 	require.False(t, ok)
 }
 
 func TestRedelegationHumanReadableString(t *testing.T) {
-	r := Redelegation{
-		DelegatorAddr:    sdk.AccAddress(addr1),
-		ValidatorSrcAddr: addr2,
-		ValidatorDstAddr: addr3,
-		SharesDst:        sdk.NewDec(10),
-		SharesSrc:        sdk.NewDec(20),
-	}
+	r := NewRedelegation(sdk.AccAddress(addr1), addr2, addr3, 0,
+		time.Unix(0, 0), sdk.NewInt64Coin("steak", 0),
+		sdk.NewDec(10), sdk.NewDec(20))
 
 	// NOTE: Being that the validator's keypair is random, we cannot test the
 	// actual contents of the string.
@@ -85,6 +85,31 @@ func ErrCommissionGTMaxChangeRate(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be changed more than max change rate")
 }
 
+func ErrMinSelfDelegationInvalid(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "minimum self delegation must be a positive integer")
+}
+
+func ErrMinSelfDelegationDecreased(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "minimum self delegation cannot be decreased")
+}
+
+func ErrSelfDelegationBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "validator's self delegation must be greater than or equal to their minimum self delegation")
+}
+
+func ErrNilConsPubKey(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "consensus pubkey is nil")
+}
+
+func ErrConsPubKeyUnchanged(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "new consensus pubkey is the current consensus pubkey of the validator")
+}
+
+func ErrMaxConsPubKeyRotations(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		"too many consensus pubkey rotations for this validator within the unbonding period, please wait for earlier rotations to expire")
+}
+
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "delegator address is nil")
 }
@@ -143,8 +168,32 @@ func ErrNoUnbondingDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "no unbonding delegation found")
 }
 
-func ErrExistingUnbondingDelegation(codespace sdk.CodespaceType) sdk.Error {
-	return sdk.NewError(codespace, CodeInvalidDelegation, "existing unbonding delegation found")
+func ErrMaxUnbondingDelegationEntries(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"too many unbonding delegation entries in this delegator/validator duo, please wait for some entries to mature")
+}
+
+func ErrBadCreationHeight(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "creation height must be >= 0")
+}
+
+func ErrNoUnbondingDelegationAtHeight(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("no unbonding delegation found with creation height %d", height))
+}
+
+func ErrAmbiguousUnbondingDelegationEntry(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("several unbonding delegation entries have creation height %d", height))
+}
+
+func ErrUnbondingDelegationMature(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "unbonding delegation has already matured and cannot be cancelled")
+}
+
+func ErrNotEnoughUnbondingBalance(codespace sdk.CodespaceType, balance string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("not enough unbonding balance, only have %v", balance))
 }
 
 func ErrBadRedelegationAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -164,9 +213,22 @@ func ErrTransitiveRedelegation(codespace sdk.CodespaceType) sdk.Error {
 		"redelegation to this validator already in progress, first redelegation to this validator must complete before next redelegation")
 }
 
-func ErrConflictingRedelegation(codespace sdk.CodespaceType) sdk.Error {
+func ErrMaxRedelegationEntries(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"too many redelegation entries in this delegator/src-validator/dst-validator trio, please wait for some entries to mature")
+}
+
+func ErrNilRecipientAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "recipient address is nil")
+}
+
+func ErrSelfTransferDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "cannot transfer a delegation to its own delegator")
+}
+
+func ErrTransferReceivingRedelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
-		"conflicting redelegation from this source validator to this dest validator already exists, you must wait for it to finish")
+		"redelegation to this validator in progress, it must complete before the delegation can be transferred")
 }
 
 func ErrBothShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
@@ -2,10 +2,13 @@ package types
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Pool       Pool         `json:"pool"`
-	Params     Params       `json:"params"`
-	Validators []Validator  `json:"validators"`
-	Bonds      []Delegation `json:"bonds"`
+	Pool                       Pool                        `json:"pool"`
+	Params                     Params                      `json:"params"`
+	Validators                 []Validator                 `json:"validators"`
+	Bonds                      []Delegation                `json:"bonds"`
+	ConsPubKeyRotations        []ConsPubKeyRotation        `json:"cons_pubkey_rotations"`
+	PendingConsPubKeyRotations []PendingConsPubKeyRotation `json:"pending_cons_pubkey_rotations"`
+	Exported                   bool                        `json:"exported"` // whether the state was exported from a running chain
 }
 
 func NewGenesisState(pool Pool, params Params, validators []Validator, bonds []Delegation) GenesisState {
@@ -11,7 +11,7 @@ import (
 const MsgType = "stake"
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgCreateValidator{}, &MsgEditValidator{}, &MsgDelegate{}
+var _, _, _, _ sdk.Msg = &MsgCreateValidator{}, &MsgEditValidator{}, &MsgRotateConsPubKey{}, &MsgDelegate{}
 
 //______________________________________________________________________
 
@@ -23,27 +23,34 @@ type MsgCreateValidator struct {
 	ValidatorAddr sdk.ValAddress `json:"validator_address"`
 	PubKey        crypto.PubKey  `json:"pubkey"`
 	Delegation    sdk.Coin       `json:"delegation"`
+
+	// MinSelfDelegation is the amount of tokens under which the self-delegation
+	// of the operator cannot fall without the validator being jailed.
+	MinSelfDelegation sdk.Int `json:"min_self_delegation"`
 }
 
 // Default way to create validator. Delegator address and validator address are the same
 func NewMsgCreateValidator(valAddr sdk.ValAddress, pubkey crypto.PubKey,
-	selfDelegation sdk.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
+	selfDelegation sdk.Coin, description Description, commission CommissionMsg,
+	minSelfDelegation sdk.Int) MsgCreateValidator {
 
 	return NewMsgCreateValidatorOnBehalfOf(
-		sdk.AccAddress(valAddr), valAddr, pubkey, selfDelegation, description, commission,
+		sdk.AccAddress(valAddr), valAddr, pubkey, selfDelegation, description, commission, minSelfDelegation,
 	)
 }
 
 // Creates validator msg by delegator address on behalf of validator address
 func NewMsgCreateValidatorOnBehalfOf(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
-	pubkey crypto.PubKey, delegation sdk.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
+	pubkey crypto.PubKey, delegation sdk.Coin, description Description, commission CommissionMsg,
+	minSelfDelegation sdk.Int) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   description,
-		DelegatorAddr: delAddr,
-		ValidatorAddr: valAddr,
-		PubKey:        pubkey,
-		Delegation:    delegation,
-		Commission:    commission,
+		Description:       description,
+		DelegatorAddr:     delAddr,
+		ValidatorAddr:     valAddr,
+		PubKey:            pubkey,
+		Delegation:        delegation,
+		Commission:        commission,
+		MinSelfDelegation: minSelfDelegation,
 	}
 }
 
@@ -68,15 +75,17 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgCreateValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
-		DelegatorAddr sdk.AccAddress `json:"delegator_address"`
-		ValidatorAddr sdk.ValAddress `json:"validator_address"`
-		PubKey        string         `json:"pubkey"`
-		Delegation    sdk.Coin       `json:"delegation"`
+		DelegatorAddr     sdk.AccAddress `json:"delegator_address"`
+		ValidatorAddr     sdk.ValAddress `json:"validator_address"`
+		PubKey            string         `json:"pubkey"`
+		Delegation        sdk.Coin       `json:"delegation"`
+		MinSelfDelegation sdk.Int        `json:"min_self_delegation"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
-		PubKey:        sdk.MustBech32ifyConsPub(msg.PubKey),
-		Delegation:    msg.Delegation,
+		Description:       msg.Description,
+		ValidatorAddr:     msg.ValidatorAddr,
+		PubKey:            sdk.MustBech32ifyConsPub(msg.PubKey),
+		Delegation:        msg.Delegation,
+		MinSelfDelegation: msg.MinSelfDelegation,
 	})
 	if err != nil {
 		panic(err)
@@ -101,6 +110,15 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if msg.Commission == (CommissionMsg{}) {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "commission must be included")
 	}
+	if msg.MinSelfDelegation.IsNil() || msg.MinSelfDelegation.Sign() <= 0 {
+		return ErrMinSelfDelegationInvalid(DefaultCodespace)
+	}
+	// a validator created on behalf of its operator starts without any
+	// self-delegation, otherwise it must start at the minimum
+	if bytes.Equal(msg.DelegatorAddr.Bytes(), msg.ValidatorAddr.Bytes()) &&
+		msg.Delegation.Amount.LT(msg.MinSelfDelegation) {
+		return ErrSelfDelegationBelowMinimum(DefaultCodespace)
+	}
 
 	return nil
 }
@@ -118,13 +136,20 @@ type MsgEditValidator struct {
 	//
 	// REF: #2373
 	CommissionRate *sdk.Dec `json:"commission_rate"`
+
+	// The minimum self-delegation can only be raised, it is left unchanged
+	// when nil.
+	MinSelfDelegation *sdk.Int `json:"min_self_delegation"`
 }
 
-func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec) MsgEditValidator {
+func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec,
+	newMinSelfDelegation *sdk.Int) MsgEditValidator {
+
 	return MsgEditValidator{
-		Description:    description,
-		CommissionRate: newRate,
-		ValidatorAddr:  valAddr,
+		Description:       description,
+		CommissionRate:    newRate,
+		ValidatorAddr:     valAddr,
+		MinSelfDelegation: newMinSelfDelegation,
 	}
 }
 
@@ -139,10 +164,12 @@ func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgEditValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
-		ValidatorAddr sdk.ValAddress `json:"address"`
+		ValidatorAddr     sdk.ValAddress `json:"address"`
+		MinSelfDelegation *sdk.Int       `json:"min_self_delegation"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
+		Description:       msg.Description,
+		ValidatorAddr:     msg.ValidatorAddr,
+		MinSelfDelegation: msg.MinSelfDelegation,
 	})
 	if err != nil {
 		panic(err)
@@ -156,10 +183,62 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "nil validator address")
 	}
 
-	if msg.Description == (Description{}) {
+	if msg.Description == (Description{}) && msg.MinSelfDelegation == nil {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "transaction must include some information to modify")
 	}
 
+	if msg.MinSelfDelegation != nil && (msg.MinSelfDelegation.IsNil() || msg.MinSelfDelegation.Sign() <= 0) {
+		return ErrMinSelfDelegationInvalid(DefaultCodespace)
+	}
+
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgRotateConsPubKey - struct for replacing the consensus pubkey of a validator
+type MsgRotateConsPubKey struct {
+	ValidatorAddr sdk.ValAddress `json:"validator_address"`
+	NewPubKey     crypto.PubKey  `json:"new_pubkey"`
+}
+
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, newPubKey crypto.PubKey) MsgRotateConsPubKey {
+	return MsgRotateConsPubKey{
+		ValidatorAddr: valAddr,
+		NewPubKey:     newPubKey,
+	}
+}
+
+//nolint
+func (msg MsgRotateConsPubKey) Type() string { return MsgType }
+func (msg MsgRotateConsPubKey) Name() string { return "rotate_cons_pubkey" }
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(struct {
+		ValidatorAddr sdk.ValAddress `json:"validator_address"`
+		NewPubKey     string         `json:"new_pubkey"`
+	}{
+		ValidatorAddr: msg.ValidatorAddr,
+		NewPubKey:     sdk.MustBech32ifyConsPub(msg.NewPubKey),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRotateConsPubKey) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.NewPubKey == nil {
+		return ErrNilConsPubKey(DefaultCodespace)
+	}
 	return nil
 }
 
@@ -326,3 +405,124 @@ func (msg MsgBeginUnbonding) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//______________________________________________________________________
+
+// MsgTransferDelegation - struct for transferring delegation shares to another
+// account without unbonding them
+type MsgTransferDelegation struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	RecipientAddr sdk.AccAddress `json:"recipient_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	SharesAmount  sdk.Dec        `json:"shares_amount"`
+}
+
+func NewMsgTransferDelegation(delAddr, recipientAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	sharesAmount sdk.Dec) MsgTransferDelegation {
+
+	return MsgTransferDelegation{
+		DelegatorAddr: delAddr,
+		RecipientAddr: recipientAddr,
+		ValidatorAddr: valAddr,
+		SharesAmount:  sharesAmount,
+	}
+}
+
+//nolint
+func (msg MsgTransferDelegation) Type() string                 { return MsgType }
+func (msg MsgTransferDelegation) Name() string                 { return "transfer_delegation" }
+func (msg MsgTransferDelegation) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddr} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgTransferDelegation) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(struct {
+		DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+		RecipientAddr sdk.AccAddress `json:"recipient_addr"`
+		ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+		SharesAmount  string         `json:"shares_amount"`
+	}{
+		DelegatorAddr: msg.DelegatorAddr,
+		RecipientAddr: msg.RecipientAddr,
+		ValidatorAddr: msg.ValidatorAddr,
+		SharesAmount:  msg.SharesAmount.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgTransferDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.RecipientAddr == nil {
+		return ErrNilRecipientAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if bytes.Equal(msg.DelegatorAddr, msg.RecipientAddr) {
+		return ErrSelfTransferDelegation(DefaultCodespace)
+	}
+	if msg.SharesAmount.LTE(sdk.ZeroDec()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgCancelUnbondingDelegation - struct for delegating back to the validator
+// the balance of an unbonding delegation which has not yet completed
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddr  sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr  sdk.ValAddress `json:"validator_addr"`
+	CreationHeight int64          `json:"creation_height"`
+	Amount         sdk.Coin       `json:"amount"`
+}
+
+func NewMsgCancelUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	creationHeight int64, amount sdk.Coin) MsgCancelUnbondingDelegation {
+
+	return MsgCancelUnbondingDelegation{
+		DelegatorAddr:  delAddr,
+		ValidatorAddr:  valAddr,
+		CreationHeight: creationHeight,
+		Amount:         amount,
+	}
+}
+
+//nolint
+func (msg MsgCancelUnbondingDelegation) Type() string { return MsgType }
+func (msg MsgCancelUnbondingDelegation) Name() string { return "cancel_unbonding_delegation" }
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.CreationHeight < 0 {
+		return ErrBadCreationHeight(DefaultCodespace)
+	}
+	if !(msg.Amount.Amount.GT(sdk.ZeroInt())) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
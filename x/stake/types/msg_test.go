@@ -26,21 +26,27 @@ func TestMsgCreateValidator(t *testing.T) {
 		validatorAddr                             sdk.ValAddress
 		pubkey                                    crypto.PubKey
 		bond                                      sdk.Coin
+		minSelfDelegation                         sdk.Int
 		expectPass                                bool
 	}{
-		{"basic good", "a", "b", "c", "d", commission1, addr1, pk1, coinPos, true},
-		{"partial description", "", "", "c", "", commission1, addr1, pk1, coinPos, true},
-		{"empty description", "", "", "", "", commission2, addr1, pk1, coinPos, false},
-		{"empty address", "a", "b", "c", "d", commission2, emptyAddr, pk1, coinPos, false},
-		{"empty pubkey", "a", "b", "c", "d", commission1, addr1, emptyPubkey, coinPos, true},
-		{"empty bond", "a", "b", "c", "d", commission2, addr1, pk1, coinZero, false},
-		{"negative bond", "a", "b", "c", "d", commission2, addr1, pk1, coinNeg, false},
-		{"negative bond", "a", "b", "c", "d", commission1, addr1, pk1, coinNeg, false},
+		{"basic good", "a", "b", "c", "d", commission1, addr1, pk1, coinPos, sdk.OneInt(), true},
+		{"partial description", "", "", "c", "", commission1, addr1, pk1, coinPos, sdk.OneInt(), true},
+		{"empty description", "", "", "", "", commission2, addr1, pk1, coinPos, sdk.OneInt(), false},
+		{"empty address", "a", "b", "c", "d", commission2, emptyAddr, pk1, coinPos, sdk.OneInt(), false},
+		{"empty pubkey", "a", "b", "c", "d", commission1, addr1, emptyPubkey, coinPos, sdk.OneInt(), true},
+		{"empty bond", "a", "b", "c", "d", commission2, addr1, pk1, coinZero, sdk.OneInt(), false},
+		{"negative bond", "a", "b", "c", "d", commission2, addr1, pk1, coinNeg, sdk.OneInt(), false},
+		{"negative bond", "a", "b", "c", "d", commission1, addr1, pk1, coinNeg, sdk.OneInt(), false},
+		{"bond at min self delegation", "a", "b", "c", "d", commission1, addr1, pk1, coinPos, coinPos.Amount, true},
+		{"bond below min self delegation", "a", "b", "c", "d", commission1, addr1, pk1, coinPos, coinPos.Amount.AddRaw(1), false},
+		{"zero min self delegation", "a", "b", "c", "d", commission1, addr1, pk1, coinPos, sdk.ZeroInt(), false},
+		{"negative min self delegation", "a", "b", "c", "d", commission1, addr1, pk1, coinPos, sdk.NewInt(-1), false},
+		{"empty min self delegation", "a", "b", "c", "d", commission1, addr1, pk1, coinPos, sdk.Int{}, false},
 	}
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description, tc.commissionMsg)
+		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description, tc.commissionMsg, tc.minSelfDelegation)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -54,19 +60,24 @@ func TestMsgEditValidator(t *testing.T) {
 	tests := []struct {
 		name, moniker, identity, website, details string
 		validatorAddr                             sdk.ValAddress
+		minSelfDelegation                         *sdk.Int
 		expectPass                                bool
 	}{
-		{"basic good", "a", "b", "c", "d", addr1, true},
-		{"partial description", "", "", "c", "", addr1, true},
-		{"empty description", "", "", "", "", addr1, false},
-		{"empty address", "a", "b", "c", "d", emptyAddr, false},
+		{"basic good", "a", "b", "c", "d", addr1, nil, true},
+		{"partial description", "", "", "c", "", addr1, nil, true},
+		{"empty description", "", "", "", "", addr1, nil, false},
+		{"only min self delegation", "", "", "", "", addr1, &coinPos.Amount, true},
+		{"empty address", "a", "b", "c", "d", emptyAddr, nil, false},
+		{"new min self delegation", "a", "b", "c", "d", addr1, &coinPos.Amount, true},
+		{"zero min self delegation", "a", "b", "c", "d", addr1, &coinZero.Amount, false},
+		{"negative min self delegation", "a", "b", "c", "d", addr1, &coinNeg.Amount, false},
 	}
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
 		newRate := sdk.ZeroDec()
 
-		msg := NewMsgEditValidator(tc.validatorAddr, description, &newRate)
+		msg := NewMsgEditValidator(tc.validatorAddr, description, &newRate, tc.minSelfDelegation)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -103,7 +114,7 @@ func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
 		msg := NewMsgCreateValidatorOnBehalfOf(
-			tc.delegatorAddr, tc.validatorAddr, tc.validatorPubKey, tc.bond, description, tc.commissionMsg,
+			tc.delegatorAddr, tc.validatorAddr, tc.validatorPubKey, tc.bond, description, tc.commissionMsg, sdk.OneInt(),
 		)
 
 		if tc.expectPass {
@@ -113,11 +124,11 @@ func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 		}
 	}
 
-	msg := NewMsgCreateValidator(addr1, pk1, coinPos, Description{}, CommissionMsg{})
+	msg := NewMsgCreateValidator(addr1, pk1, coinPos, Description{}, CommissionMsg{}, sdk.OneInt())
 	addrs := msg.GetSigners()
 	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(addr1)}, addrs, "Signers on default msg is wrong")
 
-	msg = NewMsgCreateValidatorOnBehalfOf(sdk.AccAddress(addr2), addr1, pk1, coinPos, Description{}, CommissionMsg{})
+	msg = NewMsgCreateValidatorOnBehalfOf(sdk.AccAddress(addr2), addr1, pk1, coinPos, Description{}, CommissionMsg{}, sdk.OneInt())
 	addrs = msg.GetSigners()
 	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(addr2), sdk.AccAddress(addr1)}, addrs, "Signers for onbehalfof msg is wrong")
 }
@@ -202,3 +213,81 @@ func TestMsgBeginUnbonding(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgTransferDelegation
+func TestMsgTransferDelegation(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		recipientAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		sharesAmount  sdk.Dec
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(addr1), sdk.AccAddress(addr2), addr3, sdk.NewDecWithPrec(1, 1), true},
+		{"negative decimal", sdk.AccAddress(addr1), sdk.AccAddress(addr2), addr3, sdk.NewDecWithPrec(-1, 1), false},
+		{"zero amount", sdk.AccAddress(addr1), sdk.AccAddress(addr2), addr3, sdk.ZeroDec(), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.AccAddress(addr2), addr3, sdk.NewDecWithPrec(1, 1), false},
+		{"empty recipient", sdk.AccAddress(addr1), sdk.AccAddress(emptyAddr), addr3, sdk.NewDecWithPrec(1, 1), false},
+		{"empty validator", sdk.AccAddress(addr1), sdk.AccAddress(addr2), emptyAddr, sdk.NewDecWithPrec(1, 1), false},
+		{"self transfer", sdk.AccAddress(addr1), sdk.AccAddress(addr1), addr3, sdk.NewDecWithPrec(1, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTransferDelegation(tc.delegatorAddr, tc.recipientAddr, tc.validatorAddr, tc.sharesAmount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		amount         sdk.Coin
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(addr1), addr2, 1, coinPos, true},
+		{"zero height", sdk.AccAddress(addr1), addr2, 0, coinPos, true},
+		{"negative height", sdk.AccAddress(addr1), addr2, -1, coinPos, false},
+		{"zero amount", sdk.AccAddress(addr1), addr2, 1, coinZero, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), addr2, 1, coinPos, false},
+		{"empty validator", sdk.AccAddress(addr1), emptyAddr, 1, coinPos, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubkey        crypto.PubKey
+		expectPass    bool
+	}{
+		{"basic good", addr1, pk2, true},
+		{"empty validator", emptyAddr, pk2, false},
+		{"empty pubkey", addr1, emptyPubkey, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRotateConsPubKey(tc.validatorAddr, tc.pubkey)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -24,13 +24,17 @@ const (
 
 // nolint - Keys for parameter access
 var (
-	KeyInflationRateChange = []byte("InflationRateChange")
-	KeyInflationMax        = []byte("InflationMax")
-	KeyInflationMin        = []byte("InflationMin")
-	KeyGoalBonded          = []byte("GoalBonded")
-	KeyUnbondingTime       = []byte("UnbondingTime")
-	KeyMaxValidators       = []byte("MaxValidators")
-	KeyBondDenom           = []byte("BondDenom")
+	KeyInflationRateChange    = []byte("InflationRateChange")
+	KeyInflationMax           = []byte("InflationMax")
+	KeyInflationMin           = []byte("InflationMin")
+	KeyGoalBonded             = []byte("GoalBonded")
+	KeyUnbondingTime          = []byte("UnbondingTime")
+	KeyMaxValidators          = []byte("MaxValidators")
+	KeyMaxEntries             = []byte("MaxEntries")
+	KeyMaxVotingPowerFraction = []byte("MaxVotingPowerFraction")
+	KeyMaxConsPubKeyRotations = []byte("MaxConsPubKeyRotations")
+	KeyConsPubKeyRotationFee  = []byte("ConsPubKeyRotationFee")
+	KeyBondDenom              = []byte("BondDenom")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -45,7 +49,16 @@ type Params struct {
 	UnbondingTime time.Duration `json:"unbonding_time"`
 
 	MaxValidators uint16 `json:"max_validators"` // maximum number of validators
-	BondDenom     string `json:"bond_denom"`     // bondable coin denomination
+	MaxEntries    uint16 `json:"max_entries"`    // max entries for either unbonding delegation or redelegation (per pair/trio)
+
+	// maximum fraction of the total bonded power which is reported to
+	// Tendermint as the power of a single validator
+	MaxVotingPowerFraction sdk.Dec `json:"max_voting_power_fraction"`
+
+	MaxConsPubKeyRotations uint16  `json:"max_cons_pubkey_rotations"` // max consensus pubkey rotations of a validator within the unbonding time
+	ConsPubKeyRotationFee  sdk.Int `json:"cons_pubkey_rotation_fee"`  // bond denom tokens burned for each consensus pubkey rotation
+
+	BondDenom string `json:"bond_denom"` // bondable coin denomination
 }
 
 // Implements params.ParamSet
@@ -57,6 +70,10 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeyGoalBonded, &p.GoalBonded},
 		{KeyUnbondingTime, &p.UnbondingTime},
 		{KeyMaxValidators, &p.MaxValidators},
+		{KeyMaxEntries, &p.MaxEntries},
+		{KeyMaxVotingPowerFraction, &p.MaxVotingPowerFraction},
+		{KeyMaxConsPubKeyRotations, &p.MaxConsPubKeyRotations},
+		{KeyConsPubKeyRotationFee, &p.ConsPubKeyRotationFee},
 		{KeyBondDenom, &p.BondDenom},
 	}
 }
@@ -71,13 +88,17 @@ func (p Params) Equal(p2 Params) bool {
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		UnbondingTime:       defaultUnbondingTime,
-		MaxValidators:       100,
-		BondDenom:           "steak",
+		InflationRateChange:    sdk.NewDecWithPrec(13, 2),
+		InflationMax:           sdk.NewDecWithPrec(20, 2),
+		InflationMin:           sdk.NewDecWithPrec(7, 2),
+		GoalBonded:             sdk.NewDecWithPrec(67, 2),
+		UnbondingTime:          defaultUnbondingTime,
+		MaxValidators:          100,
+		MaxEntries:             7,
+		MaxVotingPowerFraction: sdk.OneDec(),
+		MaxConsPubKeyRotations: 1,
+		ConsPubKeyRotationFee:  sdk.NewInt(10),
+		BondDenom:              "steak",
 	}
 }
 
@@ -92,6 +113,10 @@ func (p Params) HumanReadableString() string {
 	resp += fmt.Sprintf("Bonded Token Goal (%s): %s\n", "s", p.GoalBonded)
 	resp += fmt.Sprintf("Unbonding Time: %s\n", p.UnbondingTime)
 	resp += fmt.Sprintf("Max Validators: %d: \n", p.MaxValidators)
+	resp += fmt.Sprintf("Max Entries: %d: \n", p.MaxEntries)
+	resp += fmt.Sprintf("Max Voting Power Fraction: %s\n", p.MaxVotingPowerFraction)
+	resp += fmt.Sprintf("Max Consensus PubKey Rotations: %d\n", p.MaxConsPubKeyRotations)
+	resp += fmt.Sprintf("Consensus PubKey Rotation Fee: %s\n", p.ConsPubKeyRotationFee)
 	resp += fmt.Sprintf("Bonded Coin Denomination: %s\n", p.BondDenom)
 	return resp
 }
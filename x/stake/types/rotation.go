@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// ConsPubKeyRotation records the replacement of the consensus pubkey of a
+// validator. The previous consensus address of the validator remains indexed so
+// evidence signed with the old key can still be attributed to the validator.
+type ConsPubKeyRotation struct {
+	OperatorAddr  sdk.ValAddress `json:"operator_address"`
+	OldConsPubKey crypto.PubKey  `json:"old_consensus_pubkey"`
+	NewConsPubKey crypto.PubKey  `json:"new_consensus_pubkey"`
+	Height        int64          `json:"height"` // height at which the rotation took place
+	Time          time.Time      `json:"time"`   // time at which the rotation took place
+}
+
+func NewConsPubKeyRotation(operator sdk.ValAddress, oldPubKey, newPubKey crypto.PubKey,
+	height int64, time time.Time) ConsPubKeyRotation {
+
+	return ConsPubKeyRotation{
+		OperatorAddr:  operator,
+		OldConsPubKey: oldPubKey,
+		NewConsPubKey: newPubKey,
+		Height:        height,
+		Time:          time,
+	}
+}
+
+// PendingConsPubKeyRotation records the consensus pubkey known to Tendermint
+// of a validator whose rotation has not yet been reported to Tendermint.
+type PendingConsPubKeyRotation struct {
+	OperatorAddr sdk.ValAddress `json:"operator_address"`
+	ConsPubKey   crypto.PubKey  `json:"consensus_pubkey"`
+}
@@ -36,7 +36,8 @@ type Validator struct {
 	UnbondingHeight  int64     `json:"unbonding_height"` // if unbonding, height at which this validator has begun unbonding
 	UnbondingMinTime time.Time `json:"unbonding_time"`   // if unbonding, min time for the validator to complete unbonding
 
-	Commission Commission `json:"commission"` // commission parameters
+	Commission        Commission `json:"commission"`          // commission parameters
+	MinSelfDelegation sdk.Int    `json:"min_self_delegation"` // minimum tokens the operator must keep self-delegated
 }
 
 // NewValidator - initialize a new validator
@@ -54,6 +55,7 @@ func NewValidator(operator sdk.ValAddress, pubKey crypto.PubKey, description Des
 		UnbondingHeight:    int64(0),
 		UnbondingMinTime:   time.Unix(0, 0).UTC(),
 		Commission:         NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation:  sdk.OneInt(),
 	}
 }
 
@@ -70,6 +72,7 @@ type validatorValue struct {
 	UnbondingHeight    int64
 	UnbondingMinTime   time.Time
 	Commission         Commission
+	MinSelfDelegation  sdk.Int
 }
 
 // return the redelegation without fields contained within the key for the store
@@ -86,6 +89,7 @@ func MustMarshalValidator(cdc *codec.Codec, validator Validator) []byte {
 		UnbondingHeight:    validator.UnbondingHeight,
 		UnbondingMinTime:   validator.UnbondingMinTime,
 		Commission:         validator.Commission,
+		MinSelfDelegation:  validator.MinSelfDelegation,
 	}
 	return cdc.MustMarshalBinary(val)
 }
@@ -124,6 +128,7 @@ func UnmarshalValidator(cdc *codec.Codec, operatorAddr, value []byte) (validator
 		UnbondingHeight:    storeValue.UnbondingHeight,
 		UnbondingMinTime:   storeValue.UnbondingMinTime,
 		Commission:         storeValue.Commission,
+		MinSelfDelegation:  storeValue.MinSelfDelegation,
 	}, nil
 }
 
@@ -148,6 +153,7 @@ func (v Validator) HumanReadableString() (string, error) {
 	resp += fmt.Sprintf("Unbonding Height: %d\n", v.UnbondingHeight)
 	resp += fmt.Sprintf("Minimum Unbonding Time: %v\n", v.UnbondingMinTime)
 	resp += fmt.Sprintf("Commission: {%s}\n", v.Commission)
+	resp += fmt.Sprintf("Minimum Self Delegation: %s\n", v.MinSelfDelegation)
 
 	return resp, nil
 }
@@ -171,7 +177,8 @@ type bechValidator struct {
 	UnbondingHeight  int64     `json:"unbonding_height"` // if unbonding, height at which this validator has begun unbonding
 	UnbondingMinTime time.Time `json:"unbonding_time"`   // if unbonding, min time for the validator to complete unbonding
 
-	Commission Commission `json:"commission"` // commission parameters
+	Commission        Commission `json:"commission"`          // commission parameters
+	MinSelfDelegation sdk.Int    `json:"min_self_delegation"` // minimum tokens the operator must keep self-delegated
 }
 
 // MarshalJSON marshals the validator to JSON using Bech32
@@ -194,6 +201,7 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 		UnbondingHeight:    v.UnbondingHeight,
 		UnbondingMinTime:   v.UnbondingMinTime,
 		Commission:         v.Commission,
+		MinSelfDelegation:  v.MinSelfDelegation,
 	})
 }
 
@@ -220,6 +228,7 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		UnbondingHeight:    bv.UnbondingHeight,
 		UnbondingMinTime:   bv.UnbondingMinTime,
 		Commission:         bv.Commission,
+		MinSelfDelegation:  bv.MinSelfDelegation,
 	}
 	return nil
 }
@@ -308,16 +317,32 @@ func (d Description) EnsureLength() (Description, sdk.Error) {
 // ABCIValidatorUpdate returns an abci.ValidatorUpdate from a staked validator type
 // with the full validator power
 func (v Validator) ABCIValidatorUpdate() abci.ValidatorUpdate {
+	return v.ABCIValidatorUpdateWithPower(v.BondedTokens().RoundInt64())
+}
+
+// ABCIValidatorUpdateWithPower returns an abci.ValidatorUpdate from a staked
+// validator type with the provided power
+func (v Validator) ABCIValidatorUpdateWithPower(power int64) abci.ValidatorUpdate {
 	return abci.ValidatorUpdate{
 		PubKey: tmtypes.TM2PB.PubKey(v.ConsPubKey),
-		Power:  v.BondedTokens().RoundInt64(),
+		Power:  power,
 	}
 }
 
-// ABCIValidatorPowerBytes
-func (v Validator) ABCIValidatorPowerBytes(cdc *codec.Codec) []byte {
+// EffectivePower returns the power of the validator reported to Tendermint,
+// which is its full power capped at maxPower
+func (v Validator) EffectivePower(maxPower int64) int64 {
 	power := v.BondedTokens().RoundInt64()
-	return cdc.MustMarshalBinary(power)
+	if power > maxPower {
+		return maxPower
+	}
+	return power
+}
+
+// ABCIValidatorPowerBytes returns the serialized effective power of the
+// validator capped at maxPower
+func (v Validator) ABCIValidatorPowerBytes(cdc *codec.Codec, maxPower int64) []byte {
+	return cdc.MustMarshalBinary(v.EffectivePower(maxPower))
 }
 
 // ABCIValidatorUpdateZero returns an abci.ValidatorUpdate from a staked validator type
@@ -441,14 +466,16 @@ func (v Validator) BondedTokens() sdk.Dec {
 var _ sdk.Validator = Validator{}
 
 // nolint - for sdk.Validator
-func (v Validator) GetJailed() bool              { return v.Jailed }
-func (v Validator) GetMoniker() string           { return v.Description.Moniker }
-func (v Validator) GetStatus() sdk.BondStatus    { return v.Status }
-func (v Validator) GetOperator() sdk.ValAddress  { return v.OperatorAddr }
-func (v Validator) GetConsPubKey() crypto.PubKey { return v.ConsPubKey }
-func (v Validator) GetConsAddr() sdk.ConsAddress { return sdk.ConsAddress(v.ConsPubKey.Address()) }
-func (v Validator) GetPower() sdk.Dec            { return v.BondedTokens() }
-func (v Validator) GetTokens() sdk.Dec           { return v.Tokens }
-func (v Validator) GetCommission() sdk.Dec       { return v.Commission.Rate }
-func (v Validator) GetDelegatorShares() sdk.Dec  { return v.DelegatorShares }
-func (v Validator) GetBondHeight() int64         { return v.BondHeight }
+func (v Validator) GetJailed() bool                  { return v.Jailed }
+func (v Validator) GetMoniker() string               { return v.Description.Moniker }
+func (v Validator) GetStatus() sdk.BondStatus        { return v.Status }
+func (v Validator) GetOperator() sdk.ValAddress      { return v.OperatorAddr }
+func (v Validator) GetConsPubKey() crypto.PubKey     { return v.ConsPubKey }
+func (v Validator) GetConsAddr() sdk.ConsAddress     { return sdk.ConsAddress(v.ConsPubKey.Address()) }
+func (v Validator) GetPower() sdk.Dec                { return v.BondedTokens() }
+func (v Validator) GetTokens() sdk.Dec               { return v.Tokens }
+func (v Validator) GetCommission() sdk.Dec           { return v.Commission.Rate }
+func (v Validator) GetDelegatorShares() sdk.Dec      { return v.DelegatorShares }
+func (v Validator) GetBondHeight() int64             { return v.BondHeight }
+func (v Validator) GetDelegatorShareExRate() sdk.Dec { return v.DelegatorShareExRate() }
+func (v Validator) GetMinSelfDelegation() sdk.Int    { return v.MinSelfDelegation }
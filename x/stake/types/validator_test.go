@@ -71,6 +71,20 @@ func TestABCIValidatorUpdateZero(t *testing.T) {
 	require.Equal(t, int64(0), abciVal.Power)
 }
 
+func TestEffectivePower(t *testing.T) {
+	validator := NewValidator(addr1, pk1, Description{})
+	validator.Status = sdk.Bonded
+	validator.Tokens = sdk.NewDec(100)
+
+	require.Equal(t, int64(100), validator.EffectivePower(200))
+	require.Equal(t, int64(100), validator.EffectivePower(100))
+	require.Equal(t, int64(40), validator.EffectivePower(40))
+
+	abciVal := validator.ABCIValidatorUpdateWithPower(validator.EffectivePower(40))
+	require.Equal(t, tmtypes.TM2PB.PubKey(validator.ConsPubKey), abciVal.PubKey)
+	require.Equal(t, int64(40), abciVal.Power)
+}
+
 func TestRemoveTokens(t *testing.T) {
 
 	validator := Validator{